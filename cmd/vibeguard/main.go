@@ -6,24 +6,16 @@ import (
 	"os"
 
 	"github.com/vibeguard/vibeguard/internal/cli"
-	"github.com/vibeguard/vibeguard/internal/config"
 )
 
 func main() {
 	if err := cli.Execute(); err != nil {
-		// Check if this is an ExitError (check completed but with specific exit code)
+		// An ExitError carries the outcome of a completed run; anything else
+		// is an error worth reporting
 		var exitErr *cli.ExitError
-		if errors.As(err, &exitErr) {
-			os.Exit(exitErr.Code)
+		if !errors.As(err, &exitErr) {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		}
-
-		// Print error message
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-
-		// Check for specific error types
-		if config.IsConfigError(err) {
-			os.Exit(2) // Configuration error
-		}
-		os.Exit(1)
+		os.Exit(cli.ExitCodeFor(err))
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -184,6 +185,39 @@ checks:
 			t.Errorf("expected exit code 0 for warning severity, got error: %v", err)
 		}
 	})
+
+	t.Run("validate exits 0 for a valid config path", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		writeConfig(t, tmpDir, `version: "1"
+checks:
+  - id: never-run
+    run: "false"
+`)
+
+		cmd := exec.Command(binPath, "validate", filepath.Join(tmpDir, "vibeguard.yaml"))
+		if err := cmd.Run(); err != nil {
+			t.Errorf("expected exit code 0, got error: %v", err)
+		}
+	})
+
+	t.Run("validate exits 2 with the line number for an invalid config path", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		writeConfig(t, tmpDir, `version: "1"
+checks:
+  - id: ok
+    run: "true"
+  - id: bad
+    run: "true"
+    severity: critical
+`)
+
+		cmd := exec.Command(binPath, "validate", filepath.Join(tmpDir, "vibeguard.yaml"))
+		out, err := cmd.CombinedOutput()
+		assertExitCode(t, err, 2)
+		if !strings.Contains(string(out), "(line 5)") {
+			t.Errorf("expected line number in output, got: %s", out)
+		}
+	})
 }
 
 // TestMain_ErrorOutput tests that error messages are written to stderr.
@@ -62,6 +62,26 @@ type BinaryExpr struct {
 func (*BinaryExpr) node() {}
 func (*BinaryExpr) expr() {}
 
+// ConditionalExpr represents a conditional expression (e.g., strict ? a : b).
+type ConditionalExpr struct {
+	Cond Expr
+	Then Expr
+	Else Expr
+}
+
+func (*ConditionalExpr) node() {}
+func (*ConditionalExpr) expr() {}
+
+// InExpr represents a membership test against a list literal
+// (e.g., status in ["ok", "passed"]).
+type InExpr struct {
+	Left     Expr
+	Elements []Expr
+}
+
+func (*InExpr) node() {}
+func (*InExpr) expr() {}
+
 // ParenExpr represents a parenthesized expression.
 type ParenExpr struct {
 	Inner Expr
@@ -69,3 +89,12 @@ type ParenExpr struct {
 
 func (*ParenExpr) node() {}
 func (*ParenExpr) expr() {}
+
+// CallExpr represents a function call (e.g., contains(output, "FAIL")).
+type CallExpr struct {
+	Name string
+	Args []Expr
+}
+
+func (*CallExpr) node() {}
+func (*CallExpr) expr() {}
@@ -3,6 +3,7 @@ package assert
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 )
@@ -23,14 +24,14 @@ func (v Value) String() string {
 }
 
 // AsBool interprets the value as a boolean.
-// "true" -> true, "false" -> false
+// "true" -> true, "false" -> false (case-insensitive)
 // Non-empty string -> true, empty string -> false
 // Numbers: 0 -> false, non-zero -> true
 func (v Value) AsBool() bool {
-	if v.raw == "true" {
+	if strings.EqualFold(v.raw, "true") {
 		return true
 	}
-	if v.raw == "false" || v.raw == "" {
+	if strings.EqualFold(v.raw, "false") || v.raw == "" {
 		return false
 	}
 	// Try as number: 0 is false, non-zero is true
@@ -85,6 +86,54 @@ func (e *Evaluator) Eval(expr string, vars map[string]string) (bool, error) {
 	return result.AsBool(), nil
 }
 
+// Variables returns the names of the variables expr references, in order of
+// first use. Function names are not variables.
+func Variables(expr string) ([]string, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, nil
+	}
+	ast, err := NewParser(expr).Parse()
+	if err != nil {
+		return nil, fmt.Errorf("parse error in assertion %q: %w", expr, err)
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	var walk func(node Expr)
+	walk = func(node Expr) {
+		switch n := node.(type) {
+		case *Ident:
+			if !seen[n.Name] {
+				seen[n.Name] = true
+				names = append(names, n.Name)
+			}
+		case *ParenExpr:
+			walk(n.Inner)
+		case *UnaryExpr:
+			walk(n.Right)
+		case *BinaryExpr:
+			walk(n.Left)
+			walk(n.Right)
+		case *ConditionalExpr:
+			walk(n.Cond)
+			walk(n.Then)
+			walk(n.Else)
+		case *InExpr:
+			walk(n.Left)
+			for _, elem := range n.Elements {
+				walk(elem)
+			}
+		case *CallExpr:
+			for _, arg := range n.Args {
+				walk(arg)
+			}
+		}
+	}
+	walk(ast)
+	return names, nil
+}
+
 // eval recursively evaluates an AST node.
 func (e *Evaluator) eval(node Expr, vars map[string]string) (Value, error) {
 	switch n := node.(type) {
@@ -117,6 +166,23 @@ func (e *Evaluator) eval(node Expr, vars map[string]string) (Value, error) {
 	case *BinaryExpr:
 		return e.evalBinary(n, vars)
 
+	case *CallExpr:
+		return e.evalCall(n, vars)
+
+	case *InExpr:
+		return e.evalIn(n, vars)
+
+	case *ConditionalExpr:
+		// Only the taken branch is evaluated
+		cond, err := e.eval(n.Cond, vars)
+		if err != nil {
+			return Value{}, err
+		}
+		if cond.AsBool() {
+			return e.eval(n.Then, vars)
+		}
+		return e.eval(n.Else, vars)
+
 	default:
 		return Value{}, fmt.Errorf("unknown node type: %T", node)
 	}
@@ -205,6 +271,15 @@ func (e *Evaluator) evalBinary(expr *BinaryExpr, vars map[string]string) (Value,
 			}
 			return a / b
 		})
+	case TokenPercent:
+		return e.evalArithmetic(left, right, func(a, b float64) float64 {
+			if b == 0 {
+				return 0 // Modulo by zero returns 0, like division
+			}
+			return math.Mod(a, b)
+		})
+	case TokenPower:
+		return e.evalArithmetic(left, right, math.Pow)
 
 	// Comparison operators
 	case TokenEq:
@@ -225,6 +300,49 @@ func (e *Evaluator) evalBinary(expr *BinaryExpr, vars map[string]string) (Value,
 	}
 }
 
+// evalCall evaluates a function call. Arguments are evaluated left to right
+// before the function runs.
+func (e *Evaluator) evalCall(expr *CallExpr, vars map[string]string) (Value, error) {
+	fn, ok := functions[expr.Name]
+	if !ok {
+		return Value{}, fmt.Errorf("unknown function: %s", expr.Name)
+	}
+
+	args := make([]Value, len(expr.Args))
+	for i, arg := range expr.Args {
+		v, err := e.eval(arg, vars)
+		if err != nil {
+			return Value{}, err
+		}
+		args[i] = v
+	}
+	return fn.call(args)
+}
+
+// evalIn evaluates a membership test. Elements are compared with the same
+// rules as ==, in order, stopping at the first match.
+func (e *Evaluator) evalIn(expr *InExpr, vars map[string]string) (Value, error) {
+	left, err := e.eval(expr.Left, vars)
+	if err != nil {
+		return Value{}, err
+	}
+
+	for _, elem := range expr.Elements {
+		right, err := e.eval(elem, vars)
+		if err != nil {
+			return Value{}, err
+		}
+		eq, err := e.evalComparison(left, right, func(cmp int) bool { return cmp == 0 })
+		if err != nil {
+			return Value{}, err
+		}
+		if eq.AsBool() {
+			return NewValue("true"), nil
+		}
+	}
+	return NewValue("false"), nil
+}
+
 // evalArithmetic evaluates an arithmetic expression.
 func (e *Evaluator) evalArithmetic(left, right Value, op func(a, b float64) float64) (Value, error) {
 	lf, lok := left.AsFloat()
@@ -235,6 +353,9 @@ func (e *Evaluator) evalArithmetic(left, right Value, op func(a, b float64) floa
 	}
 
 	result := op(lf, rf)
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return Value{}, fmt.Errorf("arithmetic result is not a finite number (left=%q right=%q)", left.raw, right.raw)
+	}
 	return NewValue(formatFloat(result)), nil
 }
 
@@ -1,6 +1,7 @@
 package assert
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -71,6 +72,42 @@ func TestEvaluator_Variables(t *testing.T) {
 			vars: map[string]string{},
 			want: false,
 		},
+		{
+			name: "boolean string true",
+			expr: "force",
+			vars: map[string]string{"force": "true"},
+			want: true,
+		},
+		{
+			name: "boolean string false",
+			expr: "force",
+			vars: map[string]string{"force": "false"},
+			want: false,
+		},
+		{
+			name: "boolean string false is case-insensitive",
+			expr: "force",
+			vars: map[string]string{"force": "FALSE"},
+			want: false,
+		},
+		{
+			name: "negated boolean string",
+			expr: "!force",
+			vars: map[string]string{"force": "False"},
+			want: true,
+		},
+		{
+			name: "other non-empty string is truthy",
+			expr: "force",
+			vars: map[string]string{"force": "yes"},
+			want: true,
+		},
+		{
+			name: "namespaced variables",
+			expr: "unit-test.coverage - 5 >= coverage && lint.issues == 0",
+			vars: map[string]string{"unit-test.coverage": "85", "coverage": "80", "lint.issues": "0"},
+			want: true,
+		},
 	}
 
 	e := New()
@@ -166,6 +203,19 @@ func TestEvaluator_Arithmetic(t *testing.T) {
 		{"subtraction", "10 - 5 == 5", nil, true},
 		{"multiplication", "10 * 5 == 50", nil, true},
 		{"division", "10 / 5 == 2", nil, true},
+		{"modulo", "10 % 3 == 1", nil, true},
+		{"modulo even", "size_mb % 2 == 0", map[string]string{"size_mb": "42"}, true},
+		{"modulo float", "5.5 % 2 == 1.5", nil, true},
+		{"modulo negative dividend", "-7 % 3 == -1", nil, true},
+		{"modulo negative divisor", "7 % -3 == 1", nil, true},
+		{"modulo precedence", "1 + 10 % 4 == 3", nil, true},
+		{"modulo left-associative", "20 % 7 % 4 == 2", nil, true},
+		{"exponent", "2 ** 10 == 1024", nil, true},
+		{"exponent fractional", "9 ** 0.5 == 3", nil, true},
+		{"exponent negative power", "2 ** -1 == 0.5", nil, true},
+		{"exponent right-associative", "2 ** 3 ** 2 == 512", nil, true},
+		{"exponent binds tighter than multiply", "3 * 2 ** 2 == 12", nil, true},
+		{"exponent grouped", "(3 * 2) ** 2 == 36", nil, true},
 		{"complex expression", "(10 + 5) * 2 == 30", nil, true},
 		{"precedence", "10 + 5 * 2 == 20", nil, true},
 		{
@@ -269,6 +319,10 @@ func TestEvaluator_UnaryMinus(t *testing.T) {
 		{"negative number", "-5 < 0", true},
 		{"negative in expr", "10 + -5 == 5", true},
 		{"double negative", "--5 == 5", true},
+		{"exponent binds tighter than unary minus", "-2 ** 2 == -4", true},
+		{"negated base in parens", "(-2) ** 2 == 4", true},
+		{"unary minus exponent", "2 ** -2 * 4 == 1", true},
+		{"unary minus with modulo", "-10 % 3 == -1", true},
 	}
 
 	e := New()
@@ -319,6 +373,7 @@ func TestEvaluator_ParseErrors(t *testing.T) {
 		{"missing operand", "10 +"},
 		{"unclosed paren", "(10 + 5"},
 		{"missing paren content", "()"},
+		{"trailing tokens", "coverage 80"},
 	}
 
 	e := New()
@@ -411,6 +466,21 @@ func TestParser_FormatErrorPointerPosition(t *testing.T) {
 			expr:           "a +@",
 			wantPointerPos: 2, // pos=3, so 2 spaces before ^ (pointing at @)
 		},
+		{
+			name:           "ternary missing else",
+			expr:           "a ? b",
+			wantPointerPos: 4, // pos=5 (end of input)
+		},
+		{
+			name:           "ternary missing then",
+			expr:           "a ? : b",
+			wantPointerPos: 3, // pos=4, pointing before ':'
+		},
+		{
+			name:           "colon without question",
+			expr:           "a : b",
+			wantPointerPos: 1, // pos=2
+		},
 	}
 
 	e := New()
@@ -542,6 +612,31 @@ func TestEvaluator_DivisionByZero(t *testing.T) {
 	}
 }
 
+func TestEvaluator_ModuloByZero(t *testing.T) {
+	e := New()
+
+	// Modulo by zero returns 0, matching division
+	for _, expr := range []string{"10 % 0 == 0", "2.5 % 0 == 0"} {
+		result, err := e.Eval(expr, nil)
+		if err != nil {
+			t.Fatalf("Eval(%q) unexpected error: %v", expr, err)
+		}
+		if !result {
+			t.Errorf("Eval(%q) expected modulo by zero to return 0", expr)
+		}
+	}
+}
+
+func TestEvaluator_ExponentNonFinite(t *testing.T) {
+	e := New()
+	for _, expr := range []string{"0 ** -1 > 0", "(-8) ** 0.5 > 0", "10 ** 400 > 0"} {
+		_, err := e.Eval(expr, nil)
+		if err == nil || !strings.Contains(err.Error(), "not a finite number") {
+			t.Errorf("Eval(%q) expected non-finite error, got %v", expr, err)
+		}
+	}
+}
+
 func TestEvaluator_SingleQuoteStrings(t *testing.T) {
 	e := New()
 
@@ -681,3 +776,315 @@ func TestValue_IsNumeric(t *testing.T) {
 		}
 	}
 }
+
+func TestEvaluator_Functions(t *testing.T) {
+	vars := map[string]string{
+		"output":   "ok  pkg/a\nFAIL pkg/b\n",
+		"version":  "1.24.4",
+		"errors":   "",
+		"name":     "héllo",
+		"coverage": "84.2",
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`contains(output, "FAIL")`, true},
+		{`contains(output, "PANIC")`, false},
+		{`!contains(output, "FAIL")`, false},
+		{`matches(version, "^1\.")`, true},
+		{`matches(version, "^2\.")`, false},
+		{`matches(output, "(?m)^FAIL\s")`, true},
+		{`len(errors) == 0`, true},
+		{`len(undefined_var) == 0`, true},
+		{`len(name) == 5`, true},
+		{`len(version) > 3 && contains(version, "24")`, true},
+		{`len("abc") + 1 == 4`, true},
+		{`contains(version, len(name))`, false},
+		{`between(coverage, 70, 100)`, true},
+		{`between(70, 70, 100) && between(100, 70, 100)`, true},
+		{`between(coverage, 90, 100)`, false},
+		{`approx(1.0/3*3, 1.0, 0.0001)`, true},
+		{`approx(0.1 + 0.2, 0.3)`, true},
+		{`approx(coverage, 85, 0.5)`, false},
+		{`approx(coverage, 85, 1)`, true},
+	}
+
+	e := New()
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := e.Eval(tt.expr, vars)
+			if err != nil {
+				t.Fatalf("Eval(%q) unexpected error: %v", tt.expr, err)
+			}
+			if got != tt.want {
+				t.Errorf("Eval(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluator_FunctionParseErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		expr        string
+		wantContain string
+	}{
+		{"unknown function", "size(output) > 0", `unknown function "size"`},
+		{"lists available functions", "size(output)", "available: approx, between, contains, len, matches"},
+		{"optional argument out of range", `approx(1)`, "function approx expects 2 to 3 arguments, got 1"},
+		{"too few arguments", `contains(output)`, "function contains expects 2 argument(s), got 1"},
+		{"too many arguments", `len(a, b)`, "function len expects 1 argument(s), got 2"},
+		{"no arguments", `len()`, "function len expects 1 argument(s), got 0"},
+		{"missing separator", `contains(a "b")`, "expected ',' or ')'"},
+	}
+
+	e := New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := e.Eval(tt.expr, nil)
+			if err == nil {
+				t.Fatalf("expected error for %q, got nil", tt.expr)
+			}
+			if !strings.Contains(err.Error(), "parse error") {
+				t.Errorf("expected a parse error, got: %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantContain) {
+				t.Errorf("error %q should contain %q", err.Error(), tt.wantContain)
+			}
+		})
+	}
+}
+
+func TestEvaluator_FunctionInvalidRegex(t *testing.T) {
+	e := New()
+	_, err := e.Eval(`matches(version, "(")`, map[string]string{"version": "1.0"})
+	if err == nil || !strings.Contains(err.Error(), "invalid pattern") {
+		t.Errorf("expected invalid pattern error, got %v", err)
+	}
+}
+
+func TestEvaluator_NumericFunctionErrors(t *testing.T) {
+	e := New()
+	for expr, want := range map[string]string{
+		`between(coverage, 70, 100)`: `between requires numeric arguments, got "" for argument 1`,
+		`approx(1, "one")`:           `approx requires numeric arguments, got "one" for argument 2`,
+		`approx(1, 1, -0.1)`:         "approx: tolerance must not be negative",
+	} {
+		_, err := e.Eval(expr, nil)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Eval(%q): expected error containing %q, got %v", expr, want, err)
+		}
+	}
+}
+
+func TestEvaluator_FunctionShortCircuit(t *testing.T) {
+	// The invalid regex is never evaluated because the result is already known
+	e := New()
+	for _, expr := range []string{
+		`false && matches(x, "(")`,
+		`true || matches(x, "(")`,
+	} {
+		if _, err := e.Eval(expr, nil); err != nil {
+			t.Errorf("Eval(%q) should short-circuit, got error: %v", expr, err)
+		}
+	}
+}
+
+func TestEvaluator_Conditional(t *testing.T) {
+	vars := map[string]string{
+		"strict":   "true",
+		"lenient":  "false",
+		"coverage": "75",
+		"flag":     "",
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"strict ? coverage >= 90 : coverage >= 70", false},
+		{"lenient ? coverage >= 90 : coverage >= 70", true},
+		// Right-associative: a ? b : (c ? d : e)
+		{"false ? 1 : true ? 0 : 1", false},
+		{"(false ? 1 : true) ? 1 : 0", true},
+		{"false ? 0 : false ? 0 : 1", true},
+		// Lower precedence than ||: (x || y) ? 1 : 0
+		{"false || true ? 1 : 0", true},
+		{"coverage > 50 && strict ? 1 : 0", true},
+		// Branches can be nested ternaries or any other expression
+		{"strict ? (lenient ? 0 : 1) : 0", true},
+		{"(strict ? 10 : 20) + 5 == 15", true},
+		// Branch results are coerced like any other value
+		{`(strict ? 10 : "5") > 3`, true},
+		{`(flag ? 10 : "5") > 3`, true},
+		{`(flag ? 10 : "abc") == "abc"`, true},
+	}
+
+	e := New()
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := e.Eval(tt.expr, vars)
+			if err != nil {
+				t.Fatalf("Eval(%q) unexpected error: %v", tt.expr, err)
+			}
+			if got != tt.want {
+				t.Errorf("Eval(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluator_ConditionalOnlyEvaluatesTakenBranch(t *testing.T) {
+	e := New()
+	for _, expr := range []string{
+		`true ? 1 : matches(x, "(")`,
+		`false ? matches(x, "(") : 1`,
+	} {
+		got, err := e.Eval(expr, nil)
+		if err != nil {
+			t.Errorf("Eval(%q) should not evaluate the other branch, got error: %v", expr, err)
+		}
+		if !got {
+			t.Errorf("Eval(%q) = false, want true", expr)
+		}
+	}
+}
+
+func TestEvaluator_ConditionalParseErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		expr        string
+		wantContain string
+	}{
+		{"missing else", "a ? b", "expected ':'"},
+		{"missing then", "a ? : b", `unexpected token ":"`},
+		{"colon without question", "a : b", `unexpected token ":"`},
+		{"missing else expression", "a ? b :", "unexpected token"},
+	}
+
+	e := New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := e.Eval(tt.expr, nil)
+			if err == nil {
+				t.Fatalf("expected error for %q, got nil", tt.expr)
+			}
+			if !strings.Contains(err.Error(), tt.wantContain) {
+				t.Errorf("error %q should contain %q", err.Error(), tt.wantContain)
+			}
+		})
+	}
+}
+
+func TestEvaluator_In(t *testing.T) {
+	vars := map[string]string{
+		"status":  "passed",
+		"code":    "2",
+		"exit":    "2.0",
+		"allowed": "passed",
+		"empty":   "",
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`status in ["ok", "passed", "clean"]`, true},
+		{`status in ["ok", "clean"]`, false},
+		{`status in []`, false},
+		{`empty in []`, false},
+		{`empty in [""]`, true},
+		{`missing in ["", "x"]`, true},
+		// Variables on both sides
+		{`status in [allowed]`, true},
+		{`status in ["ok", allowed]`, true},
+		{`code in [exit]`, true},
+		// Numeric elements compare numerically when both sides are numbers
+		{`code in [1, 2, 3]`, true},
+		{`exit in [2]`, true},
+		{`code in ["2.0"]`, true},
+		{`code in [-2, 20]`, false},
+		// Non-numeric values fall back to string comparison
+		{`status in [1, 2]`, false},
+		{`"2" in ["02"]`, true},
+		{`"v2" in ["v02"]`, false},
+		// Elements may be expressions
+		{`code in [1 + 1]`, true},
+		// Composes with other operators
+		{`!(status in ["failed"])`, true},
+		{`status in ["passed"] && code in [2]`, true},
+		{`status in ["x"] || code in [2]`, true},
+		{`code + 1 in [3]`, true},
+		{`status in ["passed"] == true`, true},
+	}
+
+	e := New()
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := e.Eval(tt.expr, vars)
+			if err != nil {
+				t.Fatalf("Eval(%q) unexpected error: %v", tt.expr, err)
+			}
+			if got != tt.want {
+				t.Errorf("Eval(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluator_InStopsAtFirstMatch(t *testing.T) {
+	// The invalid regex is never evaluated because an earlier element matched
+	e := New()
+	got, err := e.Eval(`"a" in ["a", matches(x, "(")]`, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got {
+		t.Error("expected match on the first element")
+	}
+}
+
+func TestEvaluator_InParseErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		expr        string
+		wantContain string
+	}{
+		{"missing list", `status in "ok"`, "expected '[' after 'in'"},
+		{"unclosed list", `status in ["ok"`, "expected ',' or ']'"},
+		{"missing separator", `status in ["ok" "x"]`, "expected ',' or ']'"},
+		{"trailing comma", `status in ["ok",]`, "expected element after ','"},
+		{"bare list", `["ok"]`, `unexpected token "["`},
+		{"missing left operand", `in ["ok"]`, `unexpected token "in"`},
+	}
+
+	e := New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := e.Eval(tt.expr, nil)
+			if err == nil {
+				t.Fatalf("expected error for %q, got nil", tt.expr)
+			}
+			if !strings.Contains(err.Error(), tt.wantContain) {
+				t.Errorf("error %q should contain %q", err.Error(), tt.wantContain)
+			}
+		})
+	}
+}
+
+func TestVariables(t *testing.T) {
+	got, err := Variables(`exit_code == 0 || (contains(output, "ok") && warnings < max) || status in [expected, "ok"] || warnings > 10`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"exit_code", "output", "warnings", "max", "status", "expected"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Variables() = %v, want %v", got, want)
+	}
+
+	if _, err := Variables("a >"); err == nil || !strings.Contains(err.Error(), "parse error") {
+		t.Errorf("expected a parse error, got %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package assert
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// function is a builtin callable from assertion expressions.
+type function struct {
+	arity    int // Maximum number of arguments
+	minArity int // Minimum number of arguments, if fewer than arity are allowed
+	call     func(args []Value) (Value, error)
+}
+
+// DefaultApproxTolerance is the tolerance approx uses when none is given.
+const DefaultApproxTolerance = 1e-9
+
+// functions is the registry of builtin functions, keyed by name.
+// Arity is checked at parse time.
+var functions = map[string]function{
+	// contains(s, substr) reports whether substr is within s.
+	"contains": {arity: 2, call: func(args []Value) (Value, error) {
+		return boolValue(strings.Contains(args[0].raw, args[1].raw)), nil
+	}},
+
+	// matches(s, pattern) reports whether the regular expression pattern
+	// (RE2 syntax) matches anywhere in s.
+	"matches": {arity: 2, call: func(args []Value) (Value, error) {
+		re, err := regexp.Compile(args[1].raw)
+		if err != nil {
+			return Value{}, fmt.Errorf("matches: invalid pattern %q: %w", args[1].raw, err)
+		}
+		return boolValue(re.MatchString(args[0].raw)), nil
+	}},
+
+	// len(s) returns the number of characters in s. Undefined variables
+	// are empty, so their length is 0.
+	"len": {arity: 1, call: func(args []Value) (Value, error) {
+		return NewValue(formatFloat(float64(utf8.RuneCountInString(args[0].raw)))), nil
+	}},
+
+	// between(x, lo, hi) reports whether lo <= x <= hi.
+	"between": {arity: 3, call: func(args []Value) (Value, error) {
+		nums, err := numericArgs("between", args)
+		if err != nil {
+			return Value{}, err
+		}
+		return boolValue(nums[0] >= nums[1] && nums[0] <= nums[2]), nil
+	}},
+
+	// approx(a, b[, tolerance]) reports whether a and b differ by at most
+	// tolerance, DefaultApproxTolerance if omitted.
+	"approx": {arity: 3, minArity: 2, call: func(args []Value) (Value, error) {
+		nums, err := numericArgs("approx", args)
+		if err != nil {
+			return Value{}, err
+		}
+		tolerance := DefaultApproxTolerance
+		if len(nums) == 3 {
+			tolerance = nums[2]
+		}
+		if tolerance < 0 {
+			return Value{}, fmt.Errorf("approx: tolerance must not be negative, got %s", args[2].raw)
+		}
+		return boolValue(math.Abs(nums[0]-nums[1]) <= tolerance), nil
+	}},
+}
+
+// numericArgs parses every argument of the named function as a number.
+func numericArgs(name string, args []Value) ([]float64, error) {
+	nums := make([]float64, len(args))
+	for i, arg := range args {
+		f, ok := arg.AsFloat()
+		if !ok {
+			return nil, fmt.Errorf("%s requires numeric arguments, got %q for argument %d", name, arg.raw, i+1)
+		}
+		nums[i] = f
+	}
+	return nums, nil
+}
+
+// functionNames returns the builtin function names, sorted and comma-separated.
+func functionNames() string {
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// boolValue converts a Go bool to a Value.
+func boolValue(b bool) Value {
+	if b {
+		return NewValue("true")
+	}
+	return NewValue("false")
+}
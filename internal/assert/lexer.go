@@ -51,13 +51,31 @@ func (l *Lexer) NextToken() Token {
 	case '-':
 		tok = Token{Type: TokenMinus, Literal: string(l.ch), Pos: l.pos}
 	case '*':
-		tok = Token{Type: TokenAsterisk, Literal: string(l.ch), Pos: l.pos}
+		if l.peekChar() == '*' {
+			pos := l.pos
+			l.readChar()
+			tok = Token{Type: TokenPower, Literal: "**", Pos: pos}
+		} else {
+			tok = Token{Type: TokenAsterisk, Literal: string(l.ch), Pos: l.pos}
+		}
 	case '/':
 		tok = Token{Type: TokenSlash, Literal: string(l.ch), Pos: l.pos}
+	case '%':
+		tok = Token{Type: TokenPercent, Literal: string(l.ch), Pos: l.pos}
 	case '(':
 		tok = Token{Type: TokenLParen, Literal: string(l.ch), Pos: l.pos}
 	case ')':
 		tok = Token{Type: TokenRParen, Literal: string(l.ch), Pos: l.pos}
+	case '[':
+		tok = Token{Type: TokenLBracket, Literal: string(l.ch), Pos: l.pos}
+	case ']':
+		tok = Token{Type: TokenRBracket, Literal: string(l.ch), Pos: l.pos}
+	case ',':
+		tok = Token{Type: TokenComma, Literal: string(l.ch), Pos: l.pos}
+	case '?':
+		tok = Token{Type: TokenQuestion, Literal: string(l.ch), Pos: l.pos}
+	case ':':
+		tok = Token{Type: TokenColon, Literal: string(l.ch), Pos: l.pos}
 	case '=':
 		if l.peekChar() == '=' {
 			pos := l.pos
@@ -143,15 +161,39 @@ func (l *Lexer) skipWhitespace() {
 	}
 }
 
-// readIdentifier reads an identifier (variable name).
+// readIdentifier reads an identifier (variable name). A variable of another
+// check is namespaced by its ID, as in test.coverage; since check IDs may
+// contain hyphens, a-b.c reads as the variable c of check a-b.
 func (l *Lexer) readIdentifier() string {
 	pos := l.pos
 	for isLetter(l.ch) || isDigit(l.ch) || l.ch == '_' {
 		l.readChar()
 	}
+	if end := l.namespaceEnd(); end > l.pos {
+		for l.pos < end {
+			l.readChar()
+		}
+		for isLetter(l.ch) || isDigit(l.ch) || l.ch == '_' {
+			l.readChar()
+		}
+	}
 	return l.input[pos:l.pos]
 }
 
+// namespaceEnd returns the position just after the dot of a check ID
+// namespace continuing the identifier at the current position, or the
+// current position if there is none.
+func (l *Lexer) namespaceEnd() int {
+	i := l.pos
+	for i < len(l.input) && (isLetter(l.input[i]) || isDigit(l.input[i]) || l.input[i] == '_' || l.input[i] == '-') {
+		i++
+	}
+	if i+1 >= len(l.input) || l.input[i] != '.' || l.input[i-1] == '-' || !(isLetter(l.input[i+1]) || l.input[i+1] == '_') {
+		return l.pos
+	}
+	return i + 1
+}
+
 // readNumber reads a numeric literal (int or float).
 func (l *Lexer) readNumber() string {
 	pos := l.pos
@@ -201,6 +243,8 @@ func lookupIdent(ident string) TokenType {
 	switch ident {
 	case "true", "false":
 		return TokenBool
+	case "in":
+		return TokenIn
 	default:
 		return TokenIdent
 	}
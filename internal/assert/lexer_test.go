@@ -26,6 +26,89 @@ func TestLexer_Tokens(t *testing.T) {
 				{Type: TokenEOF, Literal: "", Pos: 4},
 			},
 		},
+		{
+			name:  "function call",
+			input: `len(a, "b")`,
+			tokens: []Token{
+				{Type: TokenIdent, Literal: "len", Pos: 0},
+				{Type: TokenLParen, Literal: "(", Pos: 3},
+				{Type: TokenIdent, Literal: "a", Pos: 4},
+				{Type: TokenComma, Literal: ",", Pos: 5},
+				{Type: TokenString, Literal: "b", Pos: 7},
+				{Type: TokenRParen, Literal: ")", Pos: 10},
+				{Type: TokenEOF, Literal: "", Pos: 11},
+			},
+		},
+		{
+			name:  "modulo and exponent",
+			input: "a % 2 ** 3 * 4",
+			tokens: []Token{
+				{Type: TokenIdent, Literal: "a", Pos: 0},
+				{Type: TokenPercent, Literal: "%", Pos: 2},
+				{Type: TokenNumber, Literal: "2", Pos: 4},
+				{Type: TokenPower, Literal: "**", Pos: 6},
+				{Type: TokenNumber, Literal: "3", Pos: 9},
+				{Type: TokenAsterisk, Literal: "*", Pos: 11},
+				{Type: TokenNumber, Literal: "4", Pos: 13},
+				{Type: TokenEOF, Literal: "", Pos: 14},
+			},
+		},
+		{
+			name:  "membership",
+			input: `s in ["a", 1]`,
+			tokens: []Token{
+				{Type: TokenIdent, Literal: "s", Pos: 0},
+				{Type: TokenIn, Literal: "in", Pos: 2},
+				{Type: TokenLBracket, Literal: "[", Pos: 5},
+				{Type: TokenString, Literal: "a", Pos: 6},
+				{Type: TokenComma, Literal: ",", Pos: 9},
+				{Type: TokenNumber, Literal: "1", Pos: 11},
+				{Type: TokenRBracket, Literal: "]", Pos: 12},
+				{Type: TokenEOF, Literal: "", Pos: 13},
+			},
+		},
+		{
+			name:  "namespaced identifiers",
+			input: "unit-test.coverage - lint.issues_count-1",
+			tokens: []Token{
+				{Type: TokenIdent, Literal: "unit-test.coverage", Pos: 0},
+				{Type: TokenMinus, Literal: "-", Pos: 19},
+				{Type: TokenIdent, Literal: "lint.issues_count", Pos: 21},
+				{Type: TokenMinus, Literal: "-", Pos: 38},
+				{Type: TokenNumber, Literal: "1", Pos: 39},
+				{Type: TokenEOF, Literal: "", Pos: 40},
+			},
+		},
+		{
+			name:  "hyphen without a namespace is minus",
+			input: "a-b",
+			tokens: []Token{
+				{Type: TokenIdent, Literal: "a", Pos: 0},
+				{Type: TokenMinus, Literal: "-", Pos: 1},
+				{Type: TokenIdent, Literal: "b", Pos: 2},
+				{Type: TokenEOF, Literal: "", Pos: 3},
+			},
+		},
+		{
+			name:  "identifier starting with in",
+			input: "index",
+			tokens: []Token{
+				{Type: TokenIdent, Literal: "index", Pos: 0},
+				{Type: TokenEOF, Literal: "", Pos: 5},
+			},
+		},
+		{
+			name:  "conditional",
+			input: "a ? 1 : 2",
+			tokens: []Token{
+				{Type: TokenIdent, Literal: "a", Pos: 0},
+				{Type: TokenQuestion, Literal: "?", Pos: 2},
+				{Type: TokenNumber, Literal: "1", Pos: 4},
+				{Type: TokenColon, Literal: ":", Pos: 6},
+				{Type: TokenNumber, Literal: "2", Pos: 8},
+				{Type: TokenEOF, Literal: "", Pos: 9},
+			},
+		},
 		{
 			name:  "identifier",
 			input: "coverage",
@@ -245,6 +328,14 @@ func TestTokenType_String(t *testing.T) {
 		{TokenNot, "!"},
 		{TokenLParen, "("},
 		{TokenRParen, ")"},
+		{TokenComma, ","},
+		{TokenIn, "in"},
+		{TokenLBracket, "["},
+		{TokenRBracket, "]"},
+		{TokenPercent, "%"},
+		{TokenPower, "**"},
+		{TokenQuestion, "?"},
+		{TokenColon, ":"},
 		{TokenType(999), "UNKNOWN"}, // Test default case
 	}
 
@@ -41,36 +41,51 @@ func (p *Parser) formatError(pos int, msg string) string {
 	return fmt.Sprintf("%s\n  %s\n  %s", msg, p.input, pointer)
 }
 
-// Parse parses the input and returns the AST.
+// Parse parses the input and returns the AST. The whole input must form a
+// single expression; leftover tokens (such as a stray ':') are an error.
 func (p *Parser) Parse() (Expr, error) {
-	return p.parseExpr(PrecLowest)
+	expr, err := p.parseExpr(PrecLowest)
+	if err != nil {
+		return nil, err
+	}
+	if p.cur.Type != TokenEOF {
+		msg := fmt.Sprintf("unexpected token %q at position %d", p.cur.Literal, p.cur.Pos)
+		return nil, fmt.Errorf("%s", p.formatError(p.cur.Pos, msg))
+	}
+	return expr, nil
 }
 
 // Precedence levels (lowest to highest).
 const (
 	PrecLowest  = iota
+	PrecTernary // ?:
 	PrecOr      // ||
 	PrecAnd     // &&
-	PrecCompare // ==, !=, <, <=, >, >=
+	PrecCompare // ==, !=, <, <=, >, >=, in
 	PrecSum     // +, -
-	PrecProduct // *, /
+	PrecProduct // *, /, %
 	PrecUnary   // !, -
+	PrecPower   // **
 	PrecPrimary // literals, identifiers, parentheses
 )
 
 // precedence returns the precedence level for a token type.
 func precedence(t TokenType) int {
 	switch t {
+	case TokenQuestion:
+		return PrecTernary
 	case TokenOr:
 		return PrecOr
 	case TokenAnd:
 		return PrecAnd
-	case TokenEq, TokenNotEq, TokenLT, TokenLTE, TokenGT, TokenGTE:
+	case TokenEq, TokenNotEq, TokenLT, TokenLTE, TokenGT, TokenGTE, TokenIn:
 		return PrecCompare
 	case TokenPlus, TokenMinus:
 		return PrecSum
-	case TokenAsterisk, TokenSlash:
+	case TokenAsterisk, TokenSlash, TokenPercent:
 		return PrecProduct
+	case TokenPower:
+		return PrecPower
 	default:
 		return PrecLowest
 	}
@@ -139,13 +154,64 @@ func (p *Parser) parseBool() (Expr, error) {
 	return lit, nil
 }
 
-// parseIdent parses an identifier.
+// parseIdent parses an identifier, or a function call when the identifier is
+// followed by '('.
 func (p *Parser) parseIdent() (Expr, error) {
+	if p.peek.Type == TokenLParen {
+		return p.parseCall()
+	}
 	ident := &Ident{Name: p.cur.Literal}
 	p.nextToken()
 	return ident, nil
 }
 
+// parseCall parses a function call and checks the function exists and is
+// given the right number of arguments.
+func (p *Parser) parseCall() (Expr, error) {
+	name := p.cur
+	fn, ok := functions[name.Literal]
+	if !ok {
+		msg := fmt.Sprintf("unknown function %q at position %d (available: %s)", name.Literal, name.Pos, functionNames())
+		return nil, fmt.Errorf("%s", p.formatError(name.Pos, msg))
+	}
+
+	p.nextToken() // consume name
+	p.nextToken() // consume '('
+
+	var args []Expr
+	for p.cur.Type != TokenRParen {
+		arg, err := p.parseExpr(PrecLowest)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+
+		if p.cur.Type == TokenComma {
+			p.nextToken()
+			if p.cur.Type == TokenRParen {
+				msg := fmt.Sprintf("expected argument after ',' at position %d", p.cur.Pos)
+				return nil, fmt.Errorf("%s", p.formatError(p.cur.Pos, msg))
+			}
+			continue
+		}
+		if p.cur.Type != TokenRParen {
+			msg := fmt.Sprintf("expected ',' or ')' at position %d, got %q", p.cur.Pos, p.cur.Literal)
+			return nil, fmt.Errorf("%s", p.formatError(p.cur.Pos, msg))
+		}
+	}
+	p.nextToken() // consume ')'
+
+	if fn.minArity > 0 && (len(args) < fn.minArity || len(args) > fn.arity) {
+		msg := fmt.Sprintf("function %s expects %d to %d arguments, got %d", name.Literal, fn.minArity, fn.arity, len(args))
+		return nil, fmt.Errorf("%s", p.formatError(name.Pos, msg))
+	}
+	if fn.minArity == 0 && len(args) != fn.arity {
+		msg := fmt.Sprintf("function %s expects %d argument(s), got %d", name.Literal, fn.arity, len(args))
+		return nil, fmt.Errorf("%s", p.formatError(name.Pos, msg))
+	}
+	return &CallExpr{Name: name.Literal, Args: args}, nil
+}
+
 // parseParen parses a parenthesized expression.
 func (p *Parser) parseParen() (Expr, error) {
 	p.nextToken() // consume '('
@@ -172,10 +238,75 @@ func (p *Parser) parseUnary() (Expr, error) {
 	return &UnaryExpr{Op: op, Right: right}, nil
 }
 
+// parseConditional parses the branches of a conditional expression whose
+// condition has already been parsed. The operator is right-associative, so
+// "a ? b : c ? d : e" groups as "a ? b : (c ? d : e)".
+func (p *Parser) parseConditional(cond Expr) (Expr, error) {
+	p.nextToken() // consume '?'
+	then, err := p.parseExpr(PrecLowest)
+	if err != nil {
+		return nil, err
+	}
+	if p.cur.Type != TokenColon {
+		msg := fmt.Sprintf("expected ':' at position %d, got %q", p.cur.Pos, p.cur.Literal)
+		return nil, fmt.Errorf("%s", p.formatError(p.cur.Pos, msg))
+	}
+	p.nextToken() // consume ':'
+	els, err := p.parseExpr(PrecTernary - 1)
+	if err != nil {
+		return nil, err
+	}
+	return &ConditionalExpr{Cond: cond, Then: then, Else: els}, nil
+}
+
+// parseIn parses the list literal on the right of an 'in' operator.
+func (p *Parser) parseIn(left Expr) (Expr, error) {
+	p.nextToken() // consume 'in'
+	if p.cur.Type != TokenLBracket {
+		msg := fmt.Sprintf("expected '[' after 'in' at position %d, got %q", p.cur.Pos, p.cur.Literal)
+		return nil, fmt.Errorf("%s", p.formatError(p.cur.Pos, msg))
+	}
+	p.nextToken() // consume '['
+
+	elements := []Expr{}
+	for p.cur.Type != TokenRBracket {
+		elem, err := p.parseExpr(PrecLowest)
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, elem)
+
+		if p.cur.Type == TokenComma {
+			p.nextToken()
+			if p.cur.Type == TokenRBracket {
+				msg := fmt.Sprintf("expected element after ',' at position %d", p.cur.Pos)
+				return nil, fmt.Errorf("%s", p.formatError(p.cur.Pos, msg))
+			}
+			continue
+		}
+		if p.cur.Type != TokenRBracket {
+			msg := fmt.Sprintf("expected ',' or ']' at position %d, got %q", p.cur.Pos, p.cur.Literal)
+			return nil, fmt.Errorf("%s", p.formatError(p.cur.Pos, msg))
+		}
+	}
+	p.nextToken() // consume ']'
+	return &InExpr{Left: left, Elements: elements}, nil
+}
+
 // parseInfix parses a binary (infix) expression.
 func (p *Parser) parseInfix(left Expr) (Expr, error) {
+	switch p.cur.Type {
+	case TokenQuestion:
+		return p.parseConditional(left)
+	case TokenIn:
+		return p.parseIn(left)
+	}
 	op := p.cur.Type
 	prec := precedence(op)
+	if op == TokenPower {
+		// Right-associative: "2 ** 3 ** 2" groups as "2 ** (3 ** 2)"
+		prec--
+	}
 	p.nextToken()
 	right, err := p.parseExpr(prec)
 	if err != nil {
@@ -196,6 +196,13 @@ func TestParser_ParseSimpleExpression(t *testing.T) {
 		{"invalid token at start", "@", true},
 		{"missing closing paren", "(x", true},
 		{"unexpected token after paren", "(x]", true},
+		{"function call", `contains(x, "a")`, false},
+		{"nested function call", `len(x) > len("ab")`, false},
+		{"unknown function", "nope(x)", true},
+		{"wrong arity", "len(x, y)", true},
+		{"missing comma", "contains(x y)", true},
+		{"unclosed call", "len(x", true},
+		{"trailing comma", "len(x,)", true},
 	}
 
 	for _, tt := range tests {
@@ -300,6 +307,7 @@ func TestParser_PrecedenceFunction(t *testing.T) {
 		tokenType TokenType
 		expected  int
 	}{
+		{TokenQuestion, PrecTernary},
 		{TokenOr, PrecOr},
 		{TokenAnd, PrecAnd},
 		{TokenEq, PrecCompare},
@@ -308,14 +316,18 @@ func TestParser_PrecedenceFunction(t *testing.T) {
 		{TokenLTE, PrecCompare},
 		{TokenGT, PrecCompare},
 		{TokenGTE, PrecCompare},
+		{TokenIn, PrecCompare},
 		{TokenPlus, PrecSum},
 		{TokenMinus, PrecSum},
 		{TokenAsterisk, PrecProduct},
 		{TokenSlash, PrecProduct},
+		{TokenPercent, PrecProduct},
+		{TokenPower, PrecPower},
 		{TokenNumber, PrecLowest},
 		{TokenString, PrecLowest},
 		{TokenIdent, PrecLowest},
 		{TokenEOF, PrecLowest},
+		{TokenColon, PrecLowest},
 	}
 
 	for _, tt := range tests {
@@ -332,12 +344,14 @@ func TestParser_PrecedenceOrderingBoundary(t *testing.T) {
 	// Verify strict precedence ordering (each should be distinct and ordered)
 	precedences := []int{
 		PrecLowest,
+		PrecTernary,
 		PrecOr,
 		PrecAnd,
 		PrecCompare,
 		PrecSum,
 		PrecProduct,
 		PrecUnary,
+		PrecPower,
 		PrecPrimary,
 	}
 
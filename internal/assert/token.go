@@ -20,6 +20,8 @@ const (
 	TokenMinus    // -
 	TokenAsterisk // *
 	TokenSlash    // /
+	TokenPercent  // %
+	TokenPower    // **
 
 	// Comparison operators
 	TokenEq    // ==
@@ -28,15 +30,23 @@ const (
 	TokenLTE   // <=
 	TokenGT    // >
 	TokenGTE   // >=
+	TokenIn    // in
 
 	// Logical operators
 	TokenAnd // &&
 	TokenOr  // ||
 	TokenNot // !
 
+	// Conditional operator
+	TokenQuestion // ?
+	TokenColon    // :
+
 	// Delimiters
-	TokenLParen // (
-	TokenRParen // )
+	TokenLParen   // (
+	TokenRParen   // )
+	TokenLBracket // [
+	TokenRBracket // ]
+	TokenComma    // ,
 )
 
 // Token represents a lexical token.
@@ -69,6 +79,10 @@ func (t TokenType) String() string {
 		return "*"
 	case TokenSlash:
 		return "/"
+	case TokenPercent:
+		return "%"
+	case TokenPower:
+		return "**"
 	case TokenEq:
 		return "=="
 	case TokenNotEq:
@@ -81,16 +95,28 @@ func (t TokenType) String() string {
 		return ">"
 	case TokenGTE:
 		return ">="
+	case TokenIn:
+		return "in"
 	case TokenAnd:
 		return "&&"
 	case TokenOr:
 		return "||"
 	case TokenNot:
 		return "!"
+	case TokenQuestion:
+		return "?"
+	case TokenColon:
+		return ":"
 	case TokenLParen:
 		return "("
 	case TokenRParen:
 		return ")"
+	case TokenLBracket:
+		return "["
+	case TokenRBracket:
+		return "]"
+	case TokenComma:
+		return ","
 	default:
 		return "UNKNOWN"
 	}
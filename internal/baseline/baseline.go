@@ -0,0 +1,93 @@
+// Package baseline classifies violations as new or known by comparing them
+// with a baseline: a JSON report saved from an earlier run.
+//
+// A violation matches the baseline when the baseline has a violation of the
+// same check with the same normalized message. The message is the check's
+// suggestion with extracted values interpolated; normalizing lowercases it,
+// collapses whitespace and replaces numbers with #, so a coverage violation
+// stays known while the coverage figure drifts.
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+	"github.com/vibeguard/vibeguard/internal/output"
+)
+
+// DefaultPath is the baseline file used when none is given.
+const DefaultPath = "vibeguard-baseline.json"
+
+// number matches integers and decimals in a violation message.
+var number = regexp.MustCompile(`[0-9]+(\.[0-9]+)?`)
+
+// Baseline is the set of violations recorded in a baseline file.
+type Baseline struct {
+	keys map[string]bool
+}
+
+// Load reads a baseline from a JSON report written by check --json or
+// baseline update.
+func Load(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path) // #nosec G304 - path is an explicit user-provided baseline file
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline: %w", err)
+	}
+
+	var report output.JSONOutput
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline %s: %w", path, err)
+	}
+
+	b := &Baseline{keys: make(map[string]bool, len(report.Violations))}
+	for _, v := range report.Violations {
+		b.keys[Key(v.ID, message(v.Suggestion, v.Extracted, v.Timedout))] = true
+	}
+	return b, nil
+}
+
+// Len returns the number of distinct violations in the baseline.
+func (b *Baseline) Len() int {
+	return len(b.keys)
+}
+
+// Apply marks the violations that appear in the baseline as known and
+// returns how many it marked.
+func (b *Baseline) Apply(violations []*orchestrator.Violation) int {
+	known := 0
+	for _, v := range violations {
+		if b.keys[Key(v.CheckID, message(v.Suggestion, v.Extracted, v.Timedout))] {
+			v.Known = true
+			known++
+		}
+	}
+	return known
+}
+
+// Key returns the key violations are matched on: the check ID and the
+// normalized message.
+func Key(checkID, message string) string {
+	return checkID + "\x00" + Normalize(message)
+}
+
+// Normalize lowercases message, collapses runs of whitespace and replaces
+// numbers with #.
+func Normalize(message string) string {
+	message = number.ReplaceAllString(strings.ToLower(message), "#")
+	return strings.Join(strings.Fields(message), " ")
+}
+
+// message returns the text a violation is matched on. Timeouts are
+// distinguished from failures of the same check.
+func message(suggestion string, extracted map[string]string, timedout bool) string {
+	msg := config.InterpolateWithExtracted(suggestion, nil, extracted)
+	if timedout {
+		return "timeout: " + msg
+	}
+	return msg
+}
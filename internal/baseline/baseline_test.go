@@ -0,0 +1,93 @@
+package baseline
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+func writeBaseline(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestApply_ClassifiesNewAndKnown(t *testing.T) {
+	path := writeBaseline(t, `{
+  "violations": [
+    {"id": "coverage", "severity": "error", "suggestion": "Coverage is {{.coverage}}%, below 80%", "extracted": {"coverage": "72.5"}, "timedout": false},
+    {"id": "lint", "severity": "error", "suggestion": "Run  golangci-lint   RUN", "timedout": false},
+    {"id": "slow", "severity": "error", "suggestion": "Speed up the tests", "timedout": true}
+  ]
+}`)
+	b, err := Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b.Len() != 3 {
+		t.Errorf("expected 3 baseline violations, got %d", b.Len())
+	}
+
+	violations := []*orchestrator.Violation{
+		// Same message once numbers are normalized
+		{CheckID: "coverage", Severity: config.SeverityError, Suggestion: "Coverage is {{.coverage}}%, below 80%", Extracted: map[string]string{"coverage": "70.1"}},
+		// Same message modulo case and whitespace
+		{CheckID: "lint", Severity: config.SeverityError, Suggestion: "run golangci-lint run"},
+		// Failing instead of timing out is a new violation
+		{CheckID: "slow", Severity: config.SeverityError, Suggestion: "Speed up the tests"},
+		// Different message
+		{CheckID: "lint", Severity: config.SeverityError, Suggestion: "Run gofmt"},
+		// Unknown check
+		{CheckID: "vet", Severity: config.SeverityError, Suggestion: "Run go vet"},
+	}
+
+	if got := b.Apply(violations); got != 2 {
+		t.Errorf("expected 2 known violations, got %d", got)
+	}
+	wantKnown := []bool{true, true, false, false, false}
+	for i, v := range violations {
+		if v.Known != wantKnown[i] {
+			t.Errorf("violation %d (%s): expected known=%v, got %v", i, v.CheckID, wantKnown[i], v.Known)
+		}
+	}
+
+	// Only new violations affect the exit code
+	if code := orchestrator.ExitCode(violations[:2], 1, false); code != 0 {
+		t.Errorf("expected known violations to exit 0, got %d", code)
+	}
+	if code := orchestrator.ExitCode(violations, 1, false); code != 1 {
+		t.Errorf("expected new violations to exit 1, got %d", code)
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.json")); err == nil || !strings.Contains(err.Error(), "failed to read baseline") {
+		t.Errorf("expected read error, got: %v", err)
+	}
+	if _, err := Load(writeBaseline(t, "not json")); err == nil || !strings.Contains(err.Error(), "failed to parse baseline") {
+		t.Errorf("expected parse error, got: %v", err)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		message string
+		want    string
+	}{
+		{"Coverage 72.5% < 80%", "coverage #% < #%"},
+		{"  Found 3\tissues\n", "found # issues"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.message); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.message, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+// Package cache stores the results of passing checks so that a re-run can
+// skip checks whose command and input files have not changed.
+//
+// A check's cache key hashes the vibeguard version, the check's interpolated
+// command and shell, and the path and contents of every file the check
+// covers: the files matching its paths globs, or every file in the project
+// if it declares none. Files are listed with git when the project is a git
+// repository, so ignored build outputs do not invalidate the cache. The file
+// list and hashes are computed once and shared by every check until Reset.
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/git"
+	"github.com/vibeguard/vibeguard/internal/glob"
+	"github.com/vibeguard/vibeguard/internal/version"
+)
+
+// DefaultDir is the default directory for cached results.
+const DefaultDir = ".vibeguard/cache"
+
+// Entry is the cached result of a check.
+type Entry struct {
+	Key        string    `json:"key"`
+	ExitCode   int       `json:"exit_code"`
+	Stdout     string    `json:"stdout"`
+	Stderr     string    `json:"stderr"`
+	DurationMS int64     `json:"duration_ms"`
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+// Cache reads and writes cached check results.
+type Cache struct {
+	dir  string
+	root string
+
+	mu     sync.Mutex
+	files  []string          // The project's files, nil until first listed
+	hashes map[string]string // Hashes of the project's files by path
+	resets int               // Number of Resets, so hashes begun before one are dropped
+}
+
+// New returns a cache storing entries in dir for the project at root. An
+// empty dir uses DefaultDir and an empty root the current working directory.
+func New(dir, root string) *Cache {
+	if dir == "" {
+		dir = DefaultDir
+	}
+	return &Cache{dir: dir, root: root}
+}
+
+// Dir returns the directory entries are stored in.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+// Reset forgets the file list and hashes computed by Key, so the next key
+// sees files changed since. Call it at the start of each run.
+func (c *Cache) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.files = nil
+	c.hashes = nil
+	c.resets++
+}
+
+// Key computes the cache key of check from its command and the contents of
+// the files it covers, as of the first Key since Reset.
+func (c *Cache) Key(ctx context.Context, check *config.Check) (string, error) {
+	files, err := c.projectFiles(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	for _, part := range []string{version.String(), check.Shell, check.Run} {
+		_, _ = fmt.Fprintf(h, "%d:%s\n", len(part), part)
+	}
+
+	for _, name := range files {
+		if len(check.Paths) > 0 && !glob.MatchAny(check.Paths, name) {
+			continue
+		}
+		sum, err := c.fileHash(name)
+		if err != nil {
+			return "", err
+		}
+		_, _ = fmt.Fprintf(h, "%s %s\n", sum, name)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Get returns the cached result of checkID if it was recorded under key.
+// The returned result is marked as cached.
+func (c *Cache) Get(checkID, key string) (*executor.Result, bool) {
+	data, err := os.ReadFile(c.entryPath(checkID))
+	if err != nil {
+		return nil, false
+	}
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Key != key {
+		return nil, false
+	}
+
+	return &executor.Result{
+		CheckID:  checkID,
+		ExitCode: entry.ExitCode,
+		Stdout:   entry.Stdout,
+		Stderr:   entry.Stderr,
+		Combined: entry.Stdout + entry.Stderr,
+		Success:  entry.ExitCode == 0,
+		Cached:   true,
+	}, true
+}
+
+// Put records result as the cached result of checkID under key, replacing
+// any previous entry.
+func (c *Cache) Put(checkID, key string, result *executor.Result) error {
+	entry := Entry{
+		Key:        key,
+		ExitCode:   result.ExitCode,
+		Stdout:     result.Stdout,
+		Stderr:     result.Stderr,
+		DurationMS: result.Duration.Milliseconds(),
+		RecordedAt: time.Now().UTC(),
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.dir, 0750); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	// Write to a temporary file and rename so readers never see a partial entry
+	tmp, err := os.CreateTemp(c.dir, checkID+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.entryPath(checkID)); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	return nil
+}
+
+// Clear removes every cached result.
+func (c *Cache) Clear() error {
+	if err := os.RemoveAll(c.dir); err != nil {
+		return fmt.Errorf("failed to clear cache: %w", err)
+	}
+	return nil
+}
+
+// entryPath returns the file holding the entry of checkID.
+func (c *Cache) entryPath(checkID string) string {
+	return filepath.Join(c.dir, checkID+".json")
+}
+
+// projectFiles returns the project's files, listing them on the first call
+// since Reset.
+func (c *Cache) projectFiles(ctx context.Context) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.files == nil {
+		files, err := c.listFiles(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.files = files
+		c.hashes = make(map[string]string, len(files))
+	}
+	return c.files, nil
+}
+
+// fileHash returns the hash of the file at name, hashing it on the first
+// call since Reset. Concurrent keys may hash the same file twice, which is
+// cheaper than holding the lock while reading it.
+func (c *Cache) fileHash(name string) (string, error) {
+	c.mu.Lock()
+	sum, ok := c.hashes[name]
+	resets := c.resets
+	c.mu.Unlock()
+	if ok {
+		return sum, nil
+	}
+
+	sum, err := c.hashFile(name)
+	if err != nil {
+		return "", err
+	}
+	c.mu.Lock()
+	if c.hashes != nil && c.resets == resets {
+		c.hashes[name] = sum
+	}
+	c.mu.Unlock()
+	return sum, nil
+}
+
+// listFiles returns the project's files as sorted, slash-separated paths
+// relative to the root. It asks git first and falls back to walking the
+// directory tree. Files under .git and .vibeguard are skipped, since logs and
+// cache entries change on every run.
+func (c *Cache) listFiles(ctx context.Context) ([]string, error) {
+	if listed, err := git.ListFiles(ctx, c.root); err == nil {
+		files := listed[:0]
+		for _, name := range listed {
+			if !isVibeguardFile(name) {
+				files = append(files, name)
+			}
+		}
+		return files, nil
+	}
+
+	root := c.root
+	if root == "" {
+		root = "."
+	}
+	var files []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && (d.Name() == ".git" || d.Name() == ".vibeguard") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// isVibeguardFile reports whether the slash-separated path name lies in a
+// .vibeguard directory.
+func isVibeguardFile(name string) bool {
+	return name == ".vibeguard" || strings.HasPrefix(name, ".vibeguard/") || strings.Contains(name, "/.vibeguard/")
+}
+
+// hashFile returns the SHA-256 of the file at name, relative to the root.
+// Missing files hash to a fixed marker so that deleting a file changes the
+// key.
+func (c *Cache) hashFile(name string) (string, error) {
+	f, err := os.Open(filepath.Join(c.root, filepath.FromSlash(name))) // #nosec G304 - name comes from the project's own file listing
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "deleted", nil
+		}
+		return "", fmt.Errorf("failed to hash %s: %w", name, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return "", fmt.Errorf("failed to hash %s: %w", name, err)
+	}
+	if info.IsDir() {
+		// Submodules are listed by git as directories
+		return "dir", nil
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to hash %s: %w", name, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
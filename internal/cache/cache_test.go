@@ -0,0 +1,183 @@
+package cache
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/version"
+)
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mustKey(t *testing.T, c *Cache, check *config.Check) string {
+	t.Helper()
+	key, err := c.Key(context.Background(), check)
+	if err != nil {
+		t.Fatalf("Key failed: %v", err)
+	}
+	return key
+}
+
+func TestCache_HitAndMiss(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "main.go", "package main\n")
+	c := New(filepath.Join(root, ".vibeguard", "cache"), root)
+	check := &config.Check{ID: "vet", Run: "go vet ./..."}
+
+	key := mustKey(t, c, check)
+	if _, ok := c.Get("vet", key); ok {
+		t.Fatal("expected miss on empty cache")
+	}
+
+	result := &executor.Result{CheckID: "vet", ExitCode: 0, Stdout: "ok\n", Success: true, Duration: time.Second}
+	if err := c.Put("vet", key, result); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+
+	// Writing the entry must not change the key
+	if again := mustKey(t, c, check); again != key {
+		t.Fatal("expected key to be stable across cache writes")
+	}
+
+	cached, ok := c.Get("vet", key)
+	if !ok {
+		t.Fatal("expected hit after Put")
+	}
+	if !cached.Cached || !cached.Success || cached.Stdout != "ok\n" || cached.Combined != "ok\n" {
+		t.Errorf("unexpected cached result: %+v", cached)
+	}
+
+	if _, ok := c.Get("vet", "other-key"); ok {
+		t.Error("expected miss for a different key")
+	}
+	if _, ok := c.Get("lint", key); ok {
+		t.Error("expected miss for a different check")
+	}
+}
+
+func TestCache_KeyInvalidation(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "internal/app/app.go", "package app\n")
+	writeFile(t, root, "docs/guide.md", "# Guide\n")
+	c := New(filepath.Join(root, ".vibeguard", "cache"), root)
+
+	whole := &config.Check{ID: "test", Run: "go test ./..."}
+	scoped := &config.Check{ID: "lint", Run: "golangci-lint run", Paths: []string{"**/*.go"}}
+	wholeKey, scopedKey := mustKey(t, c, whole), mustKey(t, c, scoped)
+
+	// A file outside the scoped check's paths only invalidates the whole-project check
+	writeFile(t, root, "docs/guide.md", "# Guide\n\nUpdated.\n")
+	c.Reset()
+	if mustKey(t, c, whole) == wholeKey {
+		t.Error("expected docs change to invalidate check without paths")
+	}
+	if mustKey(t, c, scoped) != scopedKey {
+		t.Error("expected docs change to keep key of check scoped to Go files")
+	}
+
+	// A covered file changes both
+	wholeKey = mustKey(t, c, whole)
+	writeFile(t, root, "internal/app/app.go", "package app\n\nfunc F() {}\n")
+	c.Reset()
+	if mustKey(t, c, whole) == wholeKey || mustKey(t, c, scoped) == scopedKey {
+		t.Error("expected Go change to invalidate both checks")
+	}
+
+	// Deleting and adding covered files changes the key
+	scopedKey = mustKey(t, c, scoped)
+	if err := os.Remove(filepath.Join(root, "internal/app/app.go")); err != nil {
+		t.Fatal(err)
+	}
+	c.Reset()
+	if mustKey(t, c, scoped) == scopedKey {
+		t.Error("expected deleted file to invalidate key")
+	}
+	scopedKey = mustKey(t, c, scoped)
+	writeFile(t, root, "cmd/main.go", "package main\n")
+	c.Reset()
+	if mustKey(t, c, scoped) == scopedKey {
+		t.Error("expected new file to invalidate key")
+	}
+
+	// So do the command and the shell
+	scopedKey = mustKey(t, c, scoped)
+	if mustKey(t, c, &config.Check{ID: "lint", Run: "golangci-lint run --fast", Paths: scoped.Paths}) == scopedKey {
+		t.Error("expected command change to invalidate key")
+	}
+	if mustKey(t, c, &config.Check{ID: "lint", Run: scoped.Run, Shell: "bash", Paths: scoped.Paths}) == scopedKey {
+		t.Error("expected shell change to invalidate key")
+	}
+}
+
+func TestCache_KeyMemoisedUntilReset(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "main.go", "package main\n")
+	c := New(filepath.Join(root, ".vibeguard", "cache"), root)
+	check := &config.Check{ID: "vet", Run: "go vet ./..."}
+
+	// Files are listed and hashed once per run, so later changes are not seen
+	key := mustKey(t, c, check)
+	writeFile(t, root, "main.go", "package main\n\nfunc main() {}\n")
+	writeFile(t, root, "util.go", "package main\n")
+	if mustKey(t, c, check) != key {
+		t.Error("expected key to be computed from the files as first listed")
+	}
+
+	c.Reset()
+	if mustKey(t, c, check) == key {
+		t.Error("expected key to see changed files after Reset")
+	}
+}
+
+func TestCache_KeyIncludesVersion(t *testing.T) {
+	root := t.TempDir()
+	c := New("", root)
+	check := &config.Check{ID: "fmt", Run: "gofmt -l ."}
+	key := mustKey(t, c, check)
+
+	old := version.Version
+	defer func() { version.Version = old }()
+	version.Version = "v9.9.9"
+
+	if mustKey(t, c, check) == key {
+		t.Error("expected a new vibeguard version to invalidate key")
+	}
+}
+
+func TestCache_Clear(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, ".vibeguard", "cache")
+	c := New(dir, root)
+
+	if err := c.Put("fmt", "key", &executor.Result{Success: true}); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if err := c.Clear(); err != nil {
+		t.Fatalf("Clear failed: %v", err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("expected cache directory to be removed, got: %v", err)
+	}
+	if _, ok := c.Get("fmt", "key"); ok {
+		t.Error("expected miss after Clear")
+	}
+
+	// Clearing an empty cache is not an error
+	if err := c.Clear(); err != nil {
+		t.Errorf("Clear on missing directory failed: %v", err)
+	}
+}
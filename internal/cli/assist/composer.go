@@ -33,6 +33,7 @@ type CheckRecommendation struct {
 	Rationale   string
 	Command     string
 	File        string // File to read output from instead of command stdout
+	Coverage    string // Coverage report format read into the coverage variable
 	Grok        []string
 	Assert      string
 	Severity    string
@@ -125,6 +125,9 @@ func RecommendationsSection(recommendations []CheckRecommendation) PromptSection
 		if rec.File != "" {
 			sb.WriteString(fmt.Sprintf("**File:** `%s`\n", rec.File))
 		}
+		if rec.Coverage != "" {
+			sb.WriteString(fmt.Sprintf("**Coverage Report:** `%s`\n", rec.Coverage))
+		}
 		sb.WriteString(fmt.Sprintf("**Severity:** %s\n", rec.Severity))
 		if len(rec.Grok) > 0 {
 			sb.WriteString(fmt.Sprintf("**Grok Patterns:** %s\n", formatGrokPatterns(rec.Grok)))
@@ -178,10 +181,11 @@ Optional fields:
 - **grok:** Array of patterns to extract data from output
 - **assert:** Condition that must be true
 - **requires:** Array of check IDs that must pass first
-- **severity:** "error" or "warning" (default: error)
+- **severity:** "error", "warning" or "info" (default: error)
 - **suggestion:** Message shown on failure
 - **timeout:** Duration string (e.g., "30s", "5m")
-- **file:** Path to read output from instead of command stdout`,
+- **file:** Path to read output from instead of command stdout
+- **coverage:** "go", "jest" or "pytest" to read the coverage variable from the tool's JSON/profile report`,
 	}
 }
 
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vibeguard/vibeguard/internal/baseline"
+	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+var baselineCmd = &cobra.Command{
+	Use:   "baseline",
+	Short: "Manage the baseline of known violations",
+	Long: `Manage the baseline used by 'vibeguard check --baseline'.
+
+A baseline is a JSON report of the violations a project already has. With
+--baseline, check reports violations found in the baseline as known and
+exits non-zero only for new ones. Violations match on the check ID and the
+check's suggestion, ignoring case, whitespace and numbers.
+
+Examples:
+  vibeguard baseline update                    Record current violations in ` + baseline.DefaultPath + `
+  vibeguard baseline update ci-baseline.json   Record them in ci-baseline.json`,
+}
+
+var baselineUpdateCmd = &cobra.Command{
+	Use:   "update [file]",
+	Short: "Run all checks and record their violations as the baseline",
+	Long: `Run all checks and write the results, in the --json report format, to the
+baseline file (default ` + baseline.DefaultPath + `), replacing it. Violations
+don't affect the exit code.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runBaselineUpdate,
+}
+
+func init() {
+	rootCmd.AddCommand(baselineCmd)
+	baselineCmd.AddCommand(baselineUpdateCmd)
+}
+
+func runBaselineUpdate(cmd *cobra.Command, args []string) error {
+	path := baseline.DefaultPath
+	if len(args) > 0 {
+		path = args[0]
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	maxParallel, err := resolveParallel(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Run every check without fail-fast so the baseline is complete
+	orch := orchestrator.New(cfg, executor.New(cfg.Dir()), maxParallel, false, verbose, logDir, GetErrorExitCode())
+	result, err := orch.Run(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if err := writeReportFile(path, formatJSON, result); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Recorded %d violations in %s\n", len(result.Violations), path)
+	return nil
+}
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/output"
+)
+
+func TestBaseline_UpdateThenCheck(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	writeConfig := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+	}
+	writeConfig(`version: "1"
+checks:
+  - id: legacy
+    run: "exit 1"
+    suggestion: Fix the 12 legacy issues
+`)
+
+	oldConfig, oldVerbose, oldJSON, oldLogDir, oldBaseline := configFile, verbose, jsonOutput, logDir, baselineFile
+	oldStderr := os.Stderr
+	defer func() {
+		configFile, verbose, jsonOutput, logDir, baselineFile = oldConfig, oldVerbose, oldJSON, oldLogDir, oldBaseline
+		os.Stderr = oldStderr
+	}()
+	configFile = configPath
+	verbose = false
+	jsonOutput = false
+	logDir = filepath.Join(tmpDir, "logs")
+	if devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0); err == nil {
+		defer func() { _ = devNull.Close() }()
+		os.Stderr = devNull
+	}
+
+	// Record the existing violation
+	baselinePath := filepath.Join(tmpDir, "baseline.json")
+	var buf bytes.Buffer
+	baselineUpdateCmd.SetOut(&buf)
+	if err := runBaselineUpdate(baselineUpdateCmd, []string{baselinePath}); err != nil {
+		t.Fatalf("baseline update failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Recorded 1 violations in "+baselinePath) {
+		t.Errorf("unexpected output: %q", buf.String())
+	}
+	data, err := os.ReadFile(baselinePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var report output.JSONOutput
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("baseline is not a JSON report: %v", err)
+	}
+	if len(report.Violations) != 1 || report.Violations[0].ID != "legacy" {
+		t.Errorf("expected the legacy violation in the baseline, got %+v", report.Violations)
+	}
+
+	// The known violation no longer fails the run, even as its count drifts
+	writeConfig(`version: "1"
+checks:
+  - id: legacy
+    run: "exit 1"
+    suggestion: Fix the 14 legacy issues
+`)
+	baselineFile = baselinePath
+	if err := runCheck(checkCmd, nil); err != nil {
+		t.Errorf("expected known violation to pass, got: %v", err)
+	}
+
+	// A new violation does
+	writeConfig(`version: "1"
+checks:
+  - id: legacy
+    run: "exit 1"
+    suggestion: Fix the 14 legacy issues
+  - id: fresh
+    run: "exit 1"
+`)
+	err = runCheck(checkCmd, nil)
+	exitErr, ok := err.(*ExitError)
+	if !ok || exitErr.Code != GetErrorExitCode() {
+		t.Errorf("expected ExitError with code %d for a new violation, got: %v", GetErrorExitCode(), err)
+	}
+}
+
+func TestRunCheck_MissingBaseline(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte("version: \"1\"\nchecks:\n  - id: pass\n    run: \"true\"\n"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig, oldBaseline := configFile, baselineFile
+	defer func() { configFile, baselineFile = oldConfig, oldBaseline }()
+	configFile = configPath
+	baselineFile = filepath.Join(tmpDir, "missing.json")
+
+	err := runCheck(checkCmd, nil)
+	if err == nil || !strings.Contains(err.Error(), "failed to read baseline") {
+		t.Errorf("expected baseline read error, got: %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vibeguard/vibeguard/internal/cache"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage cached check results",
+	Long: `Manage the results cached by 'vibeguard check'.
+
+A check that passed is not run again while its command and the files it
+covers (its paths, or the whole project) are unchanged. Cached results are
+stored in ` + cache.DefaultDir + `.
+
+Examples:
+  vibeguard cache clear    Remove all cached results`,
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove all cached check results",
+	Args:  cobra.NoArgs,
+	RunE:  runCacheClear,
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+	cacheCmd.AddCommand(cacheClearCmd)
+}
+
+func runCacheClear(cmd *cobra.Command, args []string) error {
+	c := cache.New(filepath.Join(projectDir(), cache.DefaultDir), "")
+	if err := c.Clear(); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Cleared %s\n", c.Dir())
+	return nil
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/cache"
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+func TestRunCacheClear(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	c := cache.New("", "")
+	if err := c.Put("fmt", "key", &executor.Result{Success: true}); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	rootCmd.SetOut(&buf)
+	defer rootCmd.SetOut(nil)
+
+	if err := runCacheClear(cacheClearCmd, nil); err != nil {
+		t.Fatalf("runCacheClear failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.FromSlash(cache.DefaultDir)); !os.IsNotExist(err) {
+		t.Errorf("expected cache directory to be removed, got: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Cleared "+cache.DefaultDir) {
+		t.Errorf("unexpected output: %q", buf.String())
+	}
+}
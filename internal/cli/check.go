@@ -2,13 +2,23 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/vibeguard/vibeguard/internal/baseline"
+	"github.com/vibeguard/vibeguard/internal/cache"
 	"github.com/vibeguard/vibeguard/internal/config"
 	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/git"
+	"github.com/vibeguard/vibeguard/internal/history"
 	"github.com/vibeguard/vibeguard/internal/orchestrator"
 	"github.com/vibeguard/vibeguard/internal/output"
 )
@@ -26,76 +36,226 @@ func (e *ExitError) Error() string {
 	return fmt.Sprintf("exit code %d", e.Code)
 }
 
+// ExitCodeFor returns the process exit code for an error returned by
+// Execute: the code of an ExitError, executor.ExitCodeConfigError for
+// configuration errors, and executor.ExitCodeFailure for anything else.
+func ExitCodeFor(err error) int {
+	var exitErr *ExitError
+	switch {
+	case err == nil:
+		return executor.ExitCodeSuccess
+	case errors.As(err, &exitErr):
+		return exitErr.Code
+	case config.IsConfigError(err):
+		return executor.ExitCodeConfigError
+	default:
+		return executor.ExitCodeFailure
+	}
+}
+
 var (
-	tags        []string
-	excludeTags []string
+	tags             []string
+	excludeTags      []string
+	onlyTags         []string
+	skipTags         []string
+	selectedChecks   []string
+	strictDeps       bool
+	onlyTouching     string
+	changedFrom      string
+	historyDB        string
+	outputFormat     string
+	outputFile       string
+	outputMkdir      bool
+	profileFile      string
+	autoFix          bool
+	explainFailures  bool
+	pipeline         bool
+	dryRun           bool
+	timeoutFlags     []string
+	severityFlags    []string
+	warningsAsErrors bool
+	failUnder        float64
+	noCache          bool
+	noTTY            bool
+	sortByDuration   bool
+	baselineFile     string
 )
 
+// Report formats accepted by --format.
+const (
+	formatText   = "text"
+	formatJSON   = "json"
+	formatSARIF  = "sarif"
+	formatJUnit  = "junit"
+	formatGitHub = "github"
+	formatTAP    = "tap"
+)
+
+// reportFormats lists the valid --format values in display order.
+var reportFormats = []string{formatText, formatJSON, formatSARIF, formatJUnit, formatGitHub, formatTAP}
+
 var checkCmd = &cobra.Command{
-	Use:   "check [id]",
-	Short: "Run checks",
+	Use:     "check [id]",
+	Aliases: []string{"run"},
+	Short:   "Run checks",
 	Long: `Run all configured checks or a specific check by ID.
 
 Examples:
   vibeguard check           Run all checks
   vibeguard check fmt       Run only the 'fmt' check
+  vibeguard check --check fmt --check vet    Run fmt and vet, plus the checks they require
   vibeguard check -v        Run all checks with verbose output
   vibeguard check --tags security,lint    Run checks tagged with security or lint
-  vibeguard check --exclude-tags slow     Run all checks except those tagged slow`,
+  vibeguard check --exclude-tags slow     Run all checks except those tagged slow
+  vibeguard check --only lint,format      Run checks tagged lint or format, plus the checks they require
+  vibeguard check --skip slow --strict-deps   Skip slow checks; fail if a remaining check requires one
+  vibeguard check --only-touching internal/config   Run checks whose paths cover internal/config
+  vibeguard check --changed-from origin/main      Run checks whose paths match files changed since origin/main
+  vibeguard check --warnings-as-errors            Fail the run when a warning-severity check fails
+  vibeguard check --fail-under 80                 Fail checks whose captured coverage is below 80%
+  vibeguard check --no-cache                      Run every check, ignoring cached results
+  vibeguard check --fix     Run fix commands for failing checks, then re-run them
+  vibeguard check --pipeline -p 8                 Start each check as soon as its dependencies finish
+  vibeguard check --dry-run Print the execution plan without running any check
+  vibeguard check --timeout 5m                    Give every check 5 minutes
+  vibeguard check --timeout 1m --timeout test=10m Give test 10 minutes and every other check 1 minute
+  vibeguard check --severity lint=warning         Report lint failures as warnings for this run
+  vibeguard check --history-db vibeguard.db       Append results to a SQLite history database
+  vibeguard check --profile trace.json            Write check timings as a Chrome trace (chrome://tracing)
+  vibeguard check --baseline vibeguard-baseline.json   Fail only on violations not in the baseline
+  vibeguard check --format sarif -o results.sarif Write results as SARIF for code scanning
+  vibeguard check --format junit -o report.xml    Write a JUnit XML report to a file
+  vibeguard check --format tap                    Write TAP version 13 output
+  vibeguard check --format github                 Annotate violations in GitHub Actions (default when GITHUB_ACTIONS=true)`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runCheck,
 }
 
 func init() {
 	rootCmd.AddCommand(checkCmd)
+	checkCmd.Flags().StringSliceVar(&selectedChecks, "check", nil, "Run only these checks plus the checks they require (repeatable or comma-separated)")
 	checkCmd.Flags().StringSliceVar(&tags, "tags", nil, "Run checks matching ANY of these tags (comma-separated)")
 	checkCmd.Flags().StringSliceVar(&excludeTags, "exclude-tags", nil, "Exclude checks matching ANY of these tags (comma-separated)")
+	checkCmd.Flags().StringSliceVar(&onlyTags, "only", nil, "Run checks matching ANY of these tags, plus the checks they require (comma-separated)")
+	checkCmd.Flags().StringSliceVar(&skipTags, "skip", nil, "Skip checks matching ANY of these tags unless a selected check requires them (comma-separated)")
+	checkCmd.Flags().BoolVar(&strictDeps, "strict-deps", false, "Fail instead of skipping or pulling in checks that a tag filter removed but a selected check requires")
+	checkCmd.Flags().StringVar(&onlyTouching, "only-touching", "", "Run only checks whose paths globs cover this path prefix (plus their dependencies)")
+	checkCmd.Flags().StringVar(&changedFrom, "changed-from", "", "Run only checks whose paths globs match files changed since this git ref (plus checks without paths); scope: changed checks run on just the changed packages")
+	checkCmd.Flags().StringVar(&outputFormat, "format", "", "Output format: "+strings.Join(reportFormats, ", ")+" (default text, or github when GITHUB_ACTIONS=true)")
+	checkCmd.Flags().StringVarP(&outputFile, "output", "o", "", "Write the report to this file instead of stdout or stderr; stderr then gets a one-line summary")
+	checkCmd.Flags().BoolVar(&outputMkdir, "mkdir", false, "Create missing parent directories of the --output and --profile files")
+	checkCmd.Flags().StringVar(&profileFile, "profile", "", "Write each executed check's start, duration, level and lane to this file as a Chrome trace")
+	checkCmd.Flags().BoolVar(&autoFix, "fix", false, "Run the fix command of each failing check and re-run the check once")
+	checkCmd.Flags().BoolVar(&explainFailures, "explain-failures", false, "Show each failing check's captured values, its assertion and what it evaluated to, and the tail of its output")
+	checkCmd.Flags().BoolVar(&pipeline, "pipeline", false, "Start each check as soon as the checks it depends on finish, instead of level by level, with --parallel capping the checks running at once")
+	checkCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the checks that would run, level by level, with their interpolated commands, without executing them")
+	checkCmd.Flags().BoolVar(&noCache, "no-cache", false, "Run every check instead of reusing cached results of unchanged checks")
+	checkCmd.Flags().BoolVar(&sortByDuration, "sort-by-duration", false, "End text output with the summary and each check's duration, slowest first")
+	checkCmd.Flags().BoolVar(&noTTY, "no-tty", false, "Don't show the live status table, even when stderr is a terminal")
+	checkCmd.Flags().BoolVar(&warningsAsErrors, "warnings-as-errors", false, "Exit with the error exit code when a warning-severity check fails")
+	checkCmd.Flags().Float64Var(&failUnder, "fail-under", 0, "Fail every check whose captured coverage value is below this percentage, in addition to its assertion")
+	checkCmd.Flags().StringArrayVar(&timeoutFlags, "timeout", nil, "Override check timeouts: a duration for every check, or check-id=duration for one check (repeatable)")
+	checkCmd.Flags().StringArrayVar(&severityFlags, "severity", nil, "Override a check's severity: check-id=error, warning or info (repeatable)")
+	checkCmd.Flags().StringVar(&historyDB, "history-db", "", "Append per-check results to this SQLite database")
+	checkCmd.Flags().StringVar(&baselineFile, "baseline", "", "Report violations found in this JSON report (see 'baseline update') as known and fail only on new ones")
 }
 
 func runCheck(cmd *cobra.Command, args []string) error {
+	format, err := resolveFormat()
+	if err != nil {
+		return err
+	}
+
+	// Run every config file below the current directory
+	if recursive {
+		return runRecursive(cmd, args, format)
+	}
+
 	// Load configuration
-	cfg, err := config.Load(configFile)
+	cfg, err := loadConfig()
 	if err != nil {
 		return err
 	}
 
-	// Create executor and orchestrator
-	exec := executor.New("")
-	orch := orchestrator.New(cfg, exec, parallel, failFast, verbose, logDir, GetErrorExitCode())
+	// Load the baseline before running so a bad path fails fast
+	var base *baseline.Baseline
+	if baselineFile != "" {
+		if base, err = baseline.Load(baselineFile); err != nil {
+			return err
+		}
+	}
 
-	// Set tag filter if specified
-	if len(tags) > 0 || len(excludeTags) > 0 {
-		orch.SetTagFilter(orchestrator.TagFilter{
-			Include: tags,
-			Exclude: excludeTags,
-		})
+	orch, err := newCheckOrchestrator(cfg, format, logDir)
+	if err != nil {
+		return err
 	}
 
-	// Create formatter - use stderr for Claude Code hook visibility
-	formatter := output.New(os.Stderr, verbose)
+	// Restrict to the selected checks and their dependencies
+	if len(selectedChecks) > 0 {
+		if len(args) > 0 {
+			return fmt.Errorf("--check cannot be combined with a check ID argument")
+		}
+		orch.SetSelectedChecks(selectedChecks)
+	}
 
-	// Run checks
-	ctx := context.Background()
-	var result *orchestrator.RunResult
+	// Print the execution plan instead of running anything
+	if dryRun {
+		var plan *orchestrator.Plan
+		if len(args) > 0 {
+			plan, err = orch.PlanCheck(args[0])
+		} else {
+			plan, err = orch.Plan()
+		}
+		if err != nil {
+			return err
+		}
+		writePlan(cmd.OutOrStdout(), plan)
+		return nil
+	}
 
+	// Run checks
+	startedAt := time.Now()
+	checkID := ""
 	if len(args) > 0 {
-		// Run single check
-		result, err = orch.RunCheck(ctx, args[0])
-	} else {
-		// Run all checks
-		result, err = orch.Run(ctx)
+		checkID = args[0]
 	}
+	result, err := executeChecks(orch, format, checkID)
 	if err != nil {
 		return err
 	}
 
-	// Format and output results - use stderr for Claude Code hook visibility
-	if jsonOutput {
-		if err := output.FormatJSON(os.Stderr, result); err != nil {
+	// Only violations missing from the baseline affect the exit code
+	if base != nil {
+		base.Apply(result.Violations)
+		result.ExitCode = orchestrator.ExitCode(result.Violations, GetErrorExitCode(), warningsAsErrors)
+	}
+
+	// Write the timing trace of the checks that ran
+	if profileFile != "" {
+		if err := writeFileAtomic(profileFile, func(out io.Writer) error {
+			return output.FormatTrace(out, result)
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Format and output results - machine-readable formats go to stdout, the
+	// text report to stderr for Claude Code hook visibility
+	if outputFile != "" {
+		if err := writeReportFile(outputFile, format, result); err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "%s\nReport written to %s\n", output.FormatSummary(result.Summary()), outputFile)
+	} else if err := writeReport(reportOutput(cmd, format), format, result); err != nil {
+		return err
+	}
+
+	// Persist results for historical trend queries once the report is out,
+	// so a history database error cannot hide it
+	if historyDB != "" {
+		if err := recordHistory(historyDB, result, startedAt); err != nil {
 			return err
 		}
-	} else {
-		formatter.FormatResult(result)
 	}
 
 	// Exit with appropriate code if needed
@@ -106,3 +266,406 @@ func runCheck(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// newCheckOrchestrator creates the orchestrator that runs cfg's checks with
+// the filters and run options set by the check command's flags. Check
+// output logs go to logDir.
+func newCheckOrchestrator(cfg *config.Config, format, logDir string) (*orchestrator.Orchestrator, error) {
+	exec := executor.New(cfg.Dir())
+	maxParallel, err := resolveParallel(cfg)
+	if err != nil {
+		return nil, err
+	}
+	orch := orchestrator.New(cfg, exec, maxParallel, failFast, verbose, logDir, GetErrorExitCode())
+	orch.SetFailFastMode(failFastMode)
+	orch.SetProvenance(runProvenance(cfg))
+
+	// Set tag filter if specified
+	tagFilter, err := resolveTagFilter()
+	if err != nil {
+		return nil, err
+	}
+	if tagFilter != nil {
+		orch.SetTagFilter(*tagFilter)
+	}
+
+	// Override configured timeouts
+	timeouts, err := parseTimeoutOverride(timeoutFlags, cfg)
+	if err != nil {
+		return nil, err
+	}
+	orch.SetTimeoutOverride(timeouts)
+
+	// Override configured severities
+	severities, err := parseSeverityOverride(severityFlags, cfg)
+	if err != nil {
+		return nil, err
+	}
+	orch.SetSeverityOverride(severities)
+
+	// Restrict to path-scoped checks covering the given prefix
+	if onlyTouching != "" {
+		orch.SetOnlyTouching(onlyTouching)
+	}
+
+	// Restrict to checks affected by the files changed since a git ref
+	if changedFrom != "" {
+		files, err := git.ChangedFiles(context.Background(), cfg.Dir(), changedFrom)
+		if err != nil {
+			return nil, err
+		}
+		orch.SetChangedFiles(files)
+	}
+
+	// Reuse the results of checks whose command and files are unchanged
+	if !noCache {
+		orch.SetCache(cache.New(filepath.Join(cfg.Dir(), cache.DefaultDir), cfg.Dir()))
+	}
+
+	// Fail the run on warning-severity violations too
+	if warningsAsErrors {
+		orch.SetWarningsAsErrors(true)
+	}
+
+	// Fail checks whose captured coverage is below the threshold
+	if failUnder < 0 || failUnder > 100 {
+		return nil, fmt.Errorf("invalid --fail-under %g: must be a percentage between 0 and 100", failUnder)
+	}
+	orch.SetFailUnder(failUnder)
+
+	// Schedule checks by their dependencies instead of level by level
+	if pipeline {
+		orch.SetPipeline(true)
+	}
+
+	// Run fix commands for failing checks and re-run them
+	if autoFix {
+		orch.SetAutoFix(true)
+	}
+
+	// Attach captures, the assertion and output tails to violations
+	if explainFailures {
+		orch.SetExplainFailures(true)
+	}
+
+	// Stream check output live in verbose text mode so long-running checks
+	// show progress; structured formats stay machine-readable
+	if verbose && (format == formatText || format == formatGitHub) {
+		orch.SetStreamOutput(os.Stderr)
+	}
+	return orch, nil
+}
+
+// runProvenance returns the config file and git commit a run of cfg is made
+// from. The git fields are best-effort: they stay empty outside a repository
+// or without git installed.
+func runProvenance(cfg *config.Config) orchestrator.Provenance {
+	p := orchestrator.Provenance{ConfigPath: cfg.Path(), ConfigHash: cfg.Hash()}
+	if commit, branch, err := git.Head(context.Background(), cfg.Dir()); err == nil {
+		p.GitCommit, p.GitBranch = commit, branch
+	}
+	return p
+}
+
+// executeChecks runs the check with ID checkID, or every check if checkID is
+// empty, showing the live status table while it waits on a terminal.
+func executeChecks(orch *orchestrator.Orchestrator, format, checkID string) (*orchestrator.RunResult, error) {
+	var live *output.LiveStatus
+	if useLiveStatus(os.Stderr, format) {
+		live = output.NewLiveStatus(os.Stderr)
+		orch.SetProgress(live)
+		live.Start()
+	}
+
+	ctx := context.Background()
+	var result *orchestrator.RunResult
+	var err error
+	if checkID != "" {
+		result, err = orch.RunCheck(ctx, checkID)
+	} else {
+		result, err = orch.Run(ctx)
+	}
+	if live != nil {
+		live.Stop()
+	}
+	return result, err
+}
+
+// useLiveStatus reports whether to draw the live status table on f: only for
+// text output to a terminal, and not under --no-tty or when --verbose streams
+// check output.
+func useLiveStatus(f *os.File, format string) bool {
+	if noTTY || verbose || format != formatText || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	return isTerminal(f)
+}
+
+// isTerminal reports whether f is a terminal (character device).
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// writePlan prints the checks a run would execute, grouped by level, followed
+// by the checks the filters would skip.
+func writePlan(out io.Writer, plan *orchestrator.Plan) {
+	total := 0
+	for _, level := range plan.Levels {
+		total += len(level)
+	}
+	_, _ = fmt.Fprintf(out, "Dry run: %d checks in %d levels (nothing executed)\n", total, len(plan.Levels))
+
+	for i, level := range plan.Levels {
+		_, _ = fmt.Fprintf(out, "\nLevel %d:\n", i+1)
+		for _, check := range level {
+			_, _ = fmt.Fprintf(out, "  %s\n", check.ID)
+			if check.Run != "" {
+				_, _ = fmt.Fprintf(out, "    run: %s\n", check.Run)
+			} else {
+				_, _ = fmt.Fprintf(out, "    file: %s\n", check.File)
+			}
+			if len(check.Requires) > 0 {
+				_, _ = fmt.Fprintf(out, "    requires: %s\n", strings.Join(check.Requires, ", "))
+			}
+			if len(check.OptionalRequires) > 0 {
+				_, _ = fmt.Fprintf(out, "    after: %s\n", strings.Join(check.OptionalRequires, ", "))
+			}
+			if len(check.RequiresAny) > 0 {
+				_, _ = fmt.Fprintf(out, "    requires any: %s\n", strings.Join(check.RequiresAny, ", "))
+			}
+		}
+	}
+
+	if len(plan.Skipped) > 0 {
+		_, _ = fmt.Fprintln(out, "\nSkipped:")
+		for _, skip := range plan.Skipped {
+			_, _ = fmt.Fprintf(out, "  %s: %s\n", skip.Check.ID, skip.Reason)
+		}
+	}
+}
+
+// parseTimeoutOverride parses --timeout values. A bare duration applies to
+// every check; check-id=duration applies to one check and takes precedence.
+// A zero duration leaves the configured timeouts unchanged.
+func parseTimeoutOverride(values []string, cfg *config.Config) (orchestrator.TimeoutOverride, error) {
+	var override orchestrator.TimeoutOverride
+	for _, value := range values {
+		id, durationStr, perCheck := strings.Cut(value, "=")
+		if !perCheck {
+			durationStr = id
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(durationStr))
+		if err != nil || d < 0 {
+			return override, fmt.Errorf("invalid --timeout %q: expected a duration like 2m or check-id=2m", value)
+		}
+		if !perCheck {
+			override.Default = d
+			continue
+		}
+
+		id = strings.TrimSpace(id)
+		if !hasCheck(cfg, id) {
+			return override, fmt.Errorf("invalid --timeout %q: unknown check %q", value, id)
+		}
+		if override.Checks == nil {
+			override.Checks = make(map[string]time.Duration)
+		}
+		override.Checks[id] = d
+	}
+	return override, nil
+}
+
+// parseSeverityOverride parses --severity values of the form
+// check-id=severity into severities by check ID.
+func parseSeverityOverride(values []string, cfg *config.Config) (map[string]config.Severity, error) {
+	var override map[string]config.Severity
+	for _, value := range values {
+		id, severity, ok := strings.Cut(value, "=")
+		id, severity = strings.TrimSpace(id), strings.TrimSpace(severity)
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid --severity %q: expected check-id=severity, like lint=warning", value)
+		}
+		if !slices.Contains(config.Severities, config.Severity(severity)) {
+			return nil, fmt.Errorf("invalid --severity %q: severity must be error, warning or info", value)
+		}
+		if !hasCheck(cfg, id) {
+			return nil, fmt.Errorf("invalid --severity %q: unknown check %q", value, id)
+		}
+		if override == nil {
+			override = make(map[string]config.Severity)
+		}
+		override[id] = config.Severity(severity)
+	}
+	return override, nil
+}
+
+// hasCheck reports whether cfg defines a check with the given ID.
+func hasCheck(cfg *config.Config, id string) bool {
+	for _, check := range cfg.Checks {
+		if check.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveTagFilter builds the tag filter from --tags/--exclude-tags or
+// --only/--skip. The former skip checks whose requires were filtered out;
+// the latter run those requires anyway. --strict-deps turns either case into
+// an error. Returns nil if no tag flags are set.
+func resolveTagFilter() (*orchestrator.TagFilter, error) {
+	tagFlags := len(tags) > 0 || len(excludeTags) > 0
+	onlyFlags := len(onlyTags) > 0 || len(skipTags) > 0
+	if tagFlags && onlyFlags {
+		return nil, fmt.Errorf("--only/--skip cannot be combined with --tags/--exclude-tags")
+	}
+
+	var filter *orchestrator.TagFilter
+	switch {
+	case onlyFlags:
+		filter = &orchestrator.TagFilter{Include: onlyTags, Exclude: skipTags, Dependencies: orchestrator.DependenciesInclude}
+	case tagFlags:
+		filter = &orchestrator.TagFilter{Include: tags, Exclude: excludeTags, Dependencies: orchestrator.DependenciesSkip}
+	default:
+		return nil, nil
+	}
+	if strictDeps {
+		filter.Dependencies = orchestrator.DependenciesStrict
+	}
+	return filter, nil
+}
+
+// resolveFormat returns the report format selected by --format, treating the
+// global --json flag as shorthand for --format json. Without either, the
+// format is github when running in GitHub Actions and text otherwise.
+func resolveFormat() (string, error) {
+	format := strings.ToLower(strings.TrimSpace(outputFormat))
+	if format == "" {
+		format = formatText
+		if os.Getenv("GITHUB_ACTIONS") == "true" && !jsonOutput {
+			return formatGitHub, nil
+		}
+	}
+	if jsonOutput {
+		if format != formatText && format != formatJSON {
+			return "", fmt.Errorf("--json conflicts with --format %s", format)
+		}
+		return formatJSON, nil
+	}
+	for _, f := range reportFormats {
+		if format == f {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("unknown output format %q (valid formats: %s)", outputFormat, strings.Join(reportFormats, ", "))
+}
+
+// writeReport writes the run result to out in the given format.
+func writeReport(out io.Writer, format string, result *orchestrator.RunResult) error {
+	switch format {
+	case formatJSON:
+		return output.FormatJSON(out, result)
+	case formatSARIF:
+		return output.FormatSARIF(out, result)
+	case formatJUnit:
+		return output.FormatJUnit(out, result)
+	case formatTAP:
+		return output.FormatTAP(out, result)
+	case formatGitHub:
+		// Annotations for the Actions UI, then the text report for the log
+		if err := output.FormatGitHub(out, result); err != nil {
+			return err
+		}
+		fallthrough
+	default:
+		formatter := output.New(out, verbose)
+		formatter.SetColor(useColor(out))
+		formatter.SetSortByDuration(sortByDuration)
+		formatter.FormatResult(result)
+		return nil
+	}
+}
+
+// reportOutput returns where the report in format goes without --output:
+// stdout for the machine-readable formats, so they can be redirected apart
+// from check progress, and stderr for the text report.
+func reportOutput(cmd *cobra.Command, format string) io.Writer {
+	switch format {
+	case formatJSON, formatSARIF, formatJUnit, formatTAP:
+		return cmd.OutOrStdout()
+	default:
+		return os.Stderr
+	}
+}
+
+// useColor reports whether to color the text report written to out: only
+// when out is a terminal, and not under --no-color, NO_COLOR or a dumb
+// terminal.
+func useColor(out io.Writer) bool {
+	if noColor || os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	f, ok := out.(*os.File)
+	return ok && isTerminal(f)
+}
+
+// writeReportFile writes the run result to the file at path, replacing any
+// existing content.
+func writeReportFile(path, format string, result *orchestrator.RunResult) error {
+	return writeFileAtomic(path, func(out io.Writer) error {
+		return writeReport(out, format, result)
+	})
+}
+
+// writeFileAtomic writes the output of write to the file at path, replacing
+// any existing content. The output goes to a temporary file next to path that
+// is renamed over it, so readers never see a partial report. Under --mkdir
+// missing parent directories are created.
+func writeFileAtomic(path string, write func(io.Writer) error) error {
+	dir := filepath.Dir(path)
+	if outputMkdir {
+		if err := os.MkdirAll(dir, 0750); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	if err := write(tmp); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+	// CreateTemp makes the file private; reports get the usual permissions
+	if err := tmp.Chmod(0644); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+	return nil
+}
+
+// recordHistory appends the run's results to the history database at path.
+func recordHistory(path string, result *orchestrator.RunResult, startedAt time.Time) error {
+	store, err := history.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = store.Close() }()
+
+	_, err = store.Record(result, startedAt)
+	return err
+}
@@ -2,9 +2,20 @@ package cli
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/output"
 )
 
 func TestRunCheck_Success(t *testing.T) {
@@ -284,6 +295,36 @@ checks:
 	}
 }
 
+func TestRunCheck_UnknownCheckSuggestion(t *testing.T) {
+	configContent := `version: "1"
+checks:
+  - id: lint
+    run: "true"
+  - id: test
+    run: "true"
+`
+	configPath := filepath.Join(t.TempDir(), "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig := configFile
+	defer func() { configFile = oldConfig }()
+	configFile = configPath
+
+	err := runCheck(checkCmd, []string{"tset"})
+	if err == nil {
+		t.Fatal("expected error for unknown check")
+	}
+	// Config errors exit with code 2
+	if !config.IsConfigError(err) {
+		t.Errorf("expected a config error, got: %T %v", err, err)
+	}
+	if !strings.Contains(err.Error(), `did you mean "test"?`) {
+		t.Errorf("expected a suggestion of test, got: %v", err)
+	}
+}
+
 func TestRunCheck_WithDependencies(t *testing.T) {
 	tmpDir, err := os.MkdirTemp("", "vibeguard-test-*")
 	if err != nil {
@@ -406,10 +447,14 @@ checks:
 	configFile = configPath
 	jsonOutput = true
 
-	// Close stderr to cause FormatJSON to fail
-	oldStderr := os.Stderr
-	defer func() { os.Stderr = oldStderr }()
-	os.Stderr = nil
+	// Write the report to a closed file to cause FormatJSON to fail
+	closed, err := os.Create(filepath.Join(tmpDir, "stdout"))
+	if err != nil {
+		t.Fatalf("failed to create stdout file: %v", err)
+	}
+	_ = closed.Close()
+	checkCmd.SetOut(closed)
+	defer checkCmd.SetOut(nil)
 
 	err = runCheck(checkCmd, []string{})
 	if err == nil {
@@ -526,3 +571,1238 @@ checks:
 		t.Errorf("runCheck with exclude-tags flag failed: %v", err)
 	}
 }
+
+func TestRunCheck_OnlySkipTags(t *testing.T) {
+	tmpDir := t.TempDir()
+	ran := func(id string) string { return filepath.Join(tmpDir, id+".ran") }
+
+	configContent := `version: "1"
+checks:
+  - id: gen
+    run: 'touch ` + ran("gen") + `'
+    tags: [slow]
+  - id: fmt
+    run: 'touch ` + ran("fmt") + `'
+    tags: [format]
+    requires: [gen]
+  - id: lint
+    run: 'touch ` + ran("lint") + `'
+    tags: [lint]
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig, oldVerbose, oldJSON, oldLogDir := configFile, verbose, jsonOutput, logDir
+	oldTags, oldExcludeTags := tags, excludeTags
+	oldOnly, oldSkip, oldStrict := onlyTags, skipTags, strictDeps
+	oldNoCache := noCache
+	oldStderr := os.Stderr
+	defer func() {
+		configFile, verbose, jsonOutput, logDir = oldConfig, oldVerbose, oldJSON, oldLogDir
+		tags, excludeTags = oldTags, oldExcludeTags
+		onlyTags, skipTags, strictDeps = oldOnly, oldSkip, oldStrict
+		noCache = oldNoCache
+		os.Stderr = oldStderr
+	}()
+
+	configFile = configPath
+	verbose = false
+	jsonOutput = false
+	logDir = filepath.Join(tmpDir, "logs")
+	// The same checks run repeatedly; each run must execute them
+	noCache = true
+	if devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0); err == nil {
+		defer func() { _ = devNull.Close() }()
+		os.Stderr = devNull
+	}
+
+	reset := func() {
+		tags, excludeTags, onlyTags, skipTags, strictDeps = nil, nil, nil, nil, false
+		for _, id := range []string{"gen", "fmt", "lint"} {
+			_ = os.Remove(ran(id))
+		}
+	}
+	assertRan := func(want map[string]bool) {
+		t.Helper()
+		for _, id := range []string{"gen", "fmt", "lint"} {
+			_, err := os.Stat(ran(id))
+			if got := err == nil; got != want[id] {
+				t.Errorf("check %s ran = %v, want %v", id, got, want[id])
+			}
+		}
+	}
+
+	// --only pulls in the checks the selected checks require
+	reset()
+	onlyTags = []string{"format"}
+	if err := runCheck(checkCmd, []string{}); err != nil {
+		t.Fatalf("runCheck --only failed: %v", err)
+	}
+	assertRan(map[string]bool{"gen": true, "fmt": true})
+
+	// --skip still runs a skipped check that a remaining check requires
+	reset()
+	skipTags = []string{"slow", "lint"}
+	if err := runCheck(checkCmd, []string{}); err != nil {
+		t.Fatalf("runCheck --skip failed: %v", err)
+	}
+	assertRan(map[string]bool{"gen": true, "fmt": true})
+
+	// --strict-deps refuses to drop or pull in the requirement
+	reset()
+	onlyTags = []string{"format"}
+	strictDeps = true
+	err := runCheck(checkCmd, []string{})
+	if err == nil || !strings.Contains(err.Error(), `check "fmt" requires "gen"`) {
+		t.Errorf("expected strict dependency error, got %v", err)
+	}
+	assertRan(map[string]bool{})
+
+	// --only and --tags select checks differently, so they cannot be mixed
+	reset()
+	onlyTags = []string{"format"}
+	tags = []string{"lint"}
+	err = runCheck(checkCmd, []string{})
+	if err == nil || !strings.Contains(err.Error(), "cannot be combined") {
+		t.Errorf("expected flag conflict error, got %v", err)
+	}
+}
+
+func TestRunCheck_FormatSARIF(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `version: "1"
+checks:
+  - id: lint
+    run: 'echo "main.go:12: unused variable x"; exit 1'
+    grok:
+      - "%{DATA:file}:%{INT:line}: %{GREEDYDATA:message}"
+    severity: warning
+    suggestion: "Remove {{.message}}"
+    timeout: 10s
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig := configFile
+	oldJSON := jsonOutput
+	oldFormat := outputFormat
+	oldStderr := os.Stderr
+	defer func() {
+		configFile = oldConfig
+		jsonOutput = oldJSON
+		outputFormat = oldFormat
+		os.Stderr = oldStderr
+		checkCmd.SetOut(nil)
+	}()
+
+	configFile = configPath
+	jsonOutput = false
+	outputFormat = "sarif"
+
+	stderrFile, err := os.Create(filepath.Join(tmpDir, "stderr"))
+	if err != nil {
+		t.Fatalf("failed to create stderr capture: %v", err)
+	}
+	defer func() { _ = stderrFile.Close() }()
+	os.Stderr = stderrFile
+	var stdout bytes.Buffer
+	checkCmd.SetOut(&stdout)
+
+	if err := runCheck(checkCmd, []string{}); err != nil {
+		t.Fatalf("runCheck failed: %v", err)
+	}
+
+	// The report goes to stdout, leaving stderr to the progress output
+	data := stdout.Bytes()
+	if stderrData, err := os.ReadFile(stderrFile.Name()); err != nil {
+		t.Fatalf("failed to read captured stderr: %v", err)
+	} else if bytes.Contains(stderrData, []byte(`"runs"`)) {
+		t.Errorf("expected SARIF report on stdout only, stderr got:\n%s", stderrData)
+	}
+
+	var log output.SARIFLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		t.Fatalf("output is not SARIF JSON: %v\n%s", err, data)
+	}
+	if len(log.Runs) != 1 || len(log.Runs[0].Results) != 1 {
+		t.Fatalf("expected one SARIF result, got %+v", log.Runs)
+	}
+	res := log.Runs[0].Results[0]
+	if res.RuleID != "lint" || res.Level != "warning" {
+		t.Errorf("unexpected result: %+v", res)
+	}
+	if res.Message.Text != "Remove unused variable x" {
+		t.Errorf("unexpected message: %q", res.Message.Text)
+	}
+	if len(res.Locations) != 1 || res.Locations[0].PhysicalLocation.ArtifactLocation.URI != "main.go" {
+		t.Errorf("expected location main.go, got %+v", res.Locations)
+	}
+}
+
+func TestRunCheck_NonTTYPlainOutput(t *testing.T) {
+	tmpDir := t.TempDir()
+	configContent := `version: "1"
+checks:
+  - id: slow
+    run: sleep 0.3
+  - id: lint
+    run: exit 1
+    severity: warning
+    suggestion: "Fix lint"
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig, oldFormat, oldLogDir, oldStderr := configFile, outputFormat, logDir, os.Stderr
+	defer func() {
+		configFile, outputFormat, logDir, os.Stderr = oldConfig, oldFormat, oldLogDir, oldStderr
+	}()
+	configFile = configPath
+	outputFormat = formatText
+	logDir = filepath.Join(tmpDir, "logs")
+
+	// A regular file is not a terminal, so no live status table is drawn
+	stderrFile, err := os.Create(filepath.Join(tmpDir, "stderr"))
+	if err != nil {
+		t.Fatalf("failed to create stderr capture: %v", err)
+	}
+	defer func() { _ = stderrFile.Close() }()
+	os.Stderr = stderrFile
+
+	if err := runCheck(checkCmd, []string{}); err != nil {
+		t.Fatalf("runCheck failed: %v", err)
+	}
+
+	data, err := os.ReadFile(stderrFile.Name())
+	if err != nil {
+		t.Fatalf("failed to read captured output: %v", err)
+	}
+	out := string(data)
+	if strings.Contains(out, "\x1b[") || strings.Contains(out, "running") {
+		t.Errorf("expected plain output without live status, got %q", out)
+	}
+	if !strings.HasPrefix(out, "WARN  lint (warning)") || !strings.Contains(out, "Fix lint") {
+		t.Errorf("expected the plain violation report, got %q", out)
+	}
+}
+
+func TestUseLiveStatus(t *testing.T) {
+	oldNoTTY, oldVerbose := noTTY, verbose
+	defer func() { noTTY, verbose = oldNoTTY, oldVerbose }()
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	noTTY, verbose = false, false
+	if useLiveStatus(f, formatText) {
+		t.Error("expected no live status for a regular file")
+	}
+	if isTerminal(f) {
+		t.Error("expected a regular file not to be a terminal")
+	}
+
+	// /dev/tty stands in for an interactive terminal when one is attached
+	tty, err := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+	if err != nil {
+		t.Skip("no terminal available")
+	}
+	defer func() { _ = tty.Close() }()
+	t.Setenv("TERM", "xterm")
+	if !useLiveStatus(tty, formatText) {
+		t.Error("expected live status on a terminal")
+	}
+	if useLiveStatus(tty, formatJSON) {
+		t.Error("expected no live status for JSON output")
+	}
+	noTTY = true
+	if useLiveStatus(tty, formatText) {
+		t.Error("expected --no-tty to disable live status")
+	}
+}
+
+func TestUseColor(t *testing.T) {
+	oldNoColor := noColor
+	defer func() { noColor = oldNoColor }()
+	noColor = false
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("TERM", "xterm")
+
+	var buf bytes.Buffer
+	if useColor(&buf) {
+		t.Error("expected no color for a buffer")
+	}
+
+	// The null device is a character device, like a terminal
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = devNull.Close() }()
+	if !useColor(devNull) {
+		t.Error("expected color on a character device")
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if useColor(devNull) {
+		t.Error("expected NO_COLOR to disable color")
+	}
+	t.Setenv("NO_COLOR", "")
+	noColor = true
+	if useColor(devNull) {
+		t.Error("expected --no-color to disable color")
+	}
+}
+
+func TestResolveFormat(t *testing.T) {
+	oldJSON := jsonOutput
+	oldFormat := outputFormat
+	defer func() {
+		jsonOutput = oldJSON
+		outputFormat = oldFormat
+	}()
+
+	tests := []struct {
+		format  string
+		json    bool
+		actions bool
+		want    string
+		wantErr bool
+	}{
+		{format: "text", want: "text"},
+		{format: "", want: "text"},
+		{format: "SARIF", want: "sarif"},
+		{format: "github", want: "github"},
+		{format: "TAP", want: "tap"},
+		{format: "text", json: true, want: "json"},
+		{format: "json", json: true, want: "json"},
+		{format: "sarif", json: true, wantErr: true},
+		{format: "xml", wantErr: true},
+		{format: "", actions: true, want: "github"},
+		{format: "text", actions: true, want: "text"},
+		{format: "", json: true, actions: true, want: "json"},
+	}
+
+	for _, tt := range tests {
+		outputFormat = tt.format
+		jsonOutput = tt.json
+		if tt.actions {
+			t.Setenv("GITHUB_ACTIONS", "true")
+		} else {
+			t.Setenv("GITHUB_ACTIONS", "")
+		}
+		got, err := resolveFormat()
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("resolveFormat(%q, json=%v) expected error", tt.format, tt.json)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("resolveFormat(%q, json=%v, actions=%v) unexpected error: %v", tt.format, tt.json, tt.actions, err)
+		}
+		if got != tt.want {
+			t.Errorf("resolveFormat(%q, json=%v, actions=%v) = %q, want %q", tt.format, tt.json, tt.actions, got, tt.want)
+		}
+	}
+}
+
+func TestRunCheck_FormatJUnitToOutputFile(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `version: "1"
+checks:
+  - id: build
+    run: 'echo "build failed"; exit 1'
+    severity: error
+    timeout: 10s
+  - id: test
+    run: "true"
+    requires: [build]
+    severity: error
+    timeout: 10s
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	reportPath := filepath.Join(tmpDir, "report.xml")
+
+	oldConfig := configFile
+	oldJSON := jsonOutput
+	oldFormat := outputFormat
+	oldOutput := outputFile
+	defer func() {
+		configFile = oldConfig
+		jsonOutput = oldJSON
+		outputFormat = oldFormat
+		outputFile = oldOutput
+	}()
+
+	configFile = configPath
+	jsonOutput = false
+	outputFormat = "junit"
+	outputFile = reportPath
+
+	err := runCheck(checkCmd, []string{})
+	exitErr, ok := err.(*ExitError)
+	if !ok || exitErr.Code != 1 {
+		t.Fatalf("expected ExitError with code 1, got %v", err)
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("expected report file to be written: %v", err)
+	}
+
+	var report output.JUnitTestSuites
+	if err := xml.Unmarshal(data, &report); err != nil {
+		t.Fatalf("report is not valid JUnit XML: %v\n%s", err, data)
+	}
+	if report.Tests != 2 || report.Failures != 1 || report.Skipped != 1 {
+		t.Errorf("unexpected totals: tests=%d failures=%d skipped=%d", report.Tests, report.Failures, report.Skipped)
+	}
+	build := report.Suites[0].TestCases[0]
+	if build.Failure == nil || !strings.Contains(build.SystemOut, "build failed") {
+		t.Errorf("expected build failure with captured output, got %+v", build)
+	}
+}
+
+func TestRunCheck_OutputFileInvalidPath(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte("version: \"1\"\nchecks:\n  - id: ok\n    run: \"true\"\n"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig := configFile
+	oldOutput := outputFile
+	defer func() {
+		configFile = oldConfig
+		outputFile = oldOutput
+	}()
+
+	configFile = configPath
+	outputFile = filepath.Join(tmpDir, "missing-dir", "report.txt")
+
+	err := runCheck(checkCmd, []string{})
+	if err == nil || !strings.Contains(err.Error(), "failed to create output file") {
+		t.Errorf("expected output file error, got %v", err)
+	}
+}
+
+func TestRunCheck_OutputFileJSONWithMkdir(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := "version: \"1\"\nchecks:\n  - id: ok\n    run: \"true\"\n  - id: broken\n    run: \"exit 1\"\n"
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	reportDir := filepath.Join(tmpDir, "reports", "ci")
+	reportPath := filepath.Join(reportDir, "results.json")
+
+	oldConfig, oldJSON, oldFormat, oldOutput, oldMkdir := configFile, jsonOutput, outputFormat, outputFile, outputMkdir
+	defer func() {
+		configFile, jsonOutput, outputFormat, outputFile, outputMkdir = oldConfig, oldJSON, oldFormat, oldOutput, oldMkdir
+	}()
+
+	configFile = configPath
+	jsonOutput = false
+	outputFormat = "json"
+	outputFile = reportPath
+	outputMkdir = true
+
+	err := runCheck(checkCmd, []string{})
+	exitErr, ok := err.(*ExitError)
+	if !ok || exitErr.Code != 1 {
+		t.Fatalf("expected ExitError with code 1, got %v", err)
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("expected report file to be written: %v", err)
+	}
+	var report map[string]any
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("report is not valid JSON: %v\n%s", err, data)
+	}
+	if got := report["exit_code"]; got != float64(1) {
+		t.Errorf("expected exit_code 1 in the report, got %v", got)
+	}
+
+	// The temporary file was renamed into place
+	entries, err := os.ReadDir(reportDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "results.json" {
+		t.Errorf("expected only the report in %s, got %v", reportDir, entries)
+	}
+}
+
+func TestRunCheck_JSONToStdout(t *testing.T) {
+	tmpDir := t.TempDir()
+	configContent := "version: \"1\"\nchecks:\n  - id: ok\n    run: \"true\"\n  - id: broken\n    run: \"exit 1\"\n"
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig, oldJSON, oldStderr := configFile, jsonOutput, os.Stderr
+	defer func() {
+		configFile, jsonOutput, os.Stderr = oldConfig, oldJSON, oldStderr
+		checkCmd.SetOut(nil)
+	}()
+
+	configFile = configPath
+	jsonOutput = true
+
+	stderrFile, err := os.Create(filepath.Join(tmpDir, "stderr"))
+	if err != nil {
+		t.Fatalf("failed to create stderr capture: %v", err)
+	}
+	defer func() { _ = stderrFile.Close() }()
+	os.Stderr = stderrFile
+	var stdout bytes.Buffer
+	checkCmd.SetOut(&stdout)
+
+	err = runCheck(checkCmd, []string{})
+	if exitErr, ok := err.(*ExitError); !ok || exitErr.Code != 1 {
+		t.Fatalf("expected ExitError with code 1, got %v", err)
+	}
+
+	// stdout holds exactly one JSON document, so it can be piped to jq
+	var report map[string]any
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		t.Fatalf("stdout is not a JSON report: %v\n%s", err, stdout.String())
+	}
+	if got := report["exit_code"]; got != float64(1) {
+		t.Errorf("expected exit_code 1 in the report, got %v", got)
+	}
+
+	stderrData, err := os.ReadFile(stderrFile.Name())
+	if err != nil {
+		t.Fatalf("failed to read captured stderr: %v", err)
+	}
+	if bytes.Contains(stderrData, []byte(`"exit_code"`)) {
+		t.Errorf("expected JSON report on stdout only, stderr got:\n%s", stderrData)
+	}
+}
+
+func TestRunCheck_JSONMetadata(t *testing.T) {
+	tmpDir := t.TempDir()
+	configContent := `version: "1"
+name: payments-api
+description: Checks for the payments service
+checks:
+  - id: fmt
+    run: "true"
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	reportPath := filepath.Join(tmpDir, "report.json")
+
+	oldConfig, oldJSON, oldFormat, oldOutput := configFile, jsonOutput, outputFormat, outputFile
+	defer func() {
+		configFile, jsonOutput, outputFormat, outputFile = oldConfig, oldJSON, oldFormat, oldOutput
+	}()
+
+	configFile = configPath
+	jsonOutput = false
+	outputFormat = "json"
+	outputFile = reportPath
+
+	if err := runCheck(checkCmd, []string{}); err != nil {
+		t.Fatalf("runCheck failed: %v", err)
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("expected report file to be written: %v", err)
+	}
+	var report struct {
+		Metadata map[string]string `json:"metadata"`
+	}
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("report is not valid JSON: %v\n%s", err, data)
+	}
+	if got := report.Metadata["name"]; got != "payments-api" {
+		t.Errorf("expected name %q in metadata, got %q", "payments-api", got)
+	}
+	if got := report.Metadata["description"]; got != "Checks for the payments service" {
+		t.Errorf("expected description %q in metadata, got %q", "Checks for the payments service", got)
+	}
+}
+
+func TestRunCheck_Profile(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := "version: \"1\"\nchecks:\n  - id: fmt\n    run: \"true\"\n  - id: vet\n    run: \"true\"\n  - id: test\n    run: \"true\"\n    requires: [fmt, vet]\n"
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	tracePath := filepath.Join(tmpDir, "trace.json")
+
+	oldConfig, oldProfile, oldNoCache, oldStderr := configFile, profileFile, noCache, os.Stderr
+	defer func() {
+		configFile, profileFile, noCache, os.Stderr = oldConfig, oldProfile, oldNoCache, oldStderr
+	}()
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = devNull.Close() }()
+
+	configFile = configPath
+	profileFile = tracePath
+	noCache = true
+	os.Stderr = devNull
+
+	if err := runCheck(checkCmd, []string{}); err != nil {
+		t.Fatalf("runCheck failed: %v", err)
+	}
+
+	data, err := os.ReadFile(tracePath)
+	if err != nil {
+		t.Fatalf("expected trace file to be written: %v", err)
+	}
+	var trace output.Trace
+	if err := json.Unmarshal(data, &trace); err != nil {
+		t.Fatalf("trace is not valid JSON: %v\n%s", err, data)
+	}
+
+	// One complete event per executed check, carrying its level
+	levels := make(map[string]any)
+	for _, e := range trace.TraceEvents {
+		if e.Ph == "X" {
+			levels[e.Name] = e.Args["level"]
+		}
+	}
+	want := map[string]any{"fmt": float64(0), "vet": float64(0), "test": float64(1)}
+	if !reflect.DeepEqual(levels, want) {
+		t.Errorf("expected complete events %v, got %v", want, levels)
+	}
+}
+
+func TestRunCheck_CoverageThresholdAssert(t *testing.T) {
+	tests := []struct {
+		name       string
+		coverage   string
+		wantFail   bool
+		wantOutput string
+	}{
+		{name: "above threshold", coverage: "85.0", wantFail: false},
+		{name: "at threshold", coverage: "80", wantFail: false},
+		{name: "below threshold", coverage: "72.5", wantFail: true, wantOutput: "Coverage is 72.5%, below the 80% minimum"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+
+			// The command always exits 0, so pass/fail is decided by the assertion
+			configContent := `version: "1"
+vars:
+  min_coverage: "80"
+checks:
+  - id: coverage
+    run: 'echo "total:  (statements)  ` + tt.coverage + `%"'
+    grok:
+      - "total:.*?%{NUMBER:coverage}%"
+    assert: "coverage >= {{.min_coverage}}"
+    suggestion: "Coverage is {{.coverage}}%, below the {{.min_coverage}}% minimum"
+    timeout: 10s
+`
+			configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+			if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+				t.Fatalf("failed to write config: %v", err)
+			}
+
+			oldConfig := configFile
+			oldVerbose := verbose
+			oldJSON := jsonOutput
+			oldLogDir := logDir
+			oldStderr := os.Stderr
+			defer func() {
+				configFile = oldConfig
+				verbose = oldVerbose
+				jsonOutput = oldJSON
+				logDir = oldLogDir
+				os.Stderr = oldStderr
+			}()
+
+			configFile = configPath
+			verbose = false
+			jsonOutput = false
+			logDir = filepath.Join(tmpDir, "logs")
+
+			stderrFile, err := os.Create(filepath.Join(tmpDir, "stderr"))
+			if err != nil {
+				t.Fatalf("failed to create stderr capture: %v", err)
+			}
+			defer func() { _ = stderrFile.Close() }()
+			os.Stderr = stderrFile
+
+			err = runCheck(checkCmd, []string{})
+
+			data, readErr := os.ReadFile(stderrFile.Name())
+			if readErr != nil {
+				t.Fatalf("failed to read captured output: %v", readErr)
+			}
+
+			if !tt.wantFail {
+				if err != nil {
+					t.Fatalf("expected check to pass, got %v\n%s", err, data)
+				}
+				return
+			}
+
+			if _, ok := err.(*ExitError); !ok {
+				t.Fatalf("expected ExitError, got %T: %v", err, err)
+			}
+			if !strings.Contains(string(data), tt.wantOutput) {
+				t.Errorf("expected output to contain %q, got:\n%s", tt.wantOutput, data)
+			}
+		})
+	}
+}
+
+func TestRunCheck_Fix(t *testing.T) {
+	tmpDir := t.TempDir()
+	marker := filepath.Join(tmpDir, "formatted")
+
+	configContent := `version: "1"
+checks:
+  - id: fmt
+    run: 'test -f ` + marker + `'
+    fix: 'touch ` + marker + `'
+  - id: lint
+    run: 'exit 1'
+    fix: 'true'
+    suggestion: "Lint failed"
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig := configFile
+	oldVerbose := verbose
+	oldJSON := jsonOutput
+	oldLogDir := logDir
+	oldAutoFix := autoFix
+	oldStderr := os.Stderr
+	defer func() {
+		configFile = oldConfig
+		verbose = oldVerbose
+		jsonOutput = oldJSON
+		logDir = oldLogDir
+		autoFix = oldAutoFix
+		os.Stderr = oldStderr
+	}()
+
+	configFile = configPath
+	verbose = false
+	jsonOutput = false
+	logDir = filepath.Join(tmpDir, "logs")
+	autoFix = true
+
+	stderrFile, err := os.Create(filepath.Join(tmpDir, "stderr"))
+	if err != nil {
+		t.Fatalf("failed to create stderr capture: %v", err)
+	}
+	defer func() { _ = stderrFile.Close() }()
+	os.Stderr = stderrFile
+
+	err = runCheck(checkCmd, []string{})
+
+	data, readErr := os.ReadFile(stderrFile.Name())
+	if readErr != nil {
+		t.Fatalf("failed to read captured output: %v", readErr)
+	}
+	output := string(data)
+
+	if _, ok := err.(*ExitError); !ok {
+		t.Fatalf("expected ExitError for the still-failing check, got %T: %v", err, err)
+	}
+	if _, statErr := os.Stat(marker); statErr != nil {
+		t.Errorf("expected fix command to create %s: %v", marker, statErr)
+	}
+	if !strings.Contains(output, "FIXED  fmt") {
+		t.Errorf("expected fmt to be reported as fixed, got:\n%s", output)
+	}
+	if !strings.Contains(output, "FAIL  lint") || !strings.Contains(output, "Auto-fix ran but the check still fails") {
+		t.Errorf("expected lint to be reported as still failing, got:\n%s", output)
+	}
+}
+
+func TestRunCheck_DryRun(t *testing.T) {
+	tmpDir := t.TempDir()
+	marker := filepath.Join(tmpDir, "executed")
+
+	// Diamond: base <- (left, right) <- top. Every command would leave a
+	// marker file behind if it ran.
+	configContent := `version: "1"
+vars:
+  target: "./cmd/..."
+checks:
+  - id: top
+    run: 'touch ` + marker + ` && go build {{.target}}'
+    requires: [left, right]
+  - id: left
+    run: 'touch ` + marker + `'
+    requires: [base]
+  - id: right
+    run: 'touch ` + marker + `'
+    requires: [base]
+  - id: base
+    run: 'touch ` + marker + `'
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig, oldVerbose, oldJSON, oldLogDir, oldDryRun := configFile, verbose, jsonOutput, logDir, dryRun
+	defer func() {
+		configFile, verbose, jsonOutput, logDir, dryRun = oldConfig, oldVerbose, oldJSON, oldLogDir, oldDryRun
+	}()
+
+	configFile = configPath
+	verbose = false
+	jsonOutput = false
+	logDir = filepath.Join(tmpDir, "logs")
+	dryRun = true
+
+	var buf bytes.Buffer
+	rootCmd.SetOut(&buf)
+	defer rootCmd.SetOut(nil)
+
+	if err := runCheck(checkCmd, []string{}); err != nil {
+		t.Fatalf("dry run failed: %v", err)
+	}
+
+	if _, err := os.Stat(marker); err == nil {
+		t.Fatal("dry run executed a check command")
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"Dry run: 4 checks in 3 levels",
+		"Level 1:\n  base\n",
+		"Level 2:\n  left\n",
+		"  right\n",
+		"Level 3:\n  top\n",
+		"go build ./cmd/...",
+		"requires: left, right",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Index(out, "Level 2:") > strings.Index(out, "  right\n") {
+		t.Errorf("expected right in level 2, got:\n%s", out)
+	}
+}
+
+func TestParseTimeoutOverride(t *testing.T) {
+	cfg := &config.Config{Checks: []config.Check{{ID: "fmt"}, {ID: "test"}}}
+
+	override, err := parseTimeoutOverride([]string{"1m", "test=10m"}, cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if override.Default != time.Minute {
+		t.Errorf("expected default 1m, got %v", override.Default)
+	}
+	if got := override.Checks["test"]; got != 10*time.Minute {
+		t.Errorf("expected test override 10m, got %v", got)
+	}
+	if _, ok := override.Checks["fmt"]; ok {
+		t.Error("expected no override for fmt")
+	}
+
+	// Unset and zero leave config values alone
+	for _, values := range [][]string{nil, {"0"}} {
+		override, err := parseTimeoutOverride(values, cfg)
+		if err != nil {
+			t.Fatalf("unexpected error for %v: %v", values, err)
+		}
+		if override.Default != 0 || len(override.Checks) != 0 {
+			t.Errorf("expected empty override for %v, got %+v", values, override)
+		}
+	}
+
+	for _, bad := range []string{"soon", "test=", "nope=1m", "-1s"} {
+		if _, err := parseTimeoutOverride([]string{bad}, cfg); err == nil {
+			t.Errorf("expected error for --timeout %q", bad)
+		}
+	}
+}
+
+func TestParseSeverityOverride(t *testing.T) {
+	cfg := &config.Config{Checks: []config.Check{{ID: "lint"}, {ID: "test"}}}
+
+	override, err := parseSeverityOverride([]string{"lint=warning", " test = info "}, cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]config.Severity{"lint": config.SeverityWarning, "test": config.SeverityInfo}
+	if !reflect.DeepEqual(override, want) {
+		t.Errorf("expected %v, got %v", want, override)
+	}
+
+	for _, bad := range []string{"lint", "=warning", "lint=low", "nope=warning"} {
+		if _, err := parseSeverityOverride([]string{bad}, cfg); err == nil {
+			t.Errorf("expected error for --severity %q", bad)
+		}
+	}
+}
+
+func TestRunCheck_SeverityOverride(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte("version: \"1\"\nchecks:\n  - id: lint\n    run: \"exit 1\"\n"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig, oldVerbose, oldJSON, oldLogDir, oldSeverity := configFile, verbose, jsonOutput, logDir, severityFlags
+	oldStderr := os.Stderr
+	defer func() {
+		configFile, verbose, jsonOutput, logDir, severityFlags = oldConfig, oldVerbose, oldJSON, oldLogDir, oldSeverity
+		os.Stderr = oldStderr
+	}()
+
+	configFile = configPath
+	verbose = false
+	jsonOutput = false
+	logDir = filepath.Join(tmpDir, "logs")
+	if devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0); err == nil {
+		defer func() { _ = devNull.Close() }()
+		os.Stderr = devNull
+	}
+
+	severityFlags = nil
+	if exitErr, ok := runCheck(checkCmd, nil).(*ExitError); !ok || exitErr.Code != 1 {
+		t.Fatalf("expected the error check to exit 1, got %v", exitErr)
+	}
+
+	severityFlags = []string{"lint=warning"}
+	if err := runCheck(checkCmd, nil); err != nil {
+		t.Errorf("expected the demoted check not to fail the run, got: %v", err)
+	}
+}
+
+func TestRunCheck_WarningsAsErrors(t *testing.T) {
+	tmpDir := t.TempDir()
+	configContent := `version: "1"
+checks:
+  - id: lint
+    run: "exit 1"
+    severity: warning
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig, oldVerbose, oldJSON, oldLogDir, oldWarnings := configFile, verbose, jsonOutput, logDir, warningsAsErrors
+	oldStderr := os.Stderr
+	defer func() {
+		configFile, verbose, jsonOutput, logDir, warningsAsErrors = oldConfig, oldVerbose, oldJSON, oldLogDir, oldWarnings
+		os.Stderr = oldStderr
+	}()
+
+	configFile = configPath
+	verbose = false
+	jsonOutput = false
+	logDir = filepath.Join(tmpDir, "logs")
+	if devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0); err == nil {
+		defer func() { _ = devNull.Close() }()
+		os.Stderr = devNull
+	}
+
+	warningsAsErrors = false
+	if err := runCheck(checkCmd, nil); err != nil {
+		t.Errorf("expected warning-only failure to pass, got: %v", err)
+	}
+
+	warningsAsErrors = true
+	err := runCheck(checkCmd, nil)
+	exitErr, ok := err.(*ExitError)
+	if !ok {
+		t.Fatalf("expected ExitError with --warnings-as-errors, got: %v", err)
+	}
+	if exitErr.Code != GetErrorExitCode() {
+		t.Errorf("expected exit code %d, got %d", GetErrorExitCode(), exitErr.Code)
+	}
+}
+
+func TestRunCheck_FailUnder(t *testing.T) {
+	tmpDir := t.TempDir()
+	configContent := `version: "1"
+checks:
+  - id: api-tests
+    run: "echo 'coverage: 91.2% of statements'"
+    grok: ['coverage: %{NUMBER:coverage}% of statements']
+  - id: web-tests
+    run: "echo 'coverage: 64.0% of statements'"
+    grok: ['coverage: %{NUMBER:coverage}% of statements']
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig, oldVerbose, oldJSON, oldLogDir, oldFailUnder := configFile, verbose, jsonOutput, logDir, failUnder
+	oldFormat, oldOutput := outputFormat, outputFile
+	oldStderr := os.Stderr
+	defer func() {
+		configFile, verbose, jsonOutput, logDir, failUnder = oldConfig, oldVerbose, oldJSON, oldLogDir, oldFailUnder
+		outputFormat, outputFile = oldFormat, oldOutput
+		os.Stderr = oldStderr
+	}()
+
+	configFile = configPath
+	verbose = false
+	jsonOutput = false
+	logDir = filepath.Join(tmpDir, "logs")
+	outputFormat = "json"
+	outputFile = filepath.Join(tmpDir, "report.json")
+	if devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0); err == nil {
+		defer func() { _ = devNull.Close() }()
+		os.Stderr = devNull
+	}
+
+	failUnder = 60
+	if err := runCheck(checkCmd, nil); err != nil {
+		t.Errorf("expected every coverage above --fail-under 60 to pass, got: %v", err)
+	}
+
+	failUnder = 80
+	err := runCheck(checkCmd, nil)
+	if exitErr, ok := err.(*ExitError); !ok || exitErr.Code != GetErrorExitCode() {
+		t.Fatalf("expected ExitError with code %d under --fail-under 80, got: %v", GetErrorExitCode(), err)
+	}
+	data, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("failed to read report: %v", err)
+	}
+	var report output.JSONOutput
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("failed to parse report: %v", err)
+	}
+	if len(report.Violations) != 1 || report.Violations[0].ID != "web-tests" {
+		t.Fatalf("expected a single web-tests violation, got %+v", report.Violations)
+	}
+	if want := "Coverage 64.0% is below --fail-under 80%"; report.Violations[0].Suggestion != want {
+		t.Errorf("expected suggestion %q, got %q", want, report.Violations[0].Suggestion)
+	}
+
+	failUnder = 120
+	if err := runCheck(checkCmd, nil); err == nil || !strings.Contains(err.Error(), "invalid --fail-under 120") {
+		t.Errorf("expected an invalid --fail-under error, got: %v", err)
+	}
+}
+
+func TestRunCheck_FromSubdirectory(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "marker"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	configContent := `version: "1"
+checks:
+  - id: coverage
+    run: "test -f marker && echo 'coverage: 91%' > report.txt"
+    file: report.txt
+    grok: "coverage: %{NUMBER:coverage}%"
+    assert: "coverage >= 90"
+`
+	if err := os.WriteFile(filepath.Join(root, "vibeguard.yaml"), []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	nested := filepath.Join(root, "internal", "pkg")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	oldConfig, oldVerbose, oldJSON, oldLogDir, oldNoCache := configFile, verbose, jsonOutput, logDir, noCache
+	oldStderr := os.Stderr
+	defer func() {
+		configFile, verbose, jsonOutput, logDir, noCache = oldConfig, oldVerbose, oldJSON, oldLogDir, oldNoCache
+		os.Stderr = oldStderr
+	}()
+	configFile = ""
+	verbose = false
+	jsonOutput = false
+	logDir = filepath.Join(t.TempDir(), "logs")
+	noCache = true
+	if devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0); err == nil {
+		defer func() { _ = devNull.Close() }()
+		os.Stderr = devNull
+	}
+	t.Chdir(nested)
+
+	// The root config is found, its command runs at the root and its file
+	// is read from there
+	if err := runCheck(checkCmd, nil); err != nil {
+		t.Errorf("expected the check to pass from a subdirectory, got: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "report.txt")); err != nil {
+		t.Errorf("expected report.txt at the config's directory: %v", err)
+	}
+}
+
+func TestRunCheck_SelectedChecks(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `version: "1"
+checks:
+  - id: build
+    run: "true"
+  - id: test
+    run: "true"
+    requires: [build]
+  - id: docs
+    run: "false"
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	reportPath := filepath.Join(tmpDir, "report.json")
+
+	oldConfig, oldFormat, oldOutput, oldSelected, oldLogDir := configFile, outputFormat, outputFile, selectedChecks, logDir
+	defer func() {
+		configFile, outputFormat, outputFile, selectedChecks, logDir = oldConfig, oldFormat, oldOutput, oldSelected, oldLogDir
+	}()
+	configFile = configPath
+	outputFormat = "json"
+	outputFile = reportPath
+	logDir = filepath.Join(tmpDir, "log")
+	selectedChecks = []string{"test"}
+
+	if err := runCheck(checkCmd, []string{}); err != nil {
+		t.Fatalf("expected docs not to run, got %v", err)
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("expected report file to be written: %v", err)
+	}
+	var report output.JSONOutput
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("invalid JSON report: %v", err)
+	}
+	var ran []string
+	for _, check := range report.Checks {
+		ran = append(ran, check.ID)
+	}
+	if !reflect.DeepEqual(ran, []string{"build", "test"}) {
+		t.Errorf("expected build and test to run, got %v", ran)
+	}
+
+	if err := runCheck(checkCmd, []string{"docs"}); err == nil || !strings.Contains(err.Error(), "--check cannot be combined") {
+		t.Errorf("expected conflict error with a check ID argument, got %v", err)
+	}
+}
+
+func TestRunCheck_Provenance(t *testing.T) {
+	tmpDir := t.TempDir()
+	configContent := `version: "1"
+checks:
+  - id: pass
+    run: "true"
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	// Commit the config when git is available; the git fields stay empty
+	// otherwise
+	_, lookErr := exec.LookPath("git")
+	hasGit := lookErr == nil
+	if hasGit {
+		for _, args := range [][]string{
+			{"init", "-q", "-b", "main"},
+			{"add", "."},
+			{"-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "initial"},
+		} {
+			cmd := exec.Command("git", args...)
+			cmd.Dir = tmpDir
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("git %s failed: %v\n%s", strings.Join(args, " "), err, out)
+			}
+		}
+	}
+
+	oldConfig, oldVerbose, oldJSON, oldLogDir := configFile, verbose, jsonOutput, logDir
+	oldFormat, oldOutput := outputFormat, outputFile
+	oldStderr := os.Stderr
+	defer func() {
+		configFile, verbose, jsonOutput, logDir = oldConfig, oldVerbose, oldJSON, oldLogDir
+		outputFormat, outputFile = oldFormat, oldOutput
+		os.Stderr = oldStderr
+	}()
+
+	configFile = configPath
+	verbose = false
+	jsonOutput = false
+	logDir = filepath.Join(t.TempDir(), "logs")
+	outputFormat = "json"
+	outputFile = filepath.Join(t.TempDir(), "report.json")
+	if devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0); err == nil {
+		defer func() { _ = devNull.Close() }()
+		os.Stderr = devNull
+	}
+
+	if err := runCheck(checkCmd, nil); err != nil {
+		t.Fatalf("runCheck failed: %v", err)
+	}
+	data, err := os.ReadFile(outputFile)
+	if err != nil {
+		t.Fatalf("failed to read report: %v", err)
+	}
+	var report output.JSONOutput
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("failed to parse report: %v", err)
+	}
+	if report.Metadata == nil {
+		t.Fatal("expected report metadata")
+	}
+
+	if absPath, _ := filepath.Abs(configPath); report.Metadata.ConfigPath != absPath {
+		t.Errorf("expected config path %q, got %q", absPath, report.Metadata.ConfigPath)
+	}
+	sum := sha256.Sum256([]byte(configContent))
+	if want := hex.EncodeToString(sum[:]); report.Metadata.ConfigHash != want {
+		t.Errorf("expected config hash %q, got %q", want, report.Metadata.ConfigHash)
+	}
+	if !hasGit {
+		return
+	}
+	if len(report.Metadata.GitCommit) != 40 {
+		t.Errorf("expected a full commit hash, got %q", report.Metadata.GitCommit)
+	}
+	if report.Metadata.GitBranch != "main" {
+		t.Errorf("expected branch main, got %q", report.Metadata.GitBranch)
+	}
+}
@@ -0,0 +1,411 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vibeguard/vibeguard/internal/cli/inspector"
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check that the tools the checks run are installed",
+	Long: `Check that the executables the configured checks run are installed, before
+a check fails with "command not found".
+
+For each check, doctor finds the shell it runs through and, for sh and bash
+checks, the executables its run command calls: the first word of each command
+in a list or pipeline and in $(...) substitutions, after variable assignments
+and prefixes such as env or exec. Shell builtins, words containing $ and
+anything after 'for' or 'case' are left out. Commands are looked up on PATH;
+paths containing a / are resolved from the config's directory. The parse is
+best-effort: commands run by find -exec, xargs or scripts are not seen.
+
+Missing tools are listed with an install hint where vibeguard knows the tool.
+A tool is required when an error-severity check without allow_failure calls
+it outside the left side of ||, where a missing tool fails the run. doctor
+exits with code 1 if a required tool is missing.
+
+Examples:
+  vibeguard doctor          Check the tools of the default config
+  vibeguard doctor --json   Print every tool, where it was found and its hint`,
+	Args: cobra.NoArgs,
+	RunE: runDoctor,
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
+
+// doctorTool is an executable the checks run and whether it was found.
+type doctorTool struct {
+	Name     string   `json:"name"`
+	Path     string   `json:"path,omitempty"` // Where the executable was found
+	Found    bool     `json:"found"`
+	Required bool     `json:"required"` // Missing, it fails a check that fails the run
+	Checks   []string `json:"checks"`   // IDs of the checks that run it
+	Install  string   `json:"install,omitempty"`
+}
+
+// doctorJSON is the JSON document written by `vibeguard doctor --json`.
+type doctorJSON struct {
+	Tools           []doctorTool `json:"tools"`
+	Missing         int          `json:"missing"`
+	MissingRequired int          `json:"missing_required"`
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	tools := doctorTools(cfg)
+	doc := doctorJSON{Tools: tools}
+	for _, tool := range tools {
+		if !tool.Found {
+			doc.Missing++
+			if tool.Required {
+				doc.MissingRequired++
+			}
+		}
+	}
+
+	out := cmd.OutOrStdout()
+	if jsonOutput {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(doc); err != nil {
+			return err
+		}
+	} else {
+		writeDoctorText(out, doc, len(cfg.Checks))
+	}
+
+	if doc.MissingRequired > 0 {
+		return &ExitError{Code: executor.ExitCodeFailure}
+	}
+	return nil
+}
+
+// doctorTools returns the executables cfg's checks run, sorted by name, each
+// looked up on PATH.
+func doctorTools(cfg *config.Config) []doctorTool {
+	byName := make(map[string]*doctorTool)
+	for _, check := range cfg.Checks {
+		if check.Run == "" {
+			continue
+		}
+		failsRun := check.Severity == config.SeverityError && !check.AllowFailure
+
+		shell := check.Shell
+		if shell == "" {
+			shell = executor.DefaultShell()
+		}
+		executables := []commandExecutable{{Name: shell}}
+		if shell == "sh" || shell == "bash" {
+			executables = append(executables, commandExecutables(check.Run)...)
+		}
+
+		for _, e := range executables {
+			tool, ok := byName[e.Name]
+			if !ok {
+				tool = &doctorTool{Name: e.Name, Install: inspector.InstallHint(e.Name)}
+				tool.Path, tool.Found = lookupExecutable(e.Name, cfg.Dir())
+				byName[e.Name] = tool
+			}
+			if len(tool.Checks) == 0 || tool.Checks[len(tool.Checks)-1] != check.ID {
+				tool.Checks = append(tool.Checks, check.ID)
+			}
+			tool.Required = tool.Required || (failsRun && !e.Optional)
+		}
+	}
+
+	tools := make([]doctorTool, 0, len(byName))
+	for _, tool := range byName {
+		tools = append(tools, *tool)
+	}
+	sort.Slice(tools, func(i, j int) bool { return tools[i].Name < tools[j].Name })
+	return tools
+}
+
+// lookupExecutable returns the path of the executable name, searching PATH
+// for a bare name and resolving a path with a / from dir.
+func lookupExecutable(name, dir string) (string, bool) {
+	if strings.Contains(filepath.ToSlash(name), "/") && !filepath.IsAbs(name) {
+		name = filepath.Join(dir, name)
+	}
+	path, err := exec.LookPath(name)
+	if err != nil && !errors.Is(err, exec.ErrDot) {
+		return "", false
+	}
+	return path, true
+}
+
+// writeDoctorText writes the found and missing tools as a human-readable
+// report.
+func writeDoctorText(out io.Writer, doc doctorJSON, checks int) {
+	width := 0
+	for _, tool := range doc.Tools {
+		width = max(width, len(tool.Name))
+	}
+
+	var found, missing []doctorTool
+	for _, tool := range doc.Tools {
+		if tool.Found {
+			found = append(found, tool)
+		} else {
+			missing = append(missing, tool)
+		}
+	}
+
+	if len(found) > 0 {
+		_, _ = fmt.Fprintln(out, "Found:")
+		for _, tool := range found {
+			_, _ = fmt.Fprintf(out, "  %-*s  %s\n", width, tool.Name, tool.Path)
+		}
+	}
+	if len(missing) > 0 {
+		if len(found) > 0 {
+			_, _ = fmt.Fprintln(out)
+		}
+		_, _ = fmt.Fprintln(out, "Missing:")
+		for _, tool := range missing {
+			usage := "required by " + strings.Join(tool.Checks, ", ")
+			if !tool.Required {
+				usage = "used by " + strings.Join(tool.Checks, ", ") + " (does not fail the run)"
+			}
+			_, _ = fmt.Fprintf(out, "  %-*s  %s\n", width, tool.Name, usage)
+			if tool.Install != "" {
+				_, _ = fmt.Fprintf(out, "  %-*s  Install: %s\n", width, "", tool.Install)
+			}
+		}
+	}
+
+	_, _ = fmt.Fprintln(out)
+	if doc.Missing == 0 {
+		_, _ = fmt.Fprintf(out, "All %d tools used by %d checks are installed\n", len(doc.Tools), checks)
+		return
+	}
+	_, _ = fmt.Fprintf(out, "%d of %d tools missing, %d required\n", doc.Missing, len(doc.Tools), doc.MissingRequired)
+}
+
+// commandExecutable is an executable a shell command calls.
+type commandExecutable struct {
+	Name     string
+	Optional bool // Called on the left of ||, so the command handles its failure
+}
+
+// shellBuiltins are the sh and bash builtins and keywords that aren't looked
+// up on PATH.
+var shellBuiltins = map[string]bool{
+	".": true, ":": true, "[": true, "[[": true, "}": true,
+	"alias": true, "break": true, "cd": true, "continue": true, "declare": true,
+	"echo": true, "eval": true, "exit": true, "export": true, "false": true,
+	"getopts": true, "let": true, "local": true, "printf": true, "pwd": true,
+	"read": true, "readonly": true, "return": true, "set": true, "shift": true,
+	"source": true, "test": true, "trap": true, "true": true, "type": true,
+	"ulimit": true, "umask": true, "unset": true, "wait": true,
+}
+
+// shellKeywords start or end compound commands whose words aren't commands.
+var shellKeywords = map[string]bool{
+	"for": true, "case": true, "select": true, "function": true, "in": true,
+	"fi": true, "done": true, "esac": true,
+}
+
+// commandPrefixes are words that run the command after them. The leading
+// executable is looked for after them; env, nohup and time themselves are
+// assumed to be present.
+var commandPrefixes = map[string]bool{
+	"if": true, "then": true, "else": true, "elif": true, "while": true,
+	"until": true, "do": true, "!": true, "{": true,
+	"env": true, "exec": true, "command": true, "nohup": true, "time": true,
+}
+
+// commandExecutables returns, best-effort, the executables the sh command
+// run calls, each once: the leading word of every simple command in its
+// lists, pipelines and command substitutions.
+func commandExecutables(run string) []commandExecutable {
+	var executables []commandExecutable
+	seen := make(map[string]int)
+	for _, c := range splitShellCommands(run) {
+		name := leadingExecutable(c.words)
+		if name == "" {
+			continue
+		}
+		if i, ok := seen[name]; ok {
+			executables[i].Optional = executables[i].Optional && c.optional
+			continue
+		}
+		seen[name] = len(executables)
+		executables = append(executables, commandExecutable{Name: name, Optional: c.optional})
+	}
+	return executables
+}
+
+// shellCommand is a simple command: the words between control operators.
+type shellCommand struct {
+	words    []string // Words with their quotes removed
+	optional bool     // Followed by ||
+}
+
+// splitShellCommands splits run into simple commands at ;, &, |, &&, ||,
+// newlines and parentheses, outside quotes. Command substitutions, even in
+// double quotes, are split recursively and stand in their word as "_".
+func splitShellCommands(run string) []shellCommand {
+	var commands []shellCommand
+	var words []string
+	var word strings.Builder
+	inWord, inSingle, inDouble := false, false, false
+
+	endWord := func() {
+		if inWord {
+			words = append(words, word.String())
+			word.Reset()
+			inWord = false
+		}
+	}
+	endCommand := func(optional bool) {
+		endWord()
+		if len(words) > 0 {
+			commands = append(commands, shellCommand{words: words, optional: optional})
+		}
+		words = nil
+	}
+
+	for i := 0; i < len(run); i++ {
+		ch := run[i]
+		switch {
+		case inSingle:
+			if ch == '\'' {
+				inSingle = false
+			} else {
+				word.WriteByte(ch)
+			}
+		case ch == '\\' && i+1 < len(run):
+			i++
+			word.WriteByte(run[i])
+			inWord = true
+		case ch == '\'' && !inDouble:
+			inSingle, inWord = true, true
+		case ch == '"':
+			inDouble, inWord = !inDouble, true
+		case ch == '$' && strings.HasPrefix(run[i:], "$("):
+			end := closingParen(run, i+1)
+			if !strings.HasPrefix(run[i:], "$((") { // Not arithmetic expansion
+				commands = append(commands, splitShellCommands(run[min(i+2, end):end])...)
+			}
+			word.WriteByte('_')
+			inWord = true
+			i = end
+		case ch == '`':
+			end := strings.IndexByte(run[i+1:], '`')
+			if end < 0 {
+				end = len(run) - i - 1
+			}
+			commands = append(commands, splitShellCommands(run[i+1:i+1+end])...)
+			word.WriteByte('_')
+			inWord = true
+			i += end + 1
+		case inDouble:
+			word.WriteByte(ch)
+		case ch == ' ' || ch == '\t':
+			endWord()
+		case ch == '#' && !inWord:
+			// A comment runs to the end of the line
+			for i+1 < len(run) && run[i+1] != '\n' {
+				i++
+			}
+		case ch == '&' && (i > 0 && (run[i-1] == '>' || run[i-1] == '<') || strings.HasPrefix(run[i:], "&>")):
+			// Part of a redirection such as 2>&1 or &>file
+			word.WriteByte(ch)
+			inWord = true
+		case ch == '|' && strings.HasPrefix(run[i:], "||"):
+			endCommand(true)
+			i++
+		case strings.IndexByte(";&|()\n", ch) >= 0:
+			endCommand(false)
+		default:
+			word.WriteByte(ch)
+			inWord = true
+		}
+	}
+	endCommand(false)
+	return commands
+}
+
+// closingParen returns the index of the ) closing the ( at open in s, or
+// len(s) if it isn't closed.
+func closingParen(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(s)
+}
+
+// leadingExecutable returns the executable a simple command runs, or "" if
+// it runs a builtin, a command that can't be known without running the
+// shell, or none.
+func leadingExecutable(words []string) string {
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+		switch {
+		case shellKeywords[w]:
+			return ""
+		case commandPrefixes[w], strings.HasPrefix(w, "-"), isShellAssignment(w):
+			continue
+		case isRedirection(w):
+			// A bare operator such as > or 2> is followed by its target
+			if strings.HasSuffix(w, ">") || strings.HasSuffix(w, "<") {
+				i++
+			}
+			continue
+		case shellBuiltins[w], w == "_", strings.ContainsAny(w, "$*?{}"):
+			return ""
+		default:
+			return w
+		}
+	}
+	return ""
+}
+
+// isShellAssignment reports whether w is a variable assignment such as
+// GOFLAGS=-mod=mod.
+func isShellAssignment(w string) bool {
+	name, _, ok := strings.Cut(w, "=")
+	if !ok || name == "" {
+		return false
+	}
+	for i, r := range name {
+		if r != '_' && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (i == 0 || r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// isRedirection reports whether w is a redirection such as >out, 2>&1 or <in.
+func isRedirection(w string) bool {
+	return strings.HasPrefix(strings.TrimLeft(w, "0123456789"), ">") ||
+		strings.HasPrefix(w, "<") || strings.HasPrefix(w, "&>")
+}
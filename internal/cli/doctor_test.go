@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCommandExecutables(t *testing.T) {
+	tests := []struct {
+		run  string
+		want []commandExecutable
+	}{
+		{"golangci-lint run ./...", []commandExecutable{{Name: "golangci-lint"}}},
+		{`test -z "$(gofmt -l .)"`, []commandExecutable{{Name: "gofmt"}}},
+		{"go test ./... -coverprofile=cover.out && go tool cover -func=cover.out", []commandExecutable{{Name: "go"}}},
+		{"pytest --cov 2>&1 | grep 'TOTAL'", []commandExecutable{{Name: "pytest"}, {Name: "grep"}}},
+		{`staticcheck ./... 2>/dev/null || echo "staticcheck not installed (optional)"`, []commandExecutable{{Name: "staticcheck", Optional: true}}},
+		{"CGO_ENABLED=0 env GOOS=linux go build > build.log", []commandExecutable{{Name: "go"}}},
+		{"vendor/bin/phpstan analyse; cd web && npx eslint .", []commandExecutable{{Name: "vendor/bin/phpstan"}, {Name: "npx"}}},
+		{"if [ -f Makefile ]; then make lint; fi", []commandExecutable{{Name: "make"}}},
+		{"for f in *.sh; do shellcheck \"$f\"; done", []commandExecutable{{Name: "shellcheck"}}},
+		{"# lint the scripts\n$LINTER . ; echo 'a; b | c'", nil},
+		{"echo `date` $((1 + 2))", []commandExecutable{{Name: "date"}}},
+	}
+	for _, tt := range tests {
+		if got := commandExecutables(tt.run); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("commandExecutables(%q) = %+v, want %+v", tt.run, got, tt.want)
+		}
+	}
+}
+
+// writeFakePath creates a directory holding an executable stub for each
+// name and makes it the whole PATH.
+func writeFakePath(t *testing.T, names ...string) {
+	t.Helper()
+	dir := t.TempDir()
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("PATH", dir)
+}
+
+func TestRunDoctor_MissingTools(t *testing.T) {
+	tmpDir := t.TempDir()
+	configContent := `version: "1"
+checks:
+  - id: vet
+    run: go vet ./...
+  - id: lint
+    run: golangci-lint run ./...
+  - id: staticcheck
+    run: staticcheck ./... || echo "staticcheck not installed (optional)"
+  - id: docker
+    run: hadolint Dockerfile
+    severity: warning
+  - id: script
+    run: ./scripts/check.sh
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(tmpDir, "scripts"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "scripts", "check.sh"), []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeFakePath(t, "sh", "go")
+
+	oldConfig, oldJSON := configFile, jsonOutput
+	defer func() { configFile, jsonOutput = oldConfig, oldJSON }()
+	configFile = configPath
+	jsonOutput = true
+
+	var buf bytes.Buffer
+	doctorCmd.SetOut(&buf)
+	defer doctorCmd.SetOut(nil)
+
+	err := runDoctor(doctorCmd, nil)
+	exitErr, ok := err.(*ExitError)
+	if !ok || exitErr.Code != 1 {
+		t.Fatalf("expected ExitError with code 1, got %v", err)
+	}
+
+	var doc doctorJSON
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	tools := make(map[string]doctorTool)
+	for _, tool := range doc.Tools {
+		tools[tool.Name] = tool
+	}
+
+	for _, name := range []string{"sh", "go", "./scripts/check.sh"} {
+		if !tools[name].Found || tools[name].Path == "" {
+			t.Errorf("expected %s to be found, got %+v", name, tools[name])
+		}
+	}
+	lint := tools["golangci-lint"]
+	if lint.Found || !lint.Required || !reflect.DeepEqual(lint.Checks, []string{"lint"}) || !strings.Contains(lint.Install, "golangci-lint.run") {
+		t.Errorf("expected golangci-lint missing and required with an install hint, got %+v", lint)
+	}
+	// A tool whose failure the command handles, or a warning check's tool,
+	// doesn't fail the run
+	for _, name := range []string{"staticcheck", "hadolint"} {
+		if tools[name].Found || tools[name].Required {
+			t.Errorf("expected %s missing and not required, got %+v", name, tools[name])
+		}
+	}
+	if doc.Missing != 3 || doc.MissingRequired != 1 {
+		t.Errorf("expected 3 missing, 1 required, got %d and %d", doc.Missing, doc.MissingRequired)
+	}
+	if !reflect.DeepEqual(tools["sh"].Checks, []string{"vet", "lint", "staticcheck", "docker", "script"}) {
+		t.Errorf("expected sh used by every check, got %v", tools["sh"].Checks)
+	}
+}
+
+func TestRunDoctor_AllInstalled(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte("version: \"1\"\nchecks:\n  - id: test\n    run: go test ./...\n  - id: lint\n    run: golangci-lint run\n    severity: warning\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	writeFakePath(t, "sh", "go", "golangci-lint")
+
+	oldConfig, oldJSON := configFile, jsonOutput
+	defer func() { configFile, jsonOutput = oldConfig, oldJSON }()
+	configFile = configPath
+	jsonOutput = false
+
+	var buf bytes.Buffer
+	doctorCmd.SetOut(&buf)
+	defer doctorCmd.SetOut(nil)
+
+	if err := runDoctor(doctorCmd, nil); err != nil {
+		t.Fatalf("runDoctor failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "All 3 tools used by 2 checks are installed") {
+		t.Errorf("unexpected output:\n%s", buf.String())
+	}
+}
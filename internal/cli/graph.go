@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vibeguard/vibeguard/internal/output"
+)
+
+// Dependency graph formats accepted by graph --format
+const (
+	graphFormatDOT     = "dot"
+	graphFormatMermaid = "mermaid"
+)
+
+var graphFormat string
+
+var graphCmd = &cobra.Command{
+	Use:   "graph",
+	Short: "Print the check dependency graph",
+	Long: `Print the dependency graph of the configured checks.
+
+Edges point from a required check to the checks that require it. Nodes are
+colored by severity; in DOT output, checks in the same execution level are
+drawn on the same rank.
+
+Examples:
+  vibeguard graph | dot -Tsvg > checks.svg
+  vibeguard graph --format mermaid`,
+	Args: cobra.NoArgs,
+	RunE: runGraph,
+}
+
+func init() {
+	rootCmd.AddCommand(graphCmd)
+	graphCmd.Flags().StringVar(&graphFormat, "format", graphFormatDOT, "Output format: dot, mermaid")
+}
+
+func runGraph(cmd *cobra.Command, args []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(graphFormat)) {
+	case graphFormatDOT:
+		return output.FormatDOT(cmd.OutOrStdout(), cfg.Checks)
+	case graphFormatMermaid:
+		return output.FormatMermaid(cmd.OutOrStdout(), cfg.Checks)
+	default:
+		return fmt.Errorf("unknown graph format %q (valid formats: dot, mermaid)", graphFormat)
+	}
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunGraph(t *testing.T) {
+	oldConfig, oldFormat := configFile, graphFormat
+	defer func() { configFile, graphFormat = oldConfig, oldFormat }()
+	configFile = writeListConfig(t)
+
+	var buf bytes.Buffer
+	rootCmd.SetOut(&buf)
+	defer rootCmd.SetOut(nil)
+
+	graphFormat = "dot"
+	if err := runGraph(graphCmd, nil); err != nil {
+		t.Fatalf("runGraph failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"fmt" -> "test";`) {
+		t.Errorf("expected DOT edge in output, got:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	graphFormat = "mermaid"
+	if err := runGraph(graphCmd, nil); err != nil {
+		t.Fatalf("runGraph failed: %v", err)
+	}
+	if !strings.HasPrefix(buf.String(), "flowchart TD\n") {
+		t.Errorf("expected Mermaid flowchart, got:\n%s", buf.String())
+	}
+
+	graphFormat = "png"
+	if err := runGraph(graphCmd, nil); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
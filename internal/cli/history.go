@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vibeguard/vibeguard/internal/history"
+)
+
+var (
+	historyQueryDB string
+	historyLimit   int
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history <check-id>",
+	Short: "Show recent results for a check",
+	Long: `Show recent results for a check recorded with 'vibeguard check --history-db'.
+
+Results are listed newest first, including pass/fail status, duration and
+any values extracted by grok patterns. With --config, only runs of that
+config file are shown; otherwise runs of every config are listed, labelled
+with their config file when there is more than one, as after 'check
+--recursive'.
+
+Examples:
+  vibeguard history coverage                     Show the last 20 runs of 'coverage'
+  vibeguard history coverage --limit 5           Show the last 5 runs
+  vibeguard history coverage --db ci-history.db  Read from a specific database
+  vibeguard history test -c api/vibeguard.yaml   Show runs of one config only
+  vibeguard history coverage --json              Output results as JSON`,
+	Args: cobra.ExactArgs(1),
+	RunE: runHistory,
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+	historyCmd.Flags().StringVar(&historyQueryDB, "db", "vibeguard.db", "Path to the SQLite history database")
+	historyCmd.Flags().IntVar(&historyLimit, "limit", 20, "Maximum number of runs to show (0 for all)")
+}
+
+func runHistory(cmd *cobra.Command, args []string) error {
+	store, err := history.Open(historyQueryDB)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = store.Close() }()
+
+	// Runs record the absolute path of their config file
+	var configPath string
+	if configFile != "" {
+		configPath, err = filepath.Abs(configFile)
+		if err != nil {
+			return fmt.Errorf("failed to resolve config path: %w", err)
+		}
+	}
+
+	entries, err := store.Query(args[0], configPath, historyLimit)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if jsonOutput {
+		if entries == nil {
+			entries = []history.Entry{}
+		}
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(entries)
+	}
+
+	if len(entries) == 0 {
+		_, _ = fmt.Fprintf(out, "No history for check %q\n", args[0])
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(out, "History for %s (%d runs):\n\n", args[0], len(entries))
+	labelConfigs := slices.ContainsFunc(entries, func(e history.Entry) bool {
+		return e.ConfigPath != entries[0].ConfigPath
+	})
+	for _, e := range entries {
+		_, _ = fmt.Fprintf(out, "  #%-5d %s  %-9s %8s",
+			e.RunID,
+			e.Timestamp.Local().Format("2006-01-02 15:04:05"),
+			e.Status,
+			(time.Duration(e.DurationMS) * time.Millisecond).String(),
+		)
+		if labelConfigs {
+			_, _ = fmt.Fprintf(out, "  [%s]", displayConfigPath(e.ConfigPath))
+		}
+		if vars := formatExtracted(e.Extracted); vars != "" {
+			_, _ = fmt.Fprintf(out, "  %s", vars)
+		}
+		_, _ = fmt.Fprintln(out)
+	}
+
+	return nil
+}
+
+// displayConfigPath returns path relative to the working directory when it is
+// below it, or path unchanged. Runs recorded before config paths were stored
+// have none.
+func displayConfigPath(path string) string {
+	if path == "" {
+		return "unknown config"
+	}
+	if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, path); err == nil && filepath.IsLocal(rel) {
+			return rel
+		}
+	}
+	return path
+}
+
+// formatExtracted renders extracted values as sorted key=value pairs.
+func formatExtracted(extracted map[string]string) string {
+	keys := make([]string, 0, len(extracted))
+	for k := range extracted {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+extracted[k])
+	}
+	return strings.Join(pairs, " ")
+}
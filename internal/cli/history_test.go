@@ -0,0 +1,241 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/history"
+)
+
+func TestRunCheck_HistoryDBAndHistoryCommand(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `version: "1"
+checks:
+  - id: coverage
+    run: 'echo "coverage: 81.5%"'
+    grok:
+      - "coverage: %{NUMBER:coverage}%"
+    severity: error
+    timeout: 10s
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	dbPath := filepath.Join(tmpDir, "history.db")
+
+	oldConfig := configFile
+	oldJSON := jsonOutput
+	oldHistoryDB := historyDB
+	oldQueryDB := historyQueryDB
+	oldLimit := historyLimit
+	defer func() {
+		configFile = oldConfig
+		jsonOutput = oldJSON
+		historyDB = oldHistoryDB
+		historyQueryDB = oldQueryDB
+		historyLimit = oldLimit
+	}()
+
+	configFile = configPath
+	jsonOutput = false
+	historyDB = dbPath
+
+	for i := 0; i < 2; i++ {
+		if err := runCheck(checkCmd, []string{}); err != nil {
+			t.Fatalf("runCheck %d failed: %v", i, err)
+		}
+	}
+
+	// Text output
+	historyQueryDB = dbPath
+	historyLimit = 20
+	var buf bytes.Buffer
+	historyCmd.SetOut(&buf)
+	if err := runHistory(historyCmd, []string{"coverage"}); err != nil {
+		t.Fatalf("runHistory failed: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "History for coverage (2 runs)") {
+		t.Errorf("expected run count header, got:\n%s", out)
+	}
+	if strings.Count(out, "coverage=81.5") != 2 {
+		t.Errorf("expected extracted coverage on both rows, got:\n%s", out)
+	}
+
+	// JSON output
+	jsonOutput = true
+	buf.Reset()
+	if err := runHistory(historyCmd, []string{"coverage"}); err != nil {
+		t.Fatalf("runHistory (json) failed: %v", err)
+	}
+	var entries []history.Entry
+	if err := json.Unmarshal(buf.Bytes(), &entries); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, buf.String())
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].RunID <= entries[1].RunID {
+		t.Errorf("expected newest run first, got run IDs %d, %d", entries[0].RunID, entries[1].RunID)
+	}
+	for _, e := range entries {
+		if !e.Passed || e.Status != "passed" {
+			t.Errorf("expected passing entry, got %+v", e)
+		}
+	}
+}
+
+func TestRunCheck_HistoryDBErrorAfterReport(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `version: "1"
+checks:
+  - id: echo
+    run: echo ok
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	reportPath := filepath.Join(tmpDir, "report.json")
+
+	oldConfig := configFile
+	oldJSON := jsonOutput
+	oldHistoryDB := historyDB
+	oldOutputFile := outputFile
+	oldStderr := os.Stderr
+	defer func() {
+		configFile = oldConfig
+		jsonOutput = oldJSON
+		historyDB = oldHistoryDB
+		outputFile = oldOutputFile
+		os.Stderr = oldStderr
+	}()
+	devNull, err := os.Open(os.DevNull)
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", os.DevNull, err)
+	}
+	defer devNull.Close()
+	os.Stderr = devNull
+
+	configFile = configPath
+	jsonOutput = true
+	outputFile = reportPath
+	// A directory that does not exist cannot hold the database
+	historyDB = filepath.Join(tmpDir, "missing", "history.db")
+
+	if err := runCheck(checkCmd, []string{}); err == nil {
+		t.Fatal("expected history database error")
+	}
+	if _, err := os.Stat(reportPath); err != nil {
+		t.Errorf("expected report to be written before the history error: %v", err)
+	}
+}
+
+func TestRunHistory_NoEntries(t *testing.T) {
+	oldQueryDB := historyQueryDB
+	oldJSON := jsonOutput
+	defer func() {
+		historyQueryDB = oldQueryDB
+		jsonOutput = oldJSON
+	}()
+
+	historyQueryDB = filepath.Join(t.TempDir(), "empty.db")
+	jsonOutput = false
+
+	var buf bytes.Buffer
+	historyCmd.SetOut(&buf)
+	if err := runHistory(historyCmd, []string{"missing"}); err != nil {
+		t.Fatalf("runHistory failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), `No history for check "missing"`) {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func TestRunHistory_ConfigFilter(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "history.db")
+
+	// Two configs sharing a check ID, as with check --recursive
+	var configPaths []string
+	for _, name := range []string{"api", "web"} {
+		dir := filepath.Join(tmpDir, name)
+		if err := os.Mkdir(dir, 0755); err != nil {
+			t.Fatalf("failed to create %s: %v", dir, err)
+		}
+		configPath := filepath.Join(dir, "vibeguard.yaml")
+		content := "version: \"1\"\nchecks:\n  - id: test\n    run: echo " + name + "\n"
+		if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+		configPaths = append(configPaths, configPath)
+	}
+
+	oldConfig := configFile
+	oldJSON := jsonOutput
+	oldHistoryDB := historyDB
+	oldQueryDB := historyQueryDB
+	oldLimit := historyLimit
+	oldStderr := os.Stderr
+	defer func() {
+		configFile = oldConfig
+		jsonOutput = oldJSON
+		historyDB = oldHistoryDB
+		historyQueryDB = oldQueryDB
+		historyLimit = oldLimit
+		os.Stderr = oldStderr
+		historyCmd.SetOut(nil)
+	}()
+	devNull, err := os.Open(os.DevNull)
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", os.DevNull, err)
+	}
+	defer devNull.Close()
+	os.Stderr = devNull
+
+	jsonOutput = false
+	historyDB = dbPath
+	for _, path := range configPaths {
+		configFile = path
+		if err := runCheck(checkCmd, []string{}); err != nil {
+			t.Fatalf("runCheck %s failed: %v", path, err)
+		}
+	}
+
+	historyQueryDB = dbPath
+	historyLimit = 0
+	var buf bytes.Buffer
+	historyCmd.SetOut(&buf)
+
+	// Without --config, runs of both configs are listed and labelled
+	configFile = ""
+	if err := runHistory(historyCmd, []string{"test"}); err != nil {
+		t.Fatalf("runHistory failed: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "(2 runs)") || !strings.Contains(out, "api") || !strings.Contains(out, "web") {
+		t.Errorf("expected labelled runs of both configs, got:\n%s", out)
+	}
+
+	// With --config, only that config's runs
+	configFile = configPaths[0]
+	jsonOutput = true
+	buf.Reset()
+	if err := runHistory(historyCmd, []string{"test"}); err != nil {
+		t.Fatalf("runHistory failed: %v", err)
+	}
+	var entries []history.Entry
+	if err := json.Unmarshal(buf.Bytes(), &entries); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, buf.String())
+	}
+	if len(entries) != 1 || entries[0].ConfigPath != configPaths[0] {
+		t.Errorf("expected only the api config's run, got %+v", entries)
+	}
+}
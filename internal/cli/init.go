@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/vibeguard/vibeguard/internal/cli/assist"
+	"github.com/vibeguard/vibeguard/internal/cli/inspector"
 	"github.com/vibeguard/vibeguard/internal/cli/templates"
 	"github.com/vibeguard/vibeguard/internal/config"
 )
@@ -19,6 +20,9 @@ var (
 	initAssist        bool
 	initOutput        string
 	initListTemplates bool
+	initDetect        bool
+	initDryRun        bool
+	initMinConfidence float64
 )
 
 var initCmd = &cobra.Command{
@@ -30,6 +34,12 @@ Use --template to select a predefined template:
   vibeguard init --template go-standard   Use the Go standard template
   vibeguard init --list-templates         List available templates
 
+Use --detect to generate checks from the tools found in the project:
+  vibeguard init --detect                 Detect the project type and tools
+  vibeguard init --detect --dry-run       Print the generated config without writing it
+  vibeguard init --detect --min-confidence 0.75
+                                          Ignore tools detected with low confidence
+
 Use --assist for AI agent-assisted setup:
   vibeguard init --assist                 Generate a setup prompt for AI agents
   vibeguard init --assist --output p.md   Save the prompt to a file
@@ -47,6 +57,9 @@ func init() {
 	initCmd.Flags().BoolVar(&initListTemplates, "list-templates", false, "List available templates")
 	initCmd.Flags().BoolVar(&initAssist, "assist", false, "Generate an AI agent-assisted setup prompt")
 	initCmd.Flags().StringVarP(&initOutput, "output", "o", "", "Output file for --assist mode (default: stdout)")
+	initCmd.Flags().BoolVar(&initDetect, "detect", false, "Generate checks from the detected project type and tools")
+	initCmd.Flags().BoolVar(&initDryRun, "dry-run", false, "Print the config to stdout instead of writing it")
+	initCmd.Flags().Float64Var(&initMinConfidence, "min-confidence", 0, "Ignore tools detected with lower confidence (0-1, requires --detect)")
 	rootCmd.AddCommand(initCmd)
 }
 
@@ -107,7 +120,25 @@ func runInit(cmd *cobra.Command, args []string) error {
 	var content string
 	var templateName string
 
-	if initTemplate != "" {
+	if initDetect && initTemplate != "" {
+		return fmt.Errorf("--detect cannot be combined with --template")
+	}
+	if initMinConfidence != 0 && !initDetect {
+		return fmt.Errorf("--min-confidence requires --detect")
+	}
+	if initMinConfidence < 0 || initMinConfidence > 1 {
+		return fmt.Errorf("--min-confidence must be between 0 and 1, got %g", initMinConfidence)
+	}
+
+	if initDetect {
+		// Build the config from inspector recommendations
+		generated, err := inspector.GenerateConfig(".", inspector.ScanOptions{MinConfidence: initMinConfidence})
+		if err != nil {
+			return err
+		}
+		content = generated.YAML()
+		templateName = fmt.Sprintf("detected %s project", generated.ProjectType)
+	} else if initTemplate != "" {
 		// Use specified template
 		tmpl, err := templates.Get(initTemplate)
 		if err != nil {
@@ -121,6 +152,12 @@ func runInit(cmd *cobra.Command, args []string) error {
 		templateName = "default (Go)"
 	}
 
+	// Print instead of writing; nothing on disk changes, so existing files are fine
+	if initDryRun {
+		fmt.Print(content)
+		return nil
+	}
+
 	configPath := "vibeguard.yaml"
 
 	// Check if any config file already exists
@@ -17,7 +17,7 @@ Help the user set up VibeGuard for their project by:
 
 ## Key Concepts
 - **Checks**: Individual validation rules that run commands and verify output/exit codes
-- **Severity**: "error" blocks commits, "warning" shows feedback but allows commits
+- **Severity**: "error" blocks commits, "warning" shows feedback but allows commits, "info" is advisory only
 - **Tags**: Label checks for selective execution
 - **Assertions**: Optional validation of extracted values using expressions
 - **Dependencies**: Checks can depend on other checks completing first
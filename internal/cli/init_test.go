@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
 )
 
 func TestRunAssist_Success(t *testing.T) {
@@ -535,3 +537,187 @@ func TestRunAssist_NotADirectory(t *testing.T) {
 		t.Errorf("expected error message about not being a directory, got: %s", exitErr.Message)
 	}
 }
+
+// setupDetectInit changes into a temp Go project and resets the init flags
+// for --detect, restoring everything when the test ends.
+func setupDetectInit(t *testing.T) string {
+	t.Helper()
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"go.mod":       "module example.com/demo\n\ngo 1.22\n",
+		"main.go":      "package main\n\nfunc main() {}\n",
+		"main_test.go": "package main\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	oldWd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+	if err := os.Chdir(tmpDir); err != nil {
+		t.Fatalf("failed to change to temp dir: %v", err)
+	}
+
+	oldForce, oldTemplate, oldAssist := initForce, initTemplate, initAssist
+	oldDetect, oldDryRun, oldStdout := initDetect, initDryRun, os.Stdout
+	oldMinConfidence := initMinConfidence
+	t.Cleanup(func() {
+		_ = os.Chdir(oldWd)
+		initForce, initTemplate, initAssist = oldForce, oldTemplate, oldAssist
+		initDetect, initDryRun, os.Stdout = oldDetect, oldDryRun, oldStdout
+		initMinConfidence = oldMinConfidence
+	})
+
+	initForce = false
+	initTemplate = ""
+	initAssist = false
+	initDetect = true
+	initDryRun = false
+	initMinConfidence = 0
+
+	// Keep the "Created ..." message out of test output
+	devNull, err := os.Open(os.DevNull)
+	if err == nil {
+		t.Cleanup(func() { _ = devNull.Close() })
+		os.Stdout = devNull
+	}
+	return tmpDir
+}
+
+func TestRunInit_Detect(t *testing.T) {
+	tmpDir := setupDetectInit(t)
+
+	if err := runInit(initCmd, []string{}); err != nil {
+		t.Fatalf("runInit --detect failed: %v", err)
+	}
+
+	cfg, err := config.Load(filepath.Join(tmpDir, "vibeguard.yaml"))
+	if err != nil {
+		t.Fatalf("generated config does not load: %v", err)
+	}
+	if cfg.Vars["packages"] != "./..." {
+		t.Errorf("expected packages var, got %v", cfg.Vars)
+	}
+	if len(cfg.Checks) == 0 {
+		t.Error("expected generated checks")
+	}
+}
+
+func TestRunInit_DetectDryRun(t *testing.T) {
+	tmpDir := setupDetectInit(t)
+	initDryRun = true
+
+	stdout, err := os.Create(filepath.Join(t.TempDir(), "stdout"))
+	if err != nil {
+		t.Fatalf("failed to create stdout capture: %v", err)
+	}
+	defer func() { _ = stdout.Close() }()
+	os.Stdout = stdout
+
+	// An existing config is not a problem because nothing is written
+	existing := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(existing, []byte("existing"), 0644); err != nil {
+		t.Fatalf("failed to create existing config: %v", err)
+	}
+
+	if err := runInit(initCmd, []string{}); err != nil {
+		t.Fatalf("runInit --detect --dry-run failed: %v", err)
+	}
+
+	printed, err := os.ReadFile(stdout.Name())
+	if err != nil {
+		t.Fatalf("failed to read captured stdout: %v", err)
+	}
+	if !strings.Contains(string(printed), "checks:") || !strings.Contains(string(printed), "{{.packages}}") {
+		t.Errorf("expected generated config on stdout, got:\n%s", printed)
+	}
+	content, _ := os.ReadFile(existing)
+	if string(content) != "existing" {
+		t.Error("--dry-run must not modify the existing config")
+	}
+}
+
+func TestRunInit_DetectRefusesOverwrite(t *testing.T) {
+	tmpDir := setupDetectInit(t)
+	existing := filepath.Join(tmpDir, ".vibeguard.yml")
+	if err := os.WriteFile(existing, []byte("existing"), 0644); err != nil {
+		t.Fatalf("failed to create existing config: %v", err)
+	}
+
+	err := runInit(initCmd, []string{})
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected 'already exists' error, got %v", err)
+	}
+
+	initForce = true
+	if err := runInit(initCmd, []string{}); err != nil {
+		t.Fatalf("runInit --detect --force failed: %v", err)
+	}
+	if _, err := config.Load(filepath.Join(tmpDir, "vibeguard.yaml")); err != nil {
+		t.Errorf("generated config does not load: %v", err)
+	}
+}
+
+func TestRunInit_DetectWithTemplate(t *testing.T) {
+	setupDetectInit(t)
+	initTemplate = "go-standard"
+
+	err := runInit(initCmd, []string{})
+	if err == nil || !strings.Contains(err.Error(), "--detect cannot be combined with --template") {
+		t.Errorf("expected conflict error, got %v", err)
+	}
+}
+
+func TestRunInit_DetectMinConfidence(t *testing.T) {
+	tmpDir := setupDetectInit(t)
+	files := map[string]string{
+		".golangci.yml": "linters:\n  enable:\n    - errcheck\n",
+		"Makefile":      "fmt:\n\tgoimports -w .\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	initMinConfidence = 0.75
+
+	if err := runInit(initCmd, []string{}); err != nil {
+		t.Fatalf("runInit --detect --min-confidence failed: %v", err)
+	}
+
+	cfg, err := config.Load(filepath.Join(tmpDir, "vibeguard.yaml"))
+	if err != nil {
+		t.Fatalf("generated config does not load: %v", err)
+	}
+	ids := make(map[string]bool)
+	for _, check := range cfg.Checks {
+		ids[check.ID] = true
+	}
+	if ids["imports"] {
+		t.Error("goimports check should be skipped below the confidence threshold")
+	}
+	if !ids["lint"] {
+		t.Error("golangci-lint check should be kept above the confidence threshold")
+	}
+}
+
+func TestRunInit_MinConfidenceValidation(t *testing.T) {
+	setupDetectInit(t)
+
+	initMinConfidence = 1.5
+	err := runInit(initCmd, []string{})
+	if err == nil || !strings.Contains(err.Error(), "must be between 0 and 1") {
+		t.Errorf("expected range error, got %v", err)
+	}
+
+	initDetect = false
+	initMinConfidence = 0.5
+	err = runInit(initCmd, []string{})
+	if err == nil || !strings.Contains(err.Error(), "--min-confidence requires --detect") {
+		t.Errorf("expected --detect requirement error, got %v", err)
+	}
+}
@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vibeguard/vibeguard/internal/cli/inspector"
+)
+
+var inspectMinConfidence float64
+
+var inspectCmd = &cobra.Command{
+	Use:   "inspect [path]",
+	Short: "Show what vibeguard detects about a project",
+	Long: `Inspect a project and print what vibeguard detects about it: the project
+type with its confidence, the development tools found with their category,
+config file and confidence, and the project's structure.
+
+This is the data 'vibeguard init --detect' builds its configuration from.
+The path defaults to the current directory.
+
+Examples:
+  vibeguard inspect                      Inspect the current directory
+  vibeguard inspect ../service           Inspect another project
+  vibeguard inspect --json               Print the report as JSON
+  vibeguard inspect --min-confidence 0.8 Show only tools detected with confidence >= 0.8`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runInspect,
+}
+
+func init() {
+	rootCmd.AddCommand(inspectCmd)
+	inspectCmd.Flags().Float64Var(&inspectMinConfidence, "min-confidence", 0, "Ignore tools detected with lower confidence (0-1)")
+}
+
+func runInspect(cmd *cobra.Command, args []string) error {
+	if inspectMinConfidence < 0 || inspectMinConfidence > 1 {
+		return fmt.Errorf("--min-confidence must be between 0 and 1, got %g", inspectMinConfidence)
+	}
+	root := "."
+	if len(args) > 0 {
+		root = args[0]
+	}
+
+	inspection, err := inspector.Inspect(root, inspector.ScanOptions{MinConfidence: inspectMinConfidence})
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if jsonOutput {
+		return writeInspectJSON(out, inspection)
+	}
+	writeInspectText(out, inspection)
+	return nil
+}
+
+// writeInspectText writes the inspection as a human-readable report.
+func writeInspectText(out io.Writer, inspection *inspector.Inspection) {
+	project, metadata, structure := inspection.Project, inspection.Metadata, inspection.Structure
+
+	_, _ = fmt.Fprintf(out, "Project: %s (confidence %.2f)\n", project.Type, project.Confidence)
+	if metadata.Name != "" {
+		_, _ = fmt.Fprintf(out, "  Name:       %s\n", metadata.Name)
+	}
+	if metadata.Version != "" {
+		_, _ = fmt.Fprintf(out, "  Version:    %s\n", metadata.Version)
+	}
+	if len(project.Indicators) > 0 {
+		_, _ = fmt.Fprintf(out, "  Indicators: %s\n", strings.Join(project.Indicators, ", "))
+	}
+
+	_, _ = fmt.Fprintf(out, "\nTools (%d):\n", len(inspection.Tools))
+	if len(inspection.Tools) > 0 {
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		for _, tool := range inspection.Tools {
+			configFile := tool.ConfigFile
+			if configFile == "" {
+				configFile = "-"
+			}
+			_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\t%.2f\n", tool.Name, tool.Category, configFile, tool.Confidence)
+		}
+		_ = w.Flush()
+	}
+
+	_, _ = fmt.Fprintln(out, "\nStructure:")
+	for _, field := range []struct {
+		label  string
+		values []string
+	}{
+		{"Entry points", structure.EntryPoints},
+		{"Source dirs", structure.SourceDirs},
+		{"Test dirs", structure.TestDirs},
+		{"Config files", structure.ConfigFiles},
+		{"Workspace", structure.WorkspaceModules},
+	} {
+		if len(field.values) > 0 {
+			_, _ = fmt.Fprintf(out, "  %-13s %s\n", field.label+":", strings.Join(field.values, ", "))
+		}
+	}
+	if structure.BuildOutputDir != "" {
+		_, _ = fmt.Fprintf(out, "  %-13s %s\n", "Build output:", structure.BuildOutputDir)
+	}
+	if structure.HasMonorepo {
+		_, _ = fmt.Fprintf(out, "  %-13s yes\n", "Monorepo:")
+	}
+}
+
+// inspectJSON is the JSON document written by `vibeguard inspect --json`.
+type inspectJSON struct {
+	Project   inspectProjectJSON   `json:"project"`
+	Tools     []inspectToolJSON    `json:"tools"`
+	Structure inspectStructureJSON `json:"structure"`
+}
+
+// inspectProjectJSON describes the detected project type and its metadata.
+type inspectProjectJSON struct {
+	Type        string            `json:"type"`
+	Confidence  float64           `json:"confidence"`
+	Indicators  []string          `json:"indicators"`
+	Name        string            `json:"name,omitempty"`
+	Version     string            `json:"version,omitempty"`
+	Description string            `json:"description,omitempty"`
+	License     string            `json:"license,omitempty"`
+	Repository  string            `json:"repository,omitempty"`
+	Extra       map[string]string `json:"extra,omitempty"`
+}
+
+// inspectToolJSON describes a detected tool.
+type inspectToolJSON struct {
+	Name       string   `json:"name"`
+	Category   string   `json:"category"`
+	ConfigFile string   `json:"config_file,omitempty"`
+	Version    string   `json:"version,omitempty"`
+	Confidence float64  `json:"confidence"`
+	Indicators []string `json:"indicators"`
+}
+
+// inspectStructureJSON describes the project layout.
+type inspectStructureJSON struct {
+	EntryPoints      []string `json:"entry_points"`
+	SourceDirs       []string `json:"source_dirs"`
+	TestDirs         []string `json:"test_dirs"`
+	ConfigFiles      []string `json:"config_files"`
+	WorkspaceModules []string `json:"workspace_modules"`
+	BuildOutputDir   string   `json:"build_output_dir,omitempty"`
+	Monorepo         bool     `json:"monorepo"`
+}
+
+// writeInspectJSON writes the inspection as JSON.
+func writeInspectJSON(out io.Writer, inspection *inspector.Inspection) error {
+	project, metadata, structure := inspection.Project, inspection.Metadata, inspection.Structure
+	doc := inspectJSON{
+		Project: inspectProjectJSON{
+			Type:        string(project.Type),
+			Confidence:  project.Confidence,
+			Indicators:  nonNil(project.Indicators),
+			Name:        metadata.Name,
+			Version:     metadata.Version,
+			Description: metadata.Description,
+			License:     metadata.License,
+			Repository:  metadata.Repository,
+			Extra:       metadata.Extra,
+		},
+		Tools: make([]inspectToolJSON, 0, len(inspection.Tools)),
+		Structure: inspectStructureJSON{
+			EntryPoints:      nonNil(structure.EntryPoints),
+			SourceDirs:       nonNil(structure.SourceDirs),
+			TestDirs:         nonNil(structure.TestDirs),
+			ConfigFiles:      nonNil(structure.ConfigFiles),
+			WorkspaceModules: nonNil(structure.WorkspaceModules),
+			BuildOutputDir:   structure.BuildOutputDir,
+			Monorepo:         structure.HasMonorepo,
+		},
+	}
+	for _, tool := range inspection.Tools {
+		doc.Tools = append(doc.Tools, inspectToolJSON{
+			Name:       tool.Name,
+			Category:   string(tool.Category),
+			ConfigFile: tool.ConfigFile,
+			Version:    tool.Version,
+			Confidence: tool.Confidence,
+			Indicators: nonNil(tool.Indicators),
+		})
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(doc)
+}
+
+// nonNil returns s, or an empty slice if s is nil, so it encodes as [].
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeInspectFixture creates a Go project with golangci-lint and GitHub
+// Actions configured.
+func writeInspectFixture(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":                   "module github.com/example/app\n\ngo 1.22\n",
+		"main.go":                  "package main\n\nfunc main() {}\n",
+		".golangci.yml":            "linters:\n  enable:\n    - govet\n",
+		".github/workflows/ci.yml": "on: push\njobs:\n  test:\n    runs-on: ubuntu-latest\n    steps:\n      - run: go test ./...\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestRunInspect_JSON(t *testing.T) {
+	dir := writeInspectFixture(t)
+
+	oldJSON := jsonOutput
+	defer func() { jsonOutput = oldJSON }()
+	jsonOutput = true
+
+	var buf bytes.Buffer
+	inspectCmd.SetOut(&buf)
+	defer inspectCmd.SetOut(nil)
+
+	if err := runInspect(inspectCmd, []string{dir}); err != nil {
+		t.Fatalf("runInspect failed: %v", err)
+	}
+
+	var doc inspectJSON
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if doc.Project.Type != "go" || doc.Project.Confidence <= 0 {
+		t.Errorf("expected a go project with confidence, got %+v", doc.Project)
+	}
+	if doc.Project.Name != "github.com/example/app" {
+		t.Errorf("expected module name, got %q", doc.Project.Name)
+	}
+
+	tools := make(map[string]inspectToolJSON)
+	for _, tool := range doc.Tools {
+		tools[tool.Name] = tool
+	}
+	if lint, ok := tools["golangci-lint"]; !ok || lint.Category != "linter" || lint.ConfigFile != ".golangci.yml" {
+		t.Errorf("expected golangci-lint linter with .golangci.yml, got %+v (found: %v)", lint, ok)
+	}
+	if ci, ok := tools["GitHub Actions"]; !ok || ci.Category != "ci" {
+		t.Errorf("expected GitHub Actions ci tool, got %+v (found: %v)", ci, ok)
+	}
+	if !strings.Contains(strings.Join(doc.Structure.ConfigFiles, " "), "go.mod") {
+		t.Errorf("expected go.mod among config files, got %v", doc.Structure.ConfigFiles)
+	}
+}
+
+func TestRunInspect_Text(t *testing.T) {
+	dir := writeInspectFixture(t)
+
+	oldJSON := jsonOutput
+	defer func() { jsonOutput = oldJSON }()
+	jsonOutput = false
+
+	var buf bytes.Buffer
+	inspectCmd.SetOut(&buf)
+	defer inspectCmd.SetOut(nil)
+
+	if err := runInspect(inspectCmd, []string{dir}); err != nil {
+		t.Fatalf("runInspect failed: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{"Project: go (confidence", "golangci-lint", "GitHub Actions", "Structure:", "Config files:"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
@@ -3,8 +3,10 @@
 package inspector
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 // ProjectType represents the detected programming language/framework of a project.
@@ -18,9 +20,46 @@ const (
 	Ruby    ProjectType = "ruby"
 	Rust    ProjectType = "rust"
 	Java    ProjectType = "java"
+	DotNet  ProjectType = "dotnet" // C# and other .NET languages
+	PHP     ProjectType = "php"
+	Swift   ProjectType = "swift"
+	Kotlin  ProjectType = "kotlin"
 	Unknown ProjectType = "unknown"
 )
 
+// sourceExtensions maps source file extensions to the language they count
+// towards when weighting detection by file counts.
+var sourceExtensions = map[string]ProjectType{
+	".go":    Go,
+	".js":    Node,
+	".jsx":   Node,
+	".mjs":   Node,
+	".cjs":   Node,
+	".ts":    Node,
+	".tsx":   Node,
+	".py":    Python,
+	".rb":    Ruby,
+	".rs":    Rust,
+	".java":  Java,
+	".cs":    DotNet,
+	".php":   PHP,
+	".swift": Swift,
+	".kt":    Kotlin,
+}
+
+// Bounds of the source file count walk, so large repositories are
+// classified from a sample instead of a full scan. Below minCountSample
+// source files the shares are too noisy to weight detection by.
+const (
+	maxCountDepth  = 6
+	maxCountFiles  = 5000
+	minCountSample = 10
+)
+
+// fileShareWeight is the confidence a language gains when all counted
+// source files are written in it, scaled down by its share of the files.
+const fileShareWeight = 0.6
+
 // DetectionResult holds the result of project type detection.
 type DetectionResult struct {
 	Type       ProjectType // Detected project type
@@ -51,14 +90,23 @@ func (d *Detector) Detect() ([]DetectionResult, error) {
 		d.detectRuby,
 		d.detectRust,
 		d.detectJava,
+		d.detectDotNet,
+		d.detectPHP,
+		d.detectSwift,
+		d.detectKotlin,
 	}
 
+	counts, total := d.countSourceFiles()
 	for _, detect := range detectors {
 		result, err := detect()
 		if err != nil {
 			return nil, err
 		}
-		if result != nil && result.Confidence > 0 {
+		if result == nil {
+			continue
+		}
+		weightByFileShare(result, counts[result.Type], total)
+		if result.Confidence > 0 {
 			results = append(results, *result)
 		}
 	}
@@ -344,6 +392,254 @@ func (d *Detector) detectJava() (*DetectionResult, error) {
 	return result, nil
 }
 
+// detectDotNet checks for .NET (C#) project indicators.
+func (d *Detector) detectDotNet() (*DetectionResult, error) {
+	result := &DetectionResult{
+		Type:       DotNet,
+		Confidence: 0,
+		Indicators: []string{},
+	}
+
+	// Check for project files (strongest indicator - 0.6)
+	// Use depth 3 since solutions usually keep projects in src/<Project>/
+	projects, err := d.findFiles("*.csproj", 3)
+	if err != nil {
+		return nil, err
+	}
+	if len(projects) > 0 {
+		result.Confidence += 0.6
+		result.Indicators = append(result.Indicators, "*.csproj files")
+	}
+
+	// Check for a solution file (0.3)
+	solutions, err := d.findFiles("*.sln", 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(solutions) > 0 {
+		result.Confidence += 0.3
+		result.Indicators = append(result.Indicators, "*.sln")
+	}
+
+	// Check for global.json, which pins the SDK version (0.2)
+	if d.fileExists("global.json") {
+		result.Confidence += 0.2
+		result.Indicators = append(result.Indicators, "global.json")
+	}
+
+	// Check for .cs files (0.2 if any found)
+	csFiles, err := d.findFiles("*.cs", 3)
+	if err != nil {
+		return nil, err
+	}
+	if len(csFiles) > 0 {
+		result.Confidence += 0.2
+		result.Indicators = append(result.Indicators, "*.cs files")
+	}
+
+	// Cap confidence at 1.0
+	if result.Confidence > 1.0 {
+		result.Confidence = 1.0
+	}
+
+	return result, nil
+}
+
+// detectPHP checks for PHP project indicators.
+func (d *Detector) detectPHP() (*DetectionResult, error) {
+	result := &DetectionResult{
+		Type:       PHP,
+		Confidence: 0,
+		Indicators: []string{},
+	}
+
+	// Check for composer.json (strongest indicator - 0.6)
+	if d.fileExists("composer.json") {
+		result.Confidence += 0.6
+		result.Indicators = append(result.Indicators, "composer.json")
+	}
+
+	// Check for composer.lock (0.2)
+	if d.fileExists("composer.lock") {
+		result.Confidence += 0.2
+		result.Indicators = append(result.Indicators, "composer.lock")
+	}
+
+	// Check for .php files (0.2 if any found)
+	phpFiles, err := d.findFiles("*.php", 3)
+	if err != nil {
+		return nil, err
+	}
+	if len(phpFiles) > 0 {
+		result.Confidence += 0.2
+		result.Indicators = append(result.Indicators, "*.php files")
+	}
+
+	// Cap confidence at 1.0
+	if result.Confidence > 1.0 {
+		result.Confidence = 1.0
+	}
+
+	return result, nil
+}
+
+// detectSwift checks for Swift Package Manager project indicators.
+func (d *Detector) detectSwift() (*DetectionResult, error) {
+	result := &DetectionResult{
+		Type:       Swift,
+		Confidence: 0,
+		Indicators: []string{},
+	}
+
+	// Check for Package.swift (strongest indicator - 0.7)
+	if d.fileExists("Package.swift") {
+		result.Confidence += 0.7
+		result.Indicators = append(result.Indicators, "Package.swift")
+	}
+
+	// Check for Package.resolved (0.2)
+	if d.fileExists("Package.resolved") {
+		result.Confidence += 0.2
+		result.Indicators = append(result.Indicators, "Package.resolved")
+	}
+
+	// Check for .swift files other than the manifest (0.1 if any found)
+	// Use depth 3 since SwiftPM keeps targets in Sources/<Target>/
+	swiftFiles, err := d.findFiles("*.swift", 3)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range swiftFiles {
+		if filepath.Base(file) != "Package.swift" {
+			result.Confidence += 0.1
+			result.Indicators = append(result.Indicators, "*.swift files")
+			break
+		}
+	}
+
+	// Cap confidence at 1.0
+	if result.Confidence > 1.0 {
+		result.Confidence = 1.0
+	}
+
+	return result, nil
+}
+
+// detectKotlin checks for Kotlin (Gradle) project indicators. A
+// build.gradle.kts alone also counts towards Java, so the Kotlin Gradle
+// plugin and .kt sources are what make Kotlin outrank it.
+func (d *Detector) detectKotlin() (*DetectionResult, error) {
+	result := &DetectionResult{
+		Type:       Kotlin,
+		Confidence: 0,
+		Indicators: []string{},
+	}
+
+	// Check for a Kotlin DSL build script (0.4)
+	if d.fileExists("build.gradle.kts") {
+		result.Confidence += 0.4
+		result.Indicators = append(result.Indicators, "build.gradle.kts")
+	}
+
+	// Check for the Kotlin Gradle plugin (0.4)
+	for _, name := range []string{"build.gradle.kts", "build.gradle"} {
+		if d.fileContains(name, "kotlin(") || d.fileContains(name, "org.jetbrains.kotlin") {
+			result.Confidence += 0.4
+			result.Indicators = append(result.Indicators, "Kotlin plugin in "+name)
+			break
+		}
+	}
+
+	// Check for .kt files (0.3 if any found)
+	// Use depth 7 since the standard Gradle layout is src/main/kotlin/ followed
+	// by the package directories
+	ktFiles, err := d.findFiles("*.kt", 7)
+	if err != nil {
+		return nil, err
+	}
+	if len(ktFiles) > 0 {
+		result.Confidence += 0.3
+		result.Indicators = append(result.Indicators, "*.kt files")
+	}
+
+	// Cap confidence at 1.0
+	if result.Confidence > 1.0 {
+		result.Confidence = 1.0
+	}
+
+	return result, nil
+}
+
+// weightByFileShare raises a result's confidence by its language's share of
+// the project's source files, so that in a polyglot repository the language
+// most of the code is written in outranks one with a stray manifest. Projects
+// with fewer than minCountSample source files are left to their manifests.
+func weightByFileShare(result *DetectionResult, count, total int) {
+	if count == 0 || total < minCountSample {
+		return
+	}
+	share := float64(count) / float64(total)
+	result.Confidence += fileShareWeight * share
+	result.Indicators = append(result.Indicators, fmt.Sprintf("%.0f%% of source files", share*100))
+
+	// Cap confidence at 1.0
+	if result.Confidence > 1.0 {
+		result.Confidence = 1.0
+	}
+}
+
+// countSourceFiles counts the source files of each language in the project,
+// skipping the same paths as findFiles. It stops after maxCountFiles
+// files and does not descend more than maxCountDepth directories. It returns
+// the counts and the number of source files counted.
+func (d *Detector) countSourceFiles() (map[ProjectType]int, int) {
+	counts := make(map[ProjectType]int)
+	total, seen := 0, 0
+	filter := NewTreeFilter(d.root)
+
+	_ = filepath.WalkDir(d.root, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return nil // Skip errors and continue
+		}
+
+		if entry.IsDir() {
+			if path == d.root {
+				return nil
+			}
+			relPath, _ := filepath.Rel(d.root, path)
+			if filter.Skip(path, true) || strings.Count(relPath, string(filepath.Separator)) >= maxCountDepth {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filter.Skip(path, false) {
+			return nil
+		}
+
+		seen++
+		if seen > maxCountFiles {
+			return filepath.SkipAll
+		}
+		if lang, ok := sourceExtensions[strings.ToLower(filepath.Ext(entry.Name()))]; ok {
+			counts[lang]++
+			total++
+		}
+		return nil
+	})
+
+	return counts, total
+}
+
+// skipDir reports whether a directory holds dependencies, build output or
+// other files that don't reflect the project's own code.
+func skipDir(name string) bool {
+	switch name {
+	case "node_modules", "vendor", ".git", "__pycache__", ".venv", "venv", "target", "build", "dist":
+		return true
+	}
+	return false
+}
+
 // fileExists checks if a file exists in the project root.
 func (d *Detector) fileExists(name string) bool {
 	path := filepath.Join(d.root, name)
@@ -364,12 +660,24 @@ func (d *Detector) dirExists(name string) bool {
 	return info.IsDir()
 }
 
+// fileContains checks if a file in the project root contains substr.
+func (d *Detector) fileContains(name, substr string) bool {
+	data, err := os.ReadFile(filepath.Join(d.root, name)) // #nosec G304 - name is a fixed manifest name
+	if err != nil {
+		return false
+	}
+	return strings.Contains(string(data), substr)
+}
+
 // findFiles searches for files matching the pattern in the project.
 // It limits the search to avoid scanning large directories.
 // maxDepth limits how deep to recurse (0 = root only, -1 = unlimited).
+// Dependency and build directories, and paths ignored by the project's
+// .gitignore, are skipped.
 func (d *Detector) findFiles(pattern string, maxDepth int) ([]string, error) {
 	var matches []string
 	maxResults := 10 // Limit results to avoid scanning entire codebase
+	filter := NewTreeFilter(d.root)
 
 	err := filepath.WalkDir(d.root, func(path string, entry os.DirEntry, err error) error {
 		if err != nil {
@@ -397,14 +705,12 @@ func (d *Detector) findFiles(pattern string, maxDepth int) ([]string, error) {
 			return nil
 		}
 
-		// Skip common non-source directories
-		if entry.IsDir() {
-			name := entry.Name()
-			if name == "node_modules" || name == "vendor" || name == ".git" ||
-				name == "__pycache__" || name == ".venv" || name == "venv" ||
-				name == "target" || name == "build" || name == "dist" {
+		// Skip common non-source directories and ignored paths
+		if filter.Skip(path, entry.IsDir()) {
+			if entry.IsDir() {
 				return filepath.SkipDir
 			}
+			return nil
 		}
 
 		// Check if file matches pattern
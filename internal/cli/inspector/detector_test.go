@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 )
 
@@ -537,6 +538,210 @@ func TestDetector_DetectJava(t *testing.T) {
 	}
 }
 
+func TestDetector_DetectDotNet(t *testing.T) {
+	tests := []struct {
+		name          string
+		files         map[string]string
+		minConfidence float64
+		maxConfidence float64
+	}{
+		{
+			name: "solution with projects",
+			files: map[string]string{
+				"Api.sln":                  "",
+				"global.json":              `{"sdk": {"version": "8.0.100"}}`,
+				"src/Api/Api.csproj":       `<Project Sdk="Microsoft.NET.Sdk.Web"></Project>`,
+				"src/Api/Program.cs":       "var app = WebApplication.Create(args);",
+				"tests/Api.Tests/Tests.cs": "public class Tests {}",
+			},
+			minConfidence: 0.95,
+			maxConfidence: 1.0,
+		},
+		{
+			name: "project file at root",
+			files: map[string]string{
+				"Tool.csproj": `<Project Sdk="Microsoft.NET.Sdk"></Project>`,
+				"Program.cs":  "Console.WriteLine();",
+			},
+			minConfidence: 0.8,
+			maxConfidence: 0.85,
+		},
+		{
+			name: "global.json only",
+			files: map[string]string{
+				"global.json": `{"sdk": {"version": "8.0.100"}}`,
+			},
+			minConfidence: 0.2,
+			maxConfidence: 0.25,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := createTestProject(t, tt.files, nil)
+			primary, err := NewDetector(root).DetectPrimary()
+			if err != nil {
+				t.Fatalf("DetectPrimary() error = %v", err)
+			}
+
+			if primary.Type != DotNet {
+				t.Fatalf("expected dotnet, got %s", primary.Type)
+			}
+			if primary.Confidence < tt.minConfidence {
+				t.Errorf("confidence %f is below minimum %f", primary.Confidence, tt.minConfidence)
+			}
+			if primary.Confidence > tt.maxConfidence {
+				t.Errorf("confidence %f is above maximum %f", primary.Confidence, tt.maxConfidence)
+			}
+		})
+	}
+}
+
+func TestDetector_DetectPHP(t *testing.T) {
+	tests := []struct {
+		name          string
+		files         map[string]string
+		minConfidence float64
+		maxConfidence float64
+	}{
+		{
+			name: "composer project",
+			files: map[string]string{
+				"composer.json":    `{"name": "acme/shop"}`,
+				"composer.lock":    "{}",
+				"src/Cart.php":     "<?php class Cart {}",
+				"public/index.php": "<?php require 'vendor/autoload.php';",
+			},
+			minConfidence: 0.95,
+			maxConfidence: 1.0,
+		},
+		{
+			name: "composer.json only",
+			files: map[string]string{
+				"composer.json": `{"name": "acme/shop"}`,
+			},
+			minConfidence: 0.6,
+			maxConfidence: 0.65,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := createTestProject(t, tt.files, nil)
+			primary, err := NewDetector(root).DetectPrimary()
+			if err != nil {
+				t.Fatalf("DetectPrimary() error = %v", err)
+			}
+
+			if primary.Type != PHP {
+				t.Fatalf("expected php, got %s", primary.Type)
+			}
+			if primary.Confidence < tt.minConfidence {
+				t.Errorf("confidence %f is below minimum %f", primary.Confidence, tt.minConfidence)
+			}
+			if primary.Confidence > tt.maxConfidence {
+				t.Errorf("confidence %f is above maximum %f", primary.Confidence, tt.maxConfidence)
+			}
+		})
+	}
+}
+
+func TestDetector_DetectSwift(t *testing.T) {
+	tests := []struct {
+		name          string
+		files         map[string]string
+		minConfidence float64
+		maxConfidence float64
+	}{
+		{
+			name: "swift package",
+			files: map[string]string{
+				"Package.swift":                  "// swift-tools-version:5.9\nimport PackageDescription\n",
+				"Package.resolved":               "{}",
+				"Sources/Weather/Forecast.swift": "struct Forecast {}",
+			},
+			minConfidence: 0.95,
+			maxConfidence: 1.0,
+		},
+		{
+			name: "Package.swift only",
+			files: map[string]string{
+				"Package.swift": "// swift-tools-version:5.9\n",
+			},
+			minConfidence: 0.7,
+			maxConfidence: 0.75,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := createTestProject(t, tt.files, nil)
+			primary, err := NewDetector(root).DetectPrimary()
+			if err != nil {
+				t.Fatalf("DetectPrimary() error = %v", err)
+			}
+
+			if primary.Type != Swift {
+				t.Fatalf("expected swift, got %s", primary.Type)
+			}
+			if primary.Confidence < tt.minConfidence {
+				t.Errorf("confidence %f is below minimum %f", primary.Confidence, tt.minConfidence)
+			}
+			if primary.Confidence > tt.maxConfidence {
+				t.Errorf("confidence %f is above maximum %f", primary.Confidence, tt.maxConfidence)
+			}
+		})
+	}
+}
+
+func TestDetector_DetectKotlin(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		primary ProjectType
+	}{
+		{
+			name: "Kotlin Gradle project",
+			files: map[string]string{
+				"build.gradle.kts":                    "plugins {\n    kotlin(\"jvm\") version \"2.0.0\"\n}\n",
+				"settings.gradle.kts":                 "rootProject.name = \"weather\"\n",
+				"src/main/kotlin/com/acme/Weather.kt": "package com.acme",
+			},
+			primary: Kotlin,
+		},
+		{
+			name: "Groovy build script with the Kotlin plugin",
+			files: map[string]string{
+				"build.gradle":                        "plugins { id 'org.jetbrains.kotlin.jvm' version '2.0.0' }",
+				"src/main/kotlin/com/acme/Weather.kt": "package com.acme",
+			},
+			primary: Kotlin,
+		},
+		{
+			name: "Java project with a Kotlin DSL build script",
+			files: map[string]string{
+				"build.gradle.kts":               "plugins { java }",
+				"src/main/java/Weather.java":     "public class Weather {}",
+				"src/test/kotlin/WeatherSpec.kt": "class WeatherSpec",
+			},
+			primary: Java,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := createTestProject(t, tt.files, nil)
+			primary, err := NewDetector(root).DetectPrimary()
+			if err != nil {
+				t.Fatalf("DetectPrimary() error = %v", err)
+			}
+			if primary.Type != tt.primary {
+				t.Errorf("expected %s, got %s (confidence %f, indicators %v)", tt.primary, primary.Type, primary.Confidence, primary.Indicators)
+			}
+		})
+	}
+}
+
 func TestDetector_DetectPrimary(t *testing.T) {
 	// Test that DetectPrimary returns the highest confidence result
 	files := map[string]string{
@@ -616,6 +821,164 @@ func TestDetector_MultiLanguageProject(t *testing.T) {
 	}
 }
 
+func TestDetector_FileCountMajorityWins(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected ProjectType
+	}{
+		{
+			name: "Python majority with stray package.json",
+			files: map[string]string{
+				"package.json": `{"name": "docs-tooling"}`,
+				"scripts/x.js": "",
+			},
+			expected: Python,
+		},
+		{
+			name: "Go majority with requirements.txt",
+			files: map[string]string{
+				"requirements.txt": "mkdocs",
+				"docs/gen.py":      "",
+			},
+			expected: Go,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := make(map[string]string)
+			for name, content := range tt.files {
+				files[name] = content
+			}
+			ext := map[ProjectType]string{Python: "py", Go: "go"}[tt.expected]
+			for i := 0; i < 9; i++ {
+				files[fmt.Sprintf("pkg%d/mod%d.%s", i%3, i, ext)] = ""
+			}
+
+			root := createTestProject(t, files, nil)
+			primary, err := NewDetector(root).DetectPrimary()
+			if err != nil {
+				t.Fatalf("DetectPrimary() error = %v", err)
+			}
+			if primary.Type != tt.expected {
+				t.Errorf("expected primary %s, got %s (confidence %.2f)", tt.expected, primary.Type, primary.Confidence)
+			}
+			if !slices.Contains(primary.Indicators, "90% of source files") {
+				t.Errorf("expected a file share indicator, got %v", primary.Indicators)
+			}
+		})
+	}
+}
+
+func TestDetector_FileCountNeedsSample(t *testing.T) {
+	// Too few source files to weight by: the manifest decides
+	files := map[string]string{
+		"package.json": `{"name": "app"}`,
+		"a.py":         "",
+		"b.py":         "",
+		"c.py":         "",
+	}
+
+	root := createTestProject(t, files, nil)
+	primary, err := NewDetector(root).DetectPrimary()
+	if err != nil {
+		t.Fatalf("DetectPrimary() error = %v", err)
+	}
+	if primary.Type != Node {
+		t.Errorf("expected primary node, got %s", primary.Type)
+	}
+}
+
+func TestDetector_CountSourceFilesSkipsVendor(t *testing.T) {
+	files := map[string]string{
+		"main.go":                   "package main",
+		"vendor/lib/lib.go":         "package lib",
+		"node_modules/pkg/index.js": "",
+		"web/app.ts":                "",
+		"README.md":                 "",
+	}
+
+	root := createTestProject(t, files, nil)
+	counts, total := NewDetector(root).countSourceFiles()
+	if total != 2 || counts[Go] != 1 || counts[Node] != 1 {
+		t.Errorf("expected one Go and one Node file, got %v (total %d)", counts, total)
+	}
+}
+
+func TestDetector_RespectsGitignore(t *testing.T) {
+	files := map[string]string{
+		"package.json":  `{"name": "app"}`,
+		"src/index.js":  "",
+		"src/app.js":    "",
+		"src/routes.js": "",
+	}
+	// A vendored Python dependency that outnumbers the project's own files
+	for i := 0; i < 20; i++ {
+		files[fmt.Sprintf("third_party/pylib/mod%d.py", i)] = ""
+	}
+
+	// Without a .gitignore, the vendored files make the project look like Python
+	root := createTestProject(t, files, nil)
+	primary, err := NewDetector(root).DetectPrimary()
+	if err != nil {
+		t.Fatalf("DetectPrimary() error = %v", err)
+	}
+	if primary.Type != Python {
+		t.Fatalf("expected the fixture to detect as python without .gitignore, got %s", primary.Type)
+	}
+
+	files[".gitignore"] = "# dependencies\n/third_party/\n*.log\n"
+	root = createTestProject(t, files, nil)
+	results, err := NewDetector(root).Detect()
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if results[0].Type != Node {
+		t.Errorf("expected primary node with third_party ignored, got %s", results[0].Type)
+	}
+	for _, r := range results {
+		if r.Type == Python {
+			t.Errorf("expected ignored Python files not to be detected, got %+v", r)
+		}
+	}
+
+	counts, total := NewDetector(root).countSourceFiles()
+	if total != 3 || counts[Node] != 3 {
+		t.Errorf("expected three Node files, got %v (total %d)", counts, total)
+	}
+}
+
+func TestTreeFilter_Ignored(t *testing.T) {
+	root := createTestProject(t, map[string]string{
+		".gitignore": "# build output\nout/\n*.log\n!keep.log\n/generated\ndocs/**/*.html\n\n",
+	}, nil)
+	filter := NewTreeFilter(root)
+
+	tests := []struct {
+		path  string
+		isDir bool
+		want  bool
+	}{
+		{"out", true, true},
+		{"out", false, false}, // out/ only matches directories
+		{"pkg/out", true, true},
+		{"debug.log", false, true},
+		{"logs/debug.log", false, true},
+		{"keep.log", false, false},
+		{"generated", true, true},
+		{"pkg/generated", true, false}, // /generated is anchored to the root
+		{"docs/api/index.html", false, true},
+		{"node_modules", true, true}, // default skipped directory
+		{"main.go", false, false},
+	}
+	for _, tt := range tests {
+		if got := filter.Skip(filepath.Join(root, tt.path), tt.isDir); got != tt.want {
+			t.Errorf("Skip(%q, dir=%v) = %v, want %v", tt.path, tt.isDir, got, tt.want)
+		}
+	}
+}
+
 func TestDetector_IndicatorsPopulated(t *testing.T) {
 	files := map[string]string{
 		"go.mod":  "module test",
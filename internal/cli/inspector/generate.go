@@ -0,0 +1,167 @@
+package inspector
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// packagesVar is the variable generated configs use for the Go package pattern.
+const packagesVar = "packages"
+
+// packagesPattern is the package pattern replaced by {{.packages}} in commands.
+const packagesPattern = "./..."
+
+// GeneratedConfig is a vibeguard configuration built from inspector results.
+type GeneratedConfig struct {
+	ProjectType     ProjectType
+	Recommendations []CheckRecommendation
+	Vars            map[string]string
+}
+
+// GenerateConfig inspects the project at root and builds a configuration from
+// the recommended checks. Tools are scanned with opts. Recommendations are
+// sorted by priority and deduplicated by ID, and requires entries pointing at
+// checks that were not recommended are dropped so the result always loads.
+func GenerateConfig(root string, opts ScanOptions) (*GeneratedConfig, error) {
+	detection, err := NewDetector(root).DetectPrimary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect project type: %w", err)
+	}
+
+	tools, err := NewToolScanner(root).ScanAllWithOptions(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan tools: %w", err)
+	}
+
+	recs, vars := prepareRecommendations(NewRecommender(detection.Type, tools).Recommend())
+	if len(recs) == 0 {
+		return nil, fmt.Errorf("no checks could be recommended for %s (detected project type: %s)", root, detection.Type)
+	}
+
+	return &GeneratedConfig{
+		ProjectType:     detection.Type,
+		Recommendations: recs,
+		Vars:            vars,
+	}, nil
+}
+
+// prepareRecommendations sorts and deduplicates recs, drops requires entries
+// for checks that are not in the list, and replaces the Go package pattern in
+// commands with a variable. It returns the checks and the variables they use.
+func prepareRecommendations(recs []CheckRecommendation) ([]CheckRecommendation, map[string]string) {
+	sortRecommendations(recs)
+	recs = DeduplicateRecommendations(recs)
+
+	ids := make(map[string]bool, len(recs))
+	for _, rec := range recs {
+		ids[rec.ID] = true
+	}
+
+	vars := make(map[string]string)
+	for i := range recs {
+		var requires []string
+		for _, dep := range recs[i].Requires {
+			if ids[dep] {
+				requires = append(requires, dep)
+			}
+		}
+		recs[i].Requires = requires
+
+		if strings.Contains(recs[i].Command, packagesPattern) {
+			recs[i].Command = strings.ReplaceAll(recs[i].Command, packagesPattern, "{{."+packagesVar+"}}")
+			vars[packagesVar] = packagesPattern
+		}
+	}
+	return recs, vars
+}
+
+// YAML renders the configuration as a vibeguard.yaml document, with each
+// check's description as a comment.
+func (g *GeneratedConfig) YAML() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Generated by 'vibeguard init --detect' (project type: %s).\n", g.ProjectType)
+	b.WriteString("# Review the checks below and adjust them to your project.\n")
+	b.WriteString("version: \"1\"\n")
+
+	if len(g.Vars) > 0 {
+		b.WriteString("\nvars:\n")
+		for _, name := range sortedKeys(g.Vars) {
+			fmt.Fprintf(&b, "  %s: %q\n", name, g.Vars[name])
+		}
+	}
+
+	b.WriteString("\nchecks:\n")
+	for i, rec := range g.Recommendations {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "  - id: %s\n", rec.ID)
+		if rec.Description != "" {
+			fmt.Fprintf(&b, "    description: %s\n", yamlScalar(rec.Description))
+		}
+		if rec.Category != "" {
+			fmt.Fprintf(&b, "    category: %s\n", rec.Category)
+		}
+		fmt.Fprintf(&b, "    run: %s\n", yamlScalar(rec.Command))
+		if rec.File != "" {
+			fmt.Fprintf(&b, "    file: %s\n", yamlScalar(rec.File))
+		}
+		if rec.Coverage != "" {
+			fmt.Fprintf(&b, "    coverage: %s\n", rec.Coverage)
+		}
+		if len(rec.Grok) > 0 {
+			b.WriteString("    grok:\n")
+			for _, pattern := range rec.Grok {
+				fmt.Fprintf(&b, "      - %s\n", yamlScalar(pattern))
+			}
+		}
+		if rec.Assert != "" {
+			fmt.Fprintf(&b, "    assert: %s\n", yamlScalar(rec.Assert))
+		}
+		if rec.Severity != "" {
+			fmt.Fprintf(&b, "    severity: %s\n", rec.Severity)
+		}
+		if rec.Suggestion != "" {
+			fmt.Fprintf(&b, "    suggestion: %s\n", yamlScalar(rec.Suggestion))
+		}
+		if rec.Timeout != "" {
+			fmt.Fprintf(&b, "    timeout: %s\n", rec.Timeout)
+		}
+		if len(rec.Requires) > 0 {
+			b.WriteString("    requires:\n")
+			for _, dep := range rec.Requires {
+				fmt.Fprintf(&b, "      - %s\n", dep)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// yamlScalar renders s as a single-line YAML scalar, quoting it as needed.
+func yamlScalar(s string) string {
+	node := &yaml.Node{Kind: yaml.ScalarNode, Value: s}
+	if strings.ContainsAny(s, "{}") || s == "" {
+		// Keep interpolation placeholders from being read as flow mappings
+		node.Style = yaml.DoubleQuotedStyle
+	}
+	out, err := yaml.Marshal(node)
+	if err != nil {
+		return fmt.Sprintf("%q", s)
+	}
+	return strings.TrimSuffix(string(out), "\n")
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,131 @@
+package inspector
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+// loadGenerated writes the generated YAML to a file and loads it with the
+// real config loader.
+func loadGenerated(t *testing.T, g *GeneratedConfig) *config.Config {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "vibeguard.yaml")
+	if err := os.WriteFile(path, []byte(g.YAML()), 0644); err != nil {
+		t.Fatalf("failed to write generated config: %v", err)
+	}
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("generated config does not load: %v\n%s", err, g.YAML())
+	}
+	return cfg
+}
+
+func TestGenerateConfig_GoProject(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "go.mod", "module example.com/demo\n\ngo 1.22\n")
+	writeFile(t, root, "main.go", "package main\n\nfunc main() {}\n")
+	writeFile(t, root, "main_test.go", "package main\n")
+
+	g, err := GenerateConfig(root, ScanOptions{})
+	if err != nil {
+		t.Fatalf("GenerateConfig failed: %v", err)
+	}
+	if g.ProjectType != Go {
+		t.Errorf("expected project type go, got %s", g.ProjectType)
+	}
+
+	cfg := loadGenerated(t, g)
+	if cfg.Vars["packages"] != "./..." {
+		t.Errorf("expected packages var \"./...\", got %q", cfg.Vars["packages"])
+	}
+
+	checks := make(map[string]config.Check)
+	for _, c := range cfg.Checks {
+		checks[c.ID] = c
+	}
+	for _, id := range []string{"fmt", "vet", "test", "build"} {
+		if _, ok := checks[id]; !ok {
+			t.Errorf("expected a %q check, got %v", id, cfg.Checks)
+		}
+	}
+	if got := checks["vet"].Run; got != "go vet ./..." {
+		t.Errorf("expected vet command to be interpolated, got %q", got)
+	}
+	for _, id := range []string{"fmt", "vet", "test", "build"} {
+		if checks[id].Description == "" {
+			t.Errorf("expected the %q check to carry the recommendation's description", id)
+		}
+	}
+	if got := checks["fmt"].Category; got != config.CategoryFormat {
+		t.Errorf("expected the fmt check to carry the recommendation's category, got %q", got)
+	}
+	if !strings.Contains(g.YAML(), "{{.packages}}") {
+		t.Error("expected generated YAML to reference the packages var")
+	}
+}
+
+func TestGenerateConfig_NodeProject(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "package.json", `{
+  "name": "demo",
+  "scripts": {"test": "jest", "lint": "eslint ."},
+  "devDependencies": {"eslint": "^8.0.0", "jest": "^29.0.0", "prettier": "^3.0.0"}
+}`)
+	writeFile(t, root, "index.js", "module.exports = {}\n")
+
+	g, err := GenerateConfig(root, ScanOptions{})
+	if err != nil {
+		t.Fatalf("GenerateConfig failed: %v", err)
+	}
+
+	cfg := loadGenerated(t, g)
+	if len(cfg.Checks) == 0 {
+		t.Fatal("expected generated checks")
+	}
+	if _, ok := cfg.Vars["packages"]; ok {
+		t.Error("did not expect a packages var for a Node project")
+	}
+}
+
+func TestGenerateConfig_NothingDetected(t *testing.T) {
+	_, err := GenerateConfig(t.TempDir(), ScanOptions{})
+	if err == nil || !strings.Contains(err.Error(), "no checks could be recommended") {
+		t.Errorf("expected no recommendations error, got %v", err)
+	}
+}
+
+func TestPrepareRecommendations(t *testing.T) {
+	recs := []CheckRecommendation{
+		{ID: "coverage", Command: "go test -cover ./...", Requires: []string{"test", "missing"}, Priority: 35},
+		{ID: "fmt", Command: "gofmt -l .", Priority: 10},
+		{ID: "test", Command: "go test ./...", Priority: 30},
+		{ID: "fmt", Command: "prettier --check .", Priority: 12},
+	}
+
+	got, vars := prepareRecommendations(recs)
+
+	var ids []string
+	for _, rec := range got {
+		ids = append(ids, rec.ID)
+	}
+	if want := []string{"fmt", "test", "coverage"}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("expected checks %v sorted by priority, got %v", want, ids)
+	}
+	if got[0].Command != "gofmt -l ." {
+		t.Errorf("expected the higher priority duplicate to win, got %q", got[0].Command)
+	}
+	if !reflect.DeepEqual(got[2].Requires, []string{"test"}) {
+		t.Errorf("expected dangling requires to be dropped, got %v", got[2].Requires)
+	}
+	if got[1].Command != "go test {{.packages}}" {
+		t.Errorf("expected package pattern to be replaced, got %q", got[1].Command)
+	}
+	if !reflect.DeepEqual(vars, map[string]string{"packages": "./..."}) {
+		t.Errorf("unexpected vars: %v", vars)
+	}
+}
@@ -0,0 +1,108 @@
+package inspector
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/vibeguard/vibeguard/internal/glob"
+)
+
+// ignoreRule is one pattern line of a .gitignore file.
+type ignoreRule struct {
+	pattern  string // Pattern without its !, leading / or trailing /
+	negate   bool   // Line started with !: re-include matching paths
+	dirOnly  bool   // Line ended with /: match directories only
+	anchored bool   // Pattern contains a /: match the path from the root
+}
+
+// TreeFilter decides which paths are skipped when walking a project, by the
+// inspector and by check --recursive: directories skipDir names, and whatever
+// the .gitignore in the project root ignores.
+type TreeFilter struct {
+	root  string
+	rules []ignoreRule
+}
+
+// NewTreeFilter reads the .gitignore in root. A missing or unreadable file
+// leaves only the default skipped directories.
+func NewTreeFilter(root string) *TreeFilter {
+	f := &TreeFilter{root: root}
+	data, err := os.ReadFile(filepath.Join(root, ".gitignore")) // #nosec G304 - .gitignore in the inspected root
+	if err != nil {
+		return f
+	}
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		if rule, ok := parseIgnoreRule(scanner.Text()); ok {
+			f.rules = append(f.rules, rule)
+		}
+	}
+	return f
+}
+
+// parseIgnoreRule parses a .gitignore line. Blank lines and comments yield
+// no rule.
+func parseIgnoreRule(line string) (ignoreRule, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignoreRule{}, false
+	}
+
+	var rule ignoreRule
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	}
+	line = strings.TrimPrefix(line, `\`) // Escaped leading # or !
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if strings.Contains(line, "/") {
+		rule.anchored = true
+		line = strings.TrimPrefix(line, "/")
+	}
+	if line == "" {
+		return ignoreRule{}, false
+	}
+	rule.pattern = line
+	return rule, true
+}
+
+// Skip reports whether the walk should leave out the entry at path: a
+// default skipped directory or a path the .gitignore ignores. The root itself
+// is never skipped.
+func (f *TreeFilter) Skip(p string, isDir bool) bool {
+	rel, err := filepath.Rel(f.root, p)
+	if err != nil || rel == "." {
+		return false
+	}
+	if isDir && skipDir(filepath.Base(p)) {
+		return true
+	}
+	return f.ignored(filepath.ToSlash(rel), isDir)
+}
+
+// ignored reports whether the .gitignore rules ignore the slash-separated
+// path rel. As in git, the last matching rule decides.
+func (f *TreeFilter) ignored(rel string, isDir bool) bool {
+	ignored := false
+	for _, rule := range f.rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		var matched bool
+		if rule.anchored {
+			matched = glob.Match(rule.pattern, rel)
+		} else {
+			matched, _ = path.Match(rule.pattern, path.Base(rel))
+		}
+		if matched {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
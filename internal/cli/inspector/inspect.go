@@ -0,0 +1,44 @@
+package inspector
+
+import "fmt"
+
+// Inspection is everything the inspector finds out about a project: the
+// same detection and tool scan that init --detect builds its config from,
+// plus the project's metadata and layout.
+type Inspection struct {
+	Project   DetectionResult   // Primary project type
+	Metadata  *ProjectMetadata  // Metadata from the project's manifest
+	Structure *ProjectStructure // Entry points, source and test directories
+	Tools     []ToolInfo        // Detected tools, in scan order
+}
+
+// Inspect runs the detector, tool scanner and metadata extractor on the
+// project at root. Tools are scanned with opts.
+func Inspect(root string, opts ScanOptions) (*Inspection, error) {
+	detection, err := NewDetector(root).DetectPrimary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect project type: %w", err)
+	}
+
+	tools, err := NewToolScanner(root).ScanAllWithOptions(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan tools: %w", err)
+	}
+
+	extractor := NewMetadataExtractor(root)
+	metadata, err := extractor.Extract(detection.Type)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract project metadata: %w", err)
+	}
+	structure, err := extractor.ExtractStructure(detection.Type)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract project structure: %w", err)
+	}
+
+	return &Inspection{
+		Project:   *detection,
+		Metadata:  metadata,
+		Structure: structure,
+		Tools:     tools,
+	}, nil
+}
@@ -429,6 +429,17 @@ func TestUnusualProjectStructures(t *testing.T) {
 		if result.Type != Go {
 			t.Errorf("Expected Go for Go workspace, got %s", result.Type)
 		}
+
+		structure, err := NewMetadataExtractor(dir).ExtractStructure(result.Type)
+		if err != nil {
+			t.Fatalf("ExtractStructure failed: %v", err)
+		}
+		if !structure.HasMonorepo {
+			t.Error("Expected go.work with two modules to be detected as a monorepo")
+		}
+		if len(structure.WorkspaceModules) != 2 {
+			t.Errorf("Expected 2 workspace modules, got %v", structure.WorkspaceModules)
+		}
 	})
 
 	t.Run("Java Maven standard layout", func(t *testing.T) {
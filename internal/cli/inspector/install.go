@@ -0,0 +1,97 @@
+package inspector
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// installHints maps the executables that recommended and template checks
+// run to how to install them.
+var installHints = map[string]string{
+	// Go
+	"go":            "Install Go from https://go.dev/doc/install",
+	"gofmt":         "Ships with Go: https://go.dev/doc/install",
+	"golangci-lint": "See https://golangci-lint.run/welcome/install/",
+	"goimports":     "go install golang.org/x/tools/cmd/goimports@latest",
+	"gosec":         "go install github.com/securego/gosec/v2/cmd/gosec@latest",
+	"govulncheck":   "go install golang.org/x/vuln/cmd/govulncheck@latest",
+	"staticcheck":   "go install honnef.co/go/tools/cmd/staticcheck@latest",
+
+	// Node.js and Bun
+	"node":     "Install Node.js from https://nodejs.org/",
+	"npm":      "Ships with Node.js: https://nodejs.org/",
+	"npx":      "Ships with Node.js: https://nodejs.org/",
+	"bun":      "curl -fsSL https://bun.sh/install | bash",
+	"bunx":     "Ships with Bun: curl -fsSL https://bun.sh/install | bash",
+	"eslint":   "npm install --save-dev eslint, then run it with npx eslint",
+	"prettier": "npm install --save-dev prettier, then run it with npx prettier",
+	"tsc":      "npm install --save-dev typescript, then run it with npx tsc",
+	"jest":     "npm install --save-dev jest, then run it with npx jest",
+	"mocha":    "npm install --save-dev mocha, then run it with npx mocha",
+	"vitest":   "npm install --save-dev vitest, then run it with npx vitest",
+
+	// Python
+	"python":    "Install Python from https://www.python.org/downloads/",
+	"python3":   "Install Python from https://www.python.org/downloads/",
+	"pip":       "python3 -m ensurepip --upgrade",
+	"poetry":    "pipx install poetry",
+	"uv":        "curl -LsSf https://astral.sh/uv/install.sh | sh",
+	"black":     "pip install black",
+	"flake8":    "pip install flake8",
+	"isort":     "pip install isort",
+	"mypy":      "pip install mypy",
+	"pylint":    "pip install pylint",
+	"pytest":    "pip install pytest",
+	"ruff":      "pip install ruff",
+	"pip-audit": "pip install pip-audit",
+
+	// Rust
+	"cargo": "Install Rust with rustup: https://rustup.rs/",
+	"rustc": "Install Rust with rustup: https://rustup.rs/",
+
+	// .NET
+	"dotnet": "Install the .NET SDK from https://dotnet.microsoft.com/download",
+
+	// PHP
+	"php":          "Install PHP from https://www.php.net/downloads",
+	"composer":     "See https://getcomposer.org/download/",
+	"php-cs-fixer": "composer require --dev friendsofphp/php-cs-fixer",
+	"phpstan":      "composer require --dev phpstan/phpstan",
+	"phpunit":      "composer require --dev phpunit/phpunit",
+
+	// Swift
+	"swift":     "Install Swift from https://www.swift.org/install/",
+	"swiftlint": "brew install swiftlint, or see https://github.com/realm/SwiftLint#installation",
+
+	// Kotlin
+	"gradle":  "See https://gradle.org/install/",
+	"gradlew": "Generate the Gradle wrapper with 'gradle wrapper': https://docs.gradle.org/current/userguide/gradle_wrapper.html",
+	"ktlint":  "brew install ktlint, or see https://pinterest.github.io/ktlint/latest/install/cli/",
+	"detekt":  "brew install detekt, or see https://detekt.dev/docs/gettingstarted/cli",
+
+	// Shell
+	"bash":       "Install bash with your system package manager",
+	"shellcheck": "brew install shellcheck, or apt-get install shellcheck",
+	"shfmt":      "go install mvdan.cc/sh/v3/cmd/shfmt@latest",
+	"bats":       "brew install bats-core, or npm install --save-dev bats",
+	"pwsh":       "See https://learn.microsoft.com/powershell/scripting/install/installing-powershell",
+
+	// Containers, CI and build runners
+	"docker":     "Install Docker from https://docs.docker.com/get-docker/",
+	"hadolint":   "brew install hadolint, or see https://github.com/hadolint/hadolint#install",
+	"checkov":    "pip install checkov",
+	"actionlint": "go install github.com/rhysd/actionlint/cmd/actionlint@latest",
+	"make":       "Install make with your system package manager (build-essential, Xcode command line tools)",
+	"just":       "See https://just.systems/man/en/packages.html",
+	"bazel":      "Install Bazelisk: https://github.com/bazelbuild/bazelisk#installation",
+}
+
+// InstallHint returns how to install the tool an executable belongs to, or
+// "" for an executable vibeguard doesn't know. Paths are looked up by their
+// base name, so vendor/bin/phpstan gets the hint for phpstan.
+func InstallHint(executable string) string {
+	name := path.Base(filepath.ToSlash(executable))
+	name = strings.TrimSuffix(strings.ToLower(name), ".exe")
+	return installHints[name]
+}
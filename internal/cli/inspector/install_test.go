@@ -0,0 +1,53 @@
+package inspector
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInstallHint(t *testing.T) {
+	tests := []struct {
+		executable string
+		wantPrefix string
+	}{
+		{"golangci-lint", "See https://golangci-lint.run"},
+		{"vendor/bin/phpstan", "composer require --dev phpstan/phpstan"},
+		{"pytest.exe", "pip install pytest"},
+		{"no-such-tool", ""},
+	}
+	for _, tt := range tests {
+		got := InstallHint(tt.executable)
+		if !strings.HasPrefix(got, tt.wantPrefix) || (tt.wantPrefix == "") != (got == "") {
+			t.Errorf("InstallHint(%q) = %q, want prefix %q", tt.executable, got, tt.wantPrefix)
+		}
+	}
+}
+
+// Every check the recommender suggests starts with an executable that has an
+// install hint, so doctor can tell users how to get it.
+func TestInstallHint_CoversRecommendations(t *testing.T) {
+	var tools []ToolInfo
+	for _, name := range []string{
+		"golangci-lint", "gofmt", "go vet", "go test", "go build", "goimports", "govulncheck", "gosec",
+		"eslint", "prettier", "jest", "mocha", "vitest", "typescript", "npm audit",
+		"black", "pylint", "pytest", "mypy", "ruff", "flake8", "isort", "pip-audit",
+		"dotnet format", "dotnet test", "dotnet analyzers",
+		"php-cs-fixer", "phpstan", "phpunit",
+		"swiftlint", "swift test", "ktlint", "detekt",
+		"hadolint", "docker compose", "make build", "make test", "bazel",
+	} {
+		tools = append(tools, ToolInfo{Name: name, Detected: true, Confidence: 1})
+	}
+
+	for _, projectType := range []ProjectType{Go, Node, Python, Rust, DotNet, PHP, Swift, Kotlin, Unknown} {
+		for _, rec := range NewRecommender(projectType, tools).Recommend() {
+			fields := strings.Fields(rec.Command)
+			if len(fields) == 0 || fields[0] == "test" {
+				continue
+			}
+			if InstallHint(fields[0]) == "" {
+				t.Errorf("%s: recommendation %q runs %q, which has no install hint", projectType, rec.ID, fields[0])
+			}
+		}
+	}
+}
@@ -5,10 +5,15 @@ package inspector
 import (
 	"bufio"
 	"encoding/json"
+	"encoding/xml"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+
+	"github.com/BurntSushi/toml"
 )
 
 // ProjectMetadata holds extracted metadata from project configuration files.
@@ -25,12 +30,13 @@ type ProjectMetadata struct {
 
 // ProjectStructure describes the layout of a project.
 type ProjectStructure struct {
-	EntryPoints    []string // Main entry points (e.g., cmd/main.go, src/index.js)
-	SourceDirs     []string // Main source directories (e.g., src, lib, pkg)
-	TestDirs       []string // Test directories (e.g., tests, test, __tests__)
-	ConfigFiles    []string // Configuration files found
-	HasMonorepo    bool     // Whether this appears to be a monorepo
-	BuildOutputDir string   // Build output directory (e.g., dist, build, bin)
+	EntryPoints      []string // Main entry points (e.g., cmd/main.go, src/index.js)
+	SourceDirs       []string // Main source directories (e.g., src, lib, pkg)
+	TestDirs         []string // Test directories (e.g., tests, test, __tests__)
+	ConfigFiles      []string // Configuration files found
+	HasMonorepo      bool     // Whether this appears to be a monorepo
+	WorkspaceModules []string // Module directories listed in go.work
+	BuildOutputDir   string   // Build output directory (e.g., dist, build, bin)
 }
 
 // MetadataExtractor extracts metadata from project configuration files.
@@ -74,6 +80,14 @@ func (m *MetadataExtractor) Extract(projectType ProjectType) (*ProjectMetadata,
 		return m.extractRubyMetadata()
 	case Java:
 		return m.extractJavaMetadata()
+	case DotNet:
+		return m.extractDotNetMetadata()
+	case PHP:
+		return m.extractPHPMetadata()
+	case Swift:
+		return m.extractSwiftMetadata()
+	case Kotlin:
+		return m.extractKotlinMetadata()
 	default:
 		return &ProjectMetadata{Extra: make(map[string]string)}, nil
 	}
@@ -82,22 +96,26 @@ func (m *MetadataExtractor) Extract(projectType ProjectType) (*ProjectMetadata,
 // ExtractStructure analyzes the project structure.
 func (m *MetadataExtractor) ExtractStructure(projectType ProjectType) (*ProjectStructure, error) {
 	structure := &ProjectStructure{
-		EntryPoints:    []string{},
-		SourceDirs:     []string{},
-		TestDirs:       []string{},
-		ConfigFiles:    []string{},
-		HasMonorepo:    false,
-		BuildOutputDir: "",
+		EntryPoints:      []string{},
+		SourceDirs:       []string{},
+		TestDirs:         []string{},
+		ConfigFiles:      []string{},
+		HasMonorepo:      false,
+		WorkspaceModules: []string{},
+		BuildOutputDir:   "",
 	}
 
 	// Find config files that exist
 	configCandidates := []string{
-		"go.mod", "go.sum",
+		"go.mod", "go.sum", "go.work",
 		"package.json", "package-lock.json", "yarn.lock", "pnpm-lock.yaml",
 		"pyproject.toml", "setup.py", "setup.cfg", "requirements.txt",
 		"Cargo.toml", "Cargo.lock",
 		"Gemfile", "Gemfile.lock",
 		"pom.xml", "build.gradle", "build.gradle.kts",
+		"global.json", "Directory.Build.props",
+		"composer.json", "composer.lock",
+		"Package.swift", "Package.resolved", "settings.gradle.kts",
 		".golangci.yml", ".eslintrc.json", ".prettierrc",
 		"tsconfig.json", "jest.config.js", "vitest.config.ts",
 		"Makefile", "Dockerfile", "docker-compose.yml",
@@ -123,10 +141,21 @@ func (m *MetadataExtractor) ExtractStructure(projectType ProjectType) (*ProjectS
 		m.extractRubyStructure(structure)
 	case Java:
 		m.extractJavaStructure(structure)
+	case DotNet:
+		m.extractDotNetStructure(structure)
+	case PHP:
+		m.extractPHPStructure(structure)
+	case Swift:
+		m.extractSwiftStructure(structure)
+	case Kotlin:
+		m.extractKotlinStructure(structure)
 	}
 
+	// A go.work can sit above modules of any project type
+	structure.WorkspaceModules = append(structure.WorkspaceModules, m.extractGoWorkspaceModules()...)
+
 	// Detect monorepo patterns
-	structure.HasMonorepo = m.detectMonorepo()
+	structure.HasMonorepo = m.detectMonorepo() || len(structure.WorkspaceModules) >= 2
 
 	return structure, nil
 }
@@ -274,82 +303,97 @@ func (m *MetadataExtractor) extractPythonMetadata() (*ProjectMetadata, error) {
 	return metadata, nil
 }
 
-// extractPyprojectToml extracts metadata from pyproject.toml.
+// extractPyprojectToml extracts metadata from pyproject.toml. Fields in the
+// PEP 621 [project] table take precedence over [tool.poetry].
 func (m *MetadataExtractor) extractPyprojectToml() (*ProjectMetadata, error) {
 	metadata := &ProjectMetadata{
 		Extra: make(map[string]string),
 	}
 
-	content, err := os.ReadFile(filepath.Join(m.root, "pyproject.toml"))
-	if err != nil {
+	doc, ok := m.readTOML("pyproject.toml")
+	if !ok {
 		return metadata, nil
 	}
 
-	lines := strings.Split(string(content), "\n")
-	inProjectSection := false
-	inAuthorsSection := false
-
-	// Simple TOML parsing for common fields
-	nameRegex := regexp.MustCompile(`^name\s*=\s*"([^"]+)"`)
-	versionRegex := regexp.MustCompile(`^version\s*=\s*"([^"]+)"`)
-	descRegex := regexp.MustCompile(`^description\s*=\s*"([^"]+)"`)
-	licenseRegex := regexp.MustCompile(`^license\s*=\s*"([^"]+)"`)
-	pythonRegex := regexp.MustCompile(`^requires-python\s*=\s*"([^"]+)"`)
-
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
+	project := tomlTable(doc, "project")
+	poetry := tomlTable(tomlTable(doc, "tool"), "poetry")
 
-		// Track section
-		if trimmed == "[project]" || trimmed == "[tool.poetry]" {
-			inProjectSection = true
-			inAuthorsSection = false
-			continue
-		}
-		if strings.HasPrefix(trimmed, "[") {
-			inProjectSection = false
-			inAuthorsSection = false
-			continue
-		}
-		if trimmed == "authors = [" {
-			inAuthorsSection = true
+	for _, section := range []map[string]any{project, poetry} {
+		if section == nil {
 			continue
 		}
-		if inAuthorsSection && trimmed == "]" {
-			inAuthorsSection = false
-			continue
+		setIfEmpty(&metadata.Name, tomlString(section, "name"))
+		setIfEmpty(&metadata.Version, tomlString(section, "version"))
+		setIfEmpty(&metadata.Description, tomlString(section, "description"))
+		setIfEmpty(&metadata.License, pyprojectLicense(section["license"]))
+		setIfEmpty(&metadata.Author, pyprojectFirstAuthor(section["authors"]))
+		if len(metadata.Keywords) == 0 {
+			metadata.Keywords = tomlStrings(section, "keywords")
 		}
+		setIfEmpty(&metadata.Repository, pyprojectURL(section, "repository", "source", "source code", "code"))
+		setExtraIfEmpty(metadata.Extra, "homepage", pyprojectURL(section, "homepage", "home"))
+		setExtraIfEmpty(metadata.Extra, "documentation", pyprojectURL(section, "documentation", "docs"))
+	}
 
-		if !inProjectSection {
-			continue
-		}
+	if python := tomlString(project, "requires-python"); python != "" {
+		metadata.Extra["python_version"] = python
+	}
 
-		// Extract fields
-		if matches := nameRegex.FindStringSubmatch(trimmed); len(matches) > 1 {
-			metadata.Name = matches[1]
-		}
-		if matches := versionRegex.FindStringSubmatch(trimmed); len(matches) > 1 {
-			metadata.Version = matches[1]
-		}
-		if matches := descRegex.FindStringSubmatch(trimmed); len(matches) > 1 {
-			metadata.Description = matches[1]
-		}
-		if matches := licenseRegex.FindStringSubmatch(trimmed); len(matches) > 1 {
-			metadata.License = matches[1]
-		}
-		if matches := pythonRegex.FindStringSubmatch(trimmed); len(matches) > 1 {
-			metadata.Extra["python_version"] = matches[1]
-		}
+	return metadata, nil
+}
 
-		// Extract first author
-		if inAuthorsSection && metadata.Author == "" {
-			authorRegex := regexp.MustCompile(`"([^"]+)"`)
-			if matches := authorRegex.FindStringSubmatch(trimmed); len(matches) > 1 {
-				metadata.Author = matches[1]
+// pyprojectLicense returns the license from a pyproject.toml license field,
+// which is either a string or a table with a text entry.
+func pyprojectLicense(v any) string {
+	switch license := v.(type) {
+	case string:
+		return license
+	case map[string]any:
+		return tomlString(license, "text")
+	default:
+		return ""
+	}
+}
+
+// pyprojectURL returns the first URL found under names, checking the
+// section's own keys (Poetry) and then its urls table (PEP 621), whose keys
+// are free-form labels and are matched case-insensitively.
+func pyprojectURL(section map[string]any, names ...string) string {
+	for _, name := range names {
+		if url := tomlString(section, name); url != "" {
+			return url
+		}
+	}
+	urls := tomlTable(section, "urls")
+	for _, name := range names {
+		for label, v := range urls {
+			if url, ok := v.(string); ok && strings.EqualFold(label, name) {
+				return url
 			}
 		}
 	}
+	return ""
+}
 
-	return metadata, nil
+// pyprojectFirstAuthor returns the first author from a pyproject.toml authors
+// array. Poetry lists authors as strings; PEP 621 uses tables with a name
+// and/or email.
+func pyprojectFirstAuthor(v any) string {
+	authors, _ := v.([]any)
+	if len(authors) == 0 {
+		return ""
+	}
+	switch author := authors[0].(type) {
+	case string:
+		return author
+	case map[string]any:
+		if name := tomlString(author, "name"); name != "" {
+			return name
+		}
+		return tomlString(author, "email")
+	default:
+		return ""
+	}
 }
 
 // extractSetupPy extracts metadata from setup.py using regex (limited parsing).
@@ -400,56 +444,24 @@ func (m *MetadataExtractor) extractRustMetadata() (*ProjectMetadata, error) {
 		Extra: make(map[string]string),
 	}
 
-	content, err := os.ReadFile(filepath.Join(m.root, "Cargo.toml"))
-	if err != nil {
+	doc, ok := m.readTOML("Cargo.toml")
+	if !ok {
 		return metadata, nil
 	}
 
-	lines := strings.Split(string(content), "\n")
-	inPackageSection := false
-
-	// Simple TOML parsing
-	nameRegex := regexp.MustCompile(`^name\s*=\s*"([^"]+)"`)
-	versionRegex := regexp.MustCompile(`^version\s*=\s*"([^"]+)"`)
-	descRegex := regexp.MustCompile(`^description\s*=\s*"([^"]+)"`)
-	licenseRegex := regexp.MustCompile(`^license\s*=\s*"([^"]+)"`)
-	editionRegex := regexp.MustCompile(`^edition\s*=\s*"([^"]+)"`)
-	repoRegex := regexp.MustCompile(`^repository\s*=\s*"([^"]+)"`)
-
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
-
-		if trimmed == "[package]" {
-			inPackageSection = true
-			continue
-		}
-		if strings.HasPrefix(trimmed, "[") && trimmed != "[package]" {
-			inPackageSection = false
-			continue
-		}
-
-		if !inPackageSection {
-			continue
-		}
-
-		if matches := nameRegex.FindStringSubmatch(trimmed); len(matches) > 1 {
-			metadata.Name = matches[1]
-		}
-		if matches := versionRegex.FindStringSubmatch(trimmed); len(matches) > 1 {
-			metadata.Version = matches[1]
-		}
-		if matches := descRegex.FindStringSubmatch(trimmed); len(matches) > 1 {
-			metadata.Description = matches[1]
-		}
-		if matches := licenseRegex.FindStringSubmatch(trimmed); len(matches) > 1 {
-			metadata.License = matches[1]
-		}
-		if matches := editionRegex.FindStringSubmatch(trimmed); len(matches) > 1 {
-			metadata.Extra["rust_edition"] = matches[1]
-		}
-		if matches := repoRegex.FindStringSubmatch(trimmed); len(matches) > 1 {
-			metadata.Repository = matches[1]
-		}
+	// Fields inherited from the workspace (version.workspace = true) are
+	// tables rather than strings and are left empty.
+	pkg := tomlTable(doc, "package")
+	metadata.Name = tomlString(pkg, "name")
+	metadata.Version = tomlString(pkg, "version")
+	metadata.Description = tomlString(pkg, "description")
+	metadata.License = tomlString(pkg, "license")
+	metadata.Repository = tomlString(pkg, "repository")
+	metadata.Keywords = tomlStrings(pkg, "keywords")
+	setExtraIfEmpty(metadata.Extra, "homepage", tomlString(pkg, "homepage"))
+	setExtraIfEmpty(metadata.Extra, "documentation", tomlString(pkg, "documentation"))
+	if edition := tomlString(pkg, "edition"); edition != "" {
+		metadata.Extra["rust_edition"] = edition
 	}
 
 	return metadata, nil
@@ -605,10 +617,12 @@ func (m *MetadataExtractor) extractBuildGradle() (*ProjectMetadata, error) {
 
 	text := string(content)
 
-	// Gradle patterns (both Groovy and Kotlin DSL)
+	// Gradle patterns (both Groovy and Kotlin DSL). The project's group and
+	// version are set at the start of a line, unlike plugin versions such as
+	// kotlin("jvm") version "2.0.0"
 	patterns := map[string]*regexp.Regexp{
-		"group":       regexp.MustCompile(`group\s*=?\s*["']([^"']+)["']`),
-		"version":     regexp.MustCompile(`version\s*=?\s*["']([^"']+)["']`),
+		"group":       regexp.MustCompile(`(?m)^\s*group\s*=?\s*["']([^"']+)["']`),
+		"version":     regexp.MustCompile(`(?m)^\s*version\s*=?\s*["']([^"']+)["']`),
 		"archiveBase": regexp.MustCompile(`archivesBaseName\s*=?\s*["']([^"']+)["']`),
 	}
 
@@ -628,6 +642,241 @@ func (m *MetadataExtractor) extractBuildGradle() (*ProjectMetadata, error) {
 	return metadata, nil
 }
 
+// csproj is the subset of an SDK-style .csproj file read for metadata.
+type csproj struct {
+	PropertyGroups []struct {
+		AssemblyName             string `xml:"AssemblyName"`
+		Version                  string `xml:"Version"`
+		Description              string `xml:"Description"`
+		Authors                  string `xml:"Authors"`
+		PackageLicenseExpression string `xml:"PackageLicenseExpression"`
+		RepositoryURL            string `xml:"RepositoryUrl"`
+		PackageTags              string `xml:"PackageTags"`
+		TargetFramework          string `xml:"TargetFramework"`
+		TargetFrameworks         string `xml:"TargetFrameworks"`
+	} `xml:"PropertyGroup"`
+}
+
+// extractDotNetMetadata extracts metadata from the main .csproj file: the
+// first non-test project at the root, in src/ or one directory down.
+func (m *MetadataExtractor) extractDotNetMetadata() (*ProjectMetadata, error) {
+	metadata := &ProjectMetadata{
+		Extra: make(map[string]string),
+	}
+
+	projects := findDotNetProjects(m.root)
+	if len(projects) == 0 {
+		return metadata, nil
+	}
+	mainProject := projects[0]
+	for _, project := range projects {
+		if !isDotNetTestProject(project) {
+			mainProject = project
+			break
+		}
+	}
+
+	projectPath := filepath.Join(m.root, mainProject)
+	if !m.isPathWithinRoot(projectPath) {
+		return metadata, nil // path outside root, return empty metadata
+	}
+	content, err := os.ReadFile(projectPath) // #nosec G304 - path is validated by isPathWithinRoot
+	if err != nil {
+		return metadata, nil
+	}
+
+	var project csproj
+	if err := xml.Unmarshal(content, &project); err != nil {
+		return metadata, nil // malformed project file, return empty metadata
+	}
+
+	// Properties may be spread over several property groups; the first
+	// value of each wins
+	for _, group := range project.PropertyGroups {
+		setIfEmpty(&metadata.Name, strings.TrimSpace(group.AssemblyName))
+		setIfEmpty(&metadata.Version, strings.TrimSpace(group.Version))
+		setIfEmpty(&metadata.Description, strings.TrimSpace(group.Description))
+		setIfEmpty(&metadata.Author, strings.TrimSpace(group.Authors))
+		setIfEmpty(&metadata.License, strings.TrimSpace(group.PackageLicenseExpression))
+		setIfEmpty(&metadata.Repository, strings.TrimSpace(group.RepositoryURL))
+		setExtraIfEmpty(metadata.Extra, "target_framework", strings.TrimSpace(group.TargetFramework))
+		setExtraIfEmpty(metadata.Extra, "target_framework", strings.TrimSpace(group.TargetFrameworks))
+		if metadata.Keywords == nil && group.PackageTags != "" {
+			for _, tag := range strings.FieldsFunc(group.PackageTags, func(r rune) bool { return r == ';' || r == ',' }) {
+				if tag = strings.TrimSpace(tag); tag != "" {
+					metadata.Keywords = append(metadata.Keywords, tag)
+				}
+			}
+		}
+	}
+
+	// Without an AssemblyName the assembly is named after the project file
+	setIfEmpty(&metadata.Name, strings.TrimSuffix(filepath.Base(mainProject), ".csproj"))
+	metadata.Extra["project_file"] = filepath.ToSlash(mainProject)
+
+	return metadata, nil
+}
+
+// findDotNetProjects returns the .csproj files at root, in src/ and test/
+// subdirectories, and one directory below root, relative to root and sorted
+// within each location.
+func findDotNetProjects(root string) []string {
+	var projects []string
+	seen := make(map[string]bool)
+	for _, pattern := range []string{"*.csproj", "src/*/*.csproj", "*/*.csproj", "test/*/*.csproj", "tests/*/*.csproj"} {
+		matches, _ := filepath.Glob(filepath.Join(root, filepath.FromSlash(pattern)))
+		sort.Strings(matches)
+		for _, match := range matches {
+			rel, err := filepath.Rel(root, match)
+			if err != nil || seen[rel] {
+				continue
+			}
+			seen[rel] = true
+			projects = append(projects, rel)
+		}
+	}
+	return projects
+}
+
+// isDotNetTestProject reports whether a project file is, by the usual
+// naming convention, a test project.
+func isDotNetTestProject(path string) bool {
+	name := strings.TrimSuffix(filepath.Base(path), ".csproj")
+	return strings.HasSuffix(name, "Tests") || strings.HasSuffix(name, ".Test") || strings.HasSuffix(name, "Test")
+}
+
+// extractPHPMetadata extracts metadata from composer.json.
+func (m *MetadataExtractor) extractPHPMetadata() (*ProjectMetadata, error) {
+	metadata := &ProjectMetadata{
+		Extra: make(map[string]string),
+	}
+
+	composerPath := filepath.Join(m.root, "composer.json")
+	if !m.isPathWithinRoot(composerPath) {
+		return metadata, nil // path outside root, return empty metadata
+	}
+	data, err := os.ReadFile(composerPath) // #nosec G304 - path is validated by isPathWithinRoot
+	if err != nil {
+		return metadata, nil // composer.json not found
+	}
+
+	var composer struct {
+		Name        string   `json:"name"`
+		Version     string   `json:"version"`
+		Description string   `json:"description"`
+		Type        string   `json:"type"`
+		License     any      `json:"license"` // Can be string or array
+		Keywords    []string `json:"keywords"`
+		Homepage    string   `json:"homepage"`
+		Authors     []struct {
+			Name  string `json:"name"`
+			Email string `json:"email"`
+		} `json:"authors"`
+		Require map[string]string `json:"require"`
+	}
+
+	if err := json.Unmarshal(data, &composer); err != nil {
+		return metadata, err
+	}
+
+	metadata.Name = composer.Name
+	metadata.Version = composer.Version
+	metadata.Description = composer.Description
+	metadata.Keywords = composer.Keywords
+	metadata.Repository = composer.Homepage
+
+	// Handle license field (can be string or array of alternatives)
+	switch license := composer.License.(type) {
+	case string:
+		metadata.License = license
+	case []interface{}:
+		var licenses []string
+		for _, l := range license {
+			if s, ok := l.(string); ok && s != "" {
+				licenses = append(licenses, s)
+			}
+		}
+		metadata.License = strings.Join(licenses, " OR ")
+	}
+
+	// Use the first author, with email if present
+	if len(composer.Authors) > 0 && composer.Authors[0].Name != "" {
+		metadata.Author = composer.Authors[0].Name
+		if composer.Authors[0].Email != "" {
+			metadata.Author += " <" + composer.Authors[0].Email + ">"
+		}
+	}
+
+	// Store extra fields
+	if composer.Type != "" {
+		metadata.Extra["type"] = composer.Type
+	}
+	if php := composer.Require["php"]; php != "" {
+		metadata.Extra["php_version"] = php
+	}
+
+	return metadata, nil
+}
+
+// extractSwiftMetadata extracts metadata from Package.swift. The manifest is
+// Swift code, so only the literal package name and the tools version
+// comment are read.
+func (m *MetadataExtractor) extractSwiftMetadata() (*ProjectMetadata, error) {
+	metadata := &ProjectMetadata{
+		Extra: make(map[string]string),
+	}
+
+	content, err := os.ReadFile(filepath.Join(m.root, "Package.swift"))
+	if err != nil {
+		return metadata, nil
+	}
+	text := string(content)
+
+	if matches := regexp.MustCompile(`Package\(\s*name:\s*"([^"]+)"`).FindStringSubmatch(text); len(matches) > 1 {
+		metadata.Name = matches[1]
+	}
+	if matches := regexp.MustCompile(`(?m)^//\s*swift-tools-version\s*:\s*([0-9.]+)`).FindStringSubmatch(text); len(matches) > 1 {
+		metadata.Extra["swift_tools_version"] = matches[1]
+	}
+
+	return metadata, nil
+}
+
+// extractKotlinMetadata extracts metadata from the Gradle build script, with
+// the project name from settings.gradle.kts or settings.gradle.
+func (m *MetadataExtractor) extractKotlinMetadata() (*ProjectMetadata, error) {
+	metadata, err := m.extractBuildGradle()
+	if err != nil {
+		return metadata, err
+	}
+
+	for _, name := range []string{"settings.gradle.kts", "settings.gradle"} {
+		content, err := os.ReadFile(filepath.Join(m.root, name))
+		if err != nil {
+			continue
+		}
+		if matches := regexp.MustCompile(`rootProject\.name\s*=\s*["']([^"']+)["']`).FindStringSubmatch(string(content)); len(matches) > 1 {
+			metadata.Name = matches[1]
+		}
+		break
+	}
+
+	// The version of the Kotlin Gradle plugin, e.g. kotlin("jvm") version "2.0.0"
+	for _, name := range []string{"build.gradle.kts", "build.gradle"} {
+		content, err := os.ReadFile(filepath.Join(m.root, name))
+		if err != nil {
+			continue
+		}
+		pattern := regexp.MustCompile(`(?:kotlin\("[\w.-]+"\)|id\(?\s*["']org\.jetbrains\.kotlin\.[\w.-]+["']\)?)\s+version\s+["']([^"']+)["']`)
+		if matches := pattern.FindStringSubmatch(string(content)); len(matches) > 1 {
+			metadata.Extra["kotlin_version"] = matches[1]
+		}
+		break
+	}
+
+	return metadata, nil
+}
+
 // extractGoStructure extracts Go project structure.
 func (m *MetadataExtractor) extractGoStructure(s *ProjectStructure) {
 	// Common Go entry points
@@ -684,6 +933,7 @@ func (m *MetadataExtractor) extractGoStructure(s *ProjectStructure) {
 // that are commonly considered source directories (pkg, internal, cmd, or root).
 func (m *MetadataExtractor) findGoTestDirs() []string {
 	testDirs := make(map[string]bool)
+	filter := NewTreeFilter(m.root)
 
 	// Walk from root to find *_test.go files
 	_ = filepath.Walk(m.root, func(path string, info os.FileInfo, err error) error {
@@ -691,15 +941,18 @@ func (m *MetadataExtractor) findGoTestDirs() []string {
 			return nil // Skip directories we can't read
 		}
 
-		// Skip hidden directories and common non-source directories
+		// Skip hidden directories, common non-source directories and ignored paths
 		name := info.Name()
 		if info.IsDir() {
 			// Don't skip the root directory itself (path == m.root handles both "." and absolute paths)
-			if path != m.root && (strings.HasPrefix(name, ".") || name == "vendor" || name == "node_modules" || name == "testdata") {
+			if path != m.root && (strings.HasPrefix(name, ".") || name == "testdata" || filter.Skip(path, true)) {
 				return filepath.SkipDir
 			}
 			return nil
 		}
+		if filter.Skip(path, false) {
+			return nil
+		}
 
 		// Check for *_test.go files
 		if strings.HasSuffix(name, "_test.go") {
@@ -779,6 +1032,12 @@ func (m *MetadataExtractor) extractNodeStructure(s *ProjectStructure) {
 			if pkg.Module != "" && pkg.Module != pkg.Main {
 				s.EntryPoints = append(s.EntryPoints, pkg.Module)
 			}
+			// Bin scripts are recorded even if they are build outputs that don't exist yet
+			for _, bin := range nodeBinPaths(pkg.Bin) {
+				if !contains(s.EntryPoints, bin) {
+					s.EntryPoints = append(s.EntryPoints, bin)
+				}
+			}
 		}
 	}
 
@@ -812,6 +1071,37 @@ func (m *MetadataExtractor) extractNodeStructure(s *ProjectStructure) {
 	}
 }
 
+// nodeBinPaths returns the script paths from a package.json bin field, which
+// is either a single path or an object mapping command names to paths.
+// Paths are cleaned so "./bin/cli.js" and "bin/cli.js" are the same entry.
+func nodeBinPaths(bin any) []string {
+	var paths []string
+	switch b := bin.(type) {
+	case string:
+		paths = append(paths, b)
+	case map[string]any:
+		names := make([]string, 0, len(b))
+		for name := range b {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if p, ok := b[name].(string); ok {
+				paths = append(paths, p)
+			}
+		}
+	}
+
+	var cleaned []string
+	for _, p := range paths {
+		if p == "" {
+			continue
+		}
+		cleaned = append(cleaned, path.Clean(filepath.ToSlash(p)))
+	}
+	return cleaned
+}
+
 // extractPythonStructure extracts Python project structure.
 func (m *MetadataExtractor) extractPythonStructure(s *ProjectStructure) {
 	// Common Python entry points
@@ -940,6 +1230,160 @@ func (m *MetadataExtractor) extractJavaStructure(s *ProjectStructure) {
 	}
 }
 
+// extractDotNetStructure extracts .NET project structure.
+func (m *MetadataExtractor) extractDotNetStructure(s *ProjectStructure) {
+	// Projects live in src/ by convention, or directly under the root
+	if m.dirExists("src") {
+		s.SourceDirs = append(s.SourceDirs, "src")
+	}
+	for _, dir := range []string{"tests", "test"} {
+		if m.dirExists(dir) {
+			s.TestDirs = append(s.TestDirs, dir)
+		}
+	}
+
+	// Top-level statements or a Main method in Program.cs next to each
+	// non-test project file
+	for _, project := range findDotNetProjects(m.root) {
+		if isDotNetTestProject(project) {
+			if dir := filepath.ToSlash(filepath.Dir(project)); dir != "." && !contains(s.TestDirs, dir) && !contains(s.TestDirs, path.Dir(dir)) {
+				s.TestDirs = append(s.TestDirs, dir)
+			}
+			continue
+		}
+		program := filepath.Join(filepath.Dir(project), "Program.cs")
+		if m.fileExists(program) {
+			s.EntryPoints = append(s.EntryPoints, filepath.ToSlash(program))
+		}
+	}
+
+	// Build output
+	if m.dirExists("bin") {
+		s.BuildOutputDir = "bin"
+	}
+}
+
+// extractPHPStructure extracts PHP project structure.
+func (m *MetadataExtractor) extractPHPStructure(s *ProjectStructure) {
+	// Common PHP entry points: front controllers and console scripts
+	for _, entry := range []string{"public/index.php", "index.php", "bin/console", "artisan"} {
+		if m.fileExists(entry) {
+			s.EntryPoints = append(s.EntryPoints, entry)
+		}
+	}
+
+	// Source directories
+	for _, dir := range []string{"src", "app", "lib"} {
+		if m.dirExists(dir) {
+			s.SourceDirs = append(s.SourceDirs, dir)
+		}
+	}
+
+	// Test directories
+	for _, dir := range []string{"tests", "test"} {
+		if m.dirExists(dir) {
+			s.TestDirs = append(s.TestDirs, dir)
+		}
+	}
+
+	// Build output (PHP typically doesn't have build output)
+	s.BuildOutputDir = ""
+}
+
+// extractSwiftStructure extracts Swift Package Manager project structure.
+func (m *MetadataExtractor) extractSwiftStructure(s *ProjectStructure) {
+	// Executable targets: Sources/<Target>/main.swift
+	mains, _ := filepath.Glob(filepath.Join(m.root, "Sources", "*", "main.swift"))
+	for _, main := range mains {
+		if rel, err := filepath.Rel(m.root, main); err == nil {
+			s.EntryPoints = append(s.EntryPoints, filepath.ToSlash(rel))
+		}
+	}
+
+	// SwiftPM's conventional target directories
+	if m.dirExists("Sources") {
+		s.SourceDirs = append(s.SourceDirs, "Sources")
+	}
+	if m.dirExists("Tests") {
+		s.TestDirs = append(s.TestDirs, "Tests")
+	}
+
+	// Build output
+	s.BuildOutputDir = ".build"
+}
+
+// extractKotlinStructure extracts Kotlin (Gradle) project structure.
+func (m *MetadataExtractor) extractKotlinStructure(s *ProjectStructure) {
+	// Gradle standard layout, which Kotlin code can share with Java code
+	for _, dir := range []string{"src/main/kotlin", "src/main/java", "src/main/resources"} {
+		if m.dirExists(dir) {
+			s.SourceDirs = append(s.SourceDirs, dir)
+		}
+	}
+
+	// Test directories
+	for _, dir := range []string{"src/test/kotlin", "src/test/java", "src/test/resources"} {
+		if m.dirExists(dir) {
+			s.TestDirs = append(s.TestDirs, dir)
+		}
+	}
+
+	// Build output
+	s.BuildOutputDir = "build"
+}
+
+// extractGoWorkspaceModules returns the module directories from the use
+// directives in go.work, in file order. Both the single-line form
+// (use ./a) and the block form (use ( ... )) are supported.
+func (m *MetadataExtractor) extractGoWorkspaceModules() []string {
+	filePath := filepath.Join(m.root, "go.work")
+	if !m.isPathWithinRoot(filePath) {
+		return nil // path outside root
+	}
+	file, err := os.Open(filePath) // #nosec G304 - path is validated by isPathWithinRoot
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = file.Close() }()
+
+	var modules []string
+	addModule := func(dir string) {
+		dir = strings.Trim(strings.TrimSpace(dir), "\"`")
+		if dir == "" {
+			return
+		}
+		dir = path.Clean(filepath.ToSlash(dir))
+		if !contains(modules, dir) {
+			modules = append(modules, dir)
+		}
+	}
+
+	inUseBlock := false
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if idx := strings.Index(line, "//"); idx >= 0 {
+			line = line[:idx]
+		}
+		line = strings.TrimSpace(line)
+
+		switch {
+		case inUseBlock:
+			if line == ")" {
+				inUseBlock = false
+			} else {
+				addModule(line)
+			}
+		case line == "use (" || line == "use(":
+			inUseBlock = true
+		case strings.HasPrefix(line, "use "):
+			addModule(strings.TrimPrefix(line, "use "))
+		}
+	}
+
+	return modules
+}
+
 // detectMonorepo checks for common monorepo patterns.
 func (m *MetadataExtractor) detectMonorepo() bool {
 	// Check for workspaces in package.json
@@ -1006,6 +1450,59 @@ func (m *MetadataExtractor) extractVersionFromFile(filename string) string {
 	return strings.TrimSpace(string(content))
 }
 
+// readTOML parses a TOML file in the project root. It reports false if the
+// file cannot be read or is not valid TOML.
+func (m *MetadataExtractor) readTOML(name string) (map[string]any, bool) {
+	filePath := filepath.Join(m.root, name)
+	if !m.isPathWithinRoot(filePath) {
+		return nil, false // path outside root
+	}
+	var doc map[string]any
+	if _, err := toml.DecodeFile(filePath, &doc); err != nil {
+		return nil, false
+	}
+	return doc, true
+}
+
+// tomlTable returns the table stored under key, or nil if there is none.
+func tomlTable(table map[string]any, key string) map[string]any {
+	v, _ := table[key].(map[string]any)
+	return v
+}
+
+// tomlString returns the string stored under key, or "" if there is none.
+func tomlString(table map[string]any, key string) string {
+	v, _ := table[key].(string)
+	return v
+}
+
+// tomlStrings returns the string elements of the array stored under key.
+func tomlStrings(table map[string]any, key string) []string {
+	values, _ := table[key].([]any)
+	var out []string
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// setIfEmpty sets *dst to value unless *dst is already set.
+func setIfEmpty(dst *string, value string) {
+	if *dst == "" {
+		*dst = value
+	}
+}
+
+// setExtraIfEmpty sets extra[key] to value unless value is empty or the key
+// is already set.
+func setExtraIfEmpty(extra map[string]string, key, value string) {
+	if value != "" && extra[key] == "" {
+		extra[key] = value
+	}
+}
+
 // fileExists checks if a file exists in the project root.
 func (m *MetadataExtractor) fileExists(name string) bool {
 	path := filepath.Join(m.root, name)
@@ -3,6 +3,7 @@ package inspector
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -431,6 +432,226 @@ archivesBaseName = 'gradle-app'
 	}
 }
 
+func TestMetadataExtractor_ExtractDotNetMetadata(t *testing.T) {
+	tmpDir := t.TempDir()
+	csproj := `<Project Sdk="Microsoft.NET.Sdk.Web">
+  <PropertyGroup>
+    <TargetFramework>net8.0</TargetFramework>
+    <Nullable>enable</Nullable>
+  </PropertyGroup>
+  <PropertyGroup>
+    <AssemblyName>Acme.Orders</AssemblyName>
+    <Version>2.3.1</Version>
+    <Description>Order service</Description>
+    <PackageLicenseExpression>MIT</PackageLicenseExpression>
+    <PackageTags>orders;api</PackageTags>
+  </PropertyGroup>
+</Project>
+`
+	files := map[string]string{
+		"tests/Orders.Tests/Orders.Tests.csproj": `<Project Sdk="Microsoft.NET.Sdk"><PropertyGroup><AssemblyName>Orders.Tests</AssemblyName></PropertyGroup></Project>`,
+		"src/Orders/Orders.csproj":               csproj,
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	metadata, err := NewMetadataExtractor(tmpDir).Extract(DotNet)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	if metadata.Name != "Acme.Orders" {
+		t.Errorf("Name = %q, want %q", metadata.Name, "Acme.Orders")
+	}
+	if metadata.Version != "2.3.1" {
+		t.Errorf("Version = %q, want %q", metadata.Version, "2.3.1")
+	}
+	if metadata.Description != "Order service" {
+		t.Errorf("Description = %q, want %q", metadata.Description, "Order service")
+	}
+	if metadata.License != "MIT" {
+		t.Errorf("License = %q, want %q", metadata.License, "MIT")
+	}
+	if metadata.Extra["target_framework"] != "net8.0" {
+		t.Errorf("target_framework = %q, want %q", metadata.Extra["target_framework"], "net8.0")
+	}
+	if metadata.Extra["project_file"] != "src/Orders/Orders.csproj" {
+		t.Errorf("project_file = %q, want %q", metadata.Extra["project_file"], "src/Orders/Orders.csproj")
+	}
+	if len(metadata.Keywords) != 2 || metadata.Keywords[0] != "orders" || metadata.Keywords[1] != "api" {
+		t.Errorf("Keywords = %v, want [orders api]", metadata.Keywords)
+	}
+}
+
+func TestMetadataExtractor_ExtractDotNetMetadata_Defaults(t *testing.T) {
+	tmpDir := t.TempDir()
+	csproj := `<Project Sdk="Microsoft.NET.Sdk">
+  <PropertyGroup>
+    <TargetFrameworks>net6.0;net8.0</TargetFrameworks>
+  </PropertyGroup>
+</Project>
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "Cli.csproj"), []byte(csproj), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	metadata, err := NewMetadataExtractor(tmpDir).Extract(DotNet)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	// The assembly is named after the project file by default
+	if metadata.Name != "Cli" {
+		t.Errorf("Name = %q, want %q", metadata.Name, "Cli")
+	}
+	if metadata.Extra["target_framework"] != "net6.0;net8.0" {
+		t.Errorf("target_framework = %q, want %q", metadata.Extra["target_framework"], "net6.0;net8.0")
+	}
+}
+
+func TestMetadataExtractor_ExtractPHPMetadata(t *testing.T) {
+	tmpDir := t.TempDir()
+	composer := `{
+  "name": "acme/shop",
+  "description": "A small shop",
+  "type": "project",
+  "license": ["MIT", "Apache-2.0"],
+  "keywords": ["shop", "cart"],
+  "homepage": "https://github.com/acme/shop",
+  "authors": [
+    {"name": "Jane Doe", "email": "jane@example.com"},
+    {"name": "John Doe"}
+  ],
+  "require": {"php": "^8.2", "symfony/console": "^7.0"}
+}`
+	if err := os.WriteFile(filepath.Join(tmpDir, "composer.json"), []byte(composer), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	metadata, err := NewMetadataExtractor(tmpDir).Extract(PHP)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	if metadata.Name != "acme/shop" {
+		t.Errorf("Name = %q, want %q", metadata.Name, "acme/shop")
+	}
+	if metadata.Description != "A small shop" {
+		t.Errorf("Description = %q, want %q", metadata.Description, "A small shop")
+	}
+	if metadata.License != "MIT OR Apache-2.0" {
+		t.Errorf("License = %q, want %q", metadata.License, "MIT OR Apache-2.0")
+	}
+	if metadata.Author != "Jane Doe <jane@example.com>" {
+		t.Errorf("Author = %q, want %q", metadata.Author, "Jane Doe <jane@example.com>")
+	}
+	if metadata.Repository != "https://github.com/acme/shop" {
+		t.Errorf("Repository = %q, want %q", metadata.Repository, "https://github.com/acme/shop")
+	}
+	if len(metadata.Keywords) != 2 {
+		t.Errorf("Keywords = %v, want [shop cart]", metadata.Keywords)
+	}
+	if metadata.Extra["php_version"] != "^8.2" {
+		t.Errorf("php_version = %q, want %q", metadata.Extra["php_version"], "^8.2")
+	}
+	if metadata.Extra["type"] != "project" {
+		t.Errorf("type = %q, want %q", metadata.Extra["type"], "project")
+	}
+}
+
+func TestMetadataExtractor_ExtractSwiftMetadata(t *testing.T) {
+	tmpDir := t.TempDir()
+	manifest := `// swift-tools-version:5.9
+import PackageDescription
+
+let package = Package(
+    name: "Weather",
+    platforms: [.macOS(.v13)],
+    products: [
+        .library(name: "WeatherKit", targets: ["WeatherKit"]),
+    ],
+    targets: [
+        .target(name: "WeatherKit"),
+        .testTarget(name: "WeatherKitTests", dependencies: ["WeatherKit"]),
+    ]
+)
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "Package.swift"), []byte(manifest), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	metadata, err := NewMetadataExtractor(tmpDir).Extract(Swift)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	if metadata.Name != "Weather" {
+		t.Errorf("Name = %q, want %q", metadata.Name, "Weather")
+	}
+	if metadata.Extra["swift_tools_version"] != "5.9" {
+		t.Errorf("swift_tools_version = %q, want %q", metadata.Extra["swift_tools_version"], "5.9")
+	}
+}
+
+func TestMetadataExtractor_ExtractKotlinMetadata(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"settings.gradle.kts": `rootProject.name = "weather"` + "\n",
+		"build.gradle.kts": `plugins {
+    kotlin("jvm") version "2.0.0"
+}
+
+group = "com.acme"
+version = "1.4.0"
+`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	metadata, err := NewMetadataExtractor(tmpDir).Extract(Kotlin)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	if metadata.Name != "weather" {
+		t.Errorf("Name = %q, want %q", metadata.Name, "weather")
+	}
+	if metadata.Version != "1.4.0" {
+		t.Errorf("Version = %q, want %q", metadata.Version, "1.4.0")
+	}
+	if metadata.Extra["group"] != "com.acme" {
+		t.Errorf("group = %q, want %q", metadata.Extra["group"], "com.acme")
+	}
+	if metadata.Extra["kotlin_version"] != "2.0.0" {
+		t.Errorf("kotlin_version = %q, want %q", metadata.Extra["kotlin_version"], "2.0.0")
+	}
+}
+
+func TestMetadataExtractor_ExtractPHPMetadata_StringLicense(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "composer.json"), []byte(`{"name": "acme/lib", "license": "BSD-3-Clause"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	metadata, err := NewMetadataExtractor(tmpDir).Extract(PHP)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if metadata.License != "BSD-3-Clause" {
+		t.Errorf("License = %q, want %q", metadata.License, "BSD-3-Clause")
+	}
+}
+
 func TestMetadataExtractor_ExtractStructure_Go(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -887,6 +1108,120 @@ func TestMetadataExtractor_ExtractStructure_Java(t *testing.T) {
 	}
 }
 
+func TestMetadataExtractor_ExtractStructure_DotNet(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"Shop.sln":                                   "",
+		"global.json":                                "{}",
+		"src/Shop.Api/Shop.Api.csproj":               "<Project />",
+		"src/Shop.Api/Program.cs":                    "",
+		"src/Shop.Core/Shop.Core.csproj":             "<Project />",
+		"tests/Shop.Api.Tests/Shop.Api.Tests.csproj": "<Project />",
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	structure, err := NewMetadataExtractor(tmpDir).ExtractStructure(DotNet)
+	if err != nil {
+		t.Fatalf("ExtractStructure() error = %v", err)
+	}
+
+	if !sliceContains(structure.SourceDirs, "src") {
+		t.Errorf("SourceDirs should contain 'src', got %v", structure.SourceDirs)
+	}
+	if len(structure.TestDirs) != 1 || structure.TestDirs[0] != "tests" {
+		t.Errorf("TestDirs = %v, want [tests]", structure.TestDirs)
+	}
+	if len(structure.EntryPoints) != 1 || structure.EntryPoints[0] != "src/Shop.Api/Program.cs" {
+		t.Errorf("EntryPoints = %v, want [src/Shop.Api/Program.cs]", structure.EntryPoints)
+	}
+	if !sliceContains(structure.ConfigFiles, "global.json") {
+		t.Errorf("ConfigFiles should contain 'global.json', got %v", structure.ConfigFiles)
+	}
+}
+
+func TestMetadataExtractor_ExtractStructure_PHP(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"composer.json":      "{}",
+		"public/index.php":   "<?php",
+		"bin/console":        "#!/usr/bin/env php",
+		"src/Kernel.php":     "<?php",
+		"tests/CartTest.php": "<?php",
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	structure, err := NewMetadataExtractor(tmpDir).ExtractStructure(PHP)
+	if err != nil {
+		t.Fatalf("ExtractStructure() error = %v", err)
+	}
+
+	if !sliceContains(structure.EntryPoints, "public/index.php") || !sliceContains(structure.EntryPoints, "bin/console") {
+		t.Errorf("EntryPoints = %v, want public/index.php and bin/console", structure.EntryPoints)
+	}
+	if !sliceContains(structure.SourceDirs, "src") {
+		t.Errorf("SourceDirs should contain 'src', got %v", structure.SourceDirs)
+	}
+	if !sliceContains(structure.TestDirs, "tests") {
+		t.Errorf("TestDirs should contain 'tests', got %v", structure.TestDirs)
+	}
+	if !sliceContains(structure.ConfigFiles, "composer.json") {
+		t.Errorf("ConfigFiles should contain 'composer.json', got %v", structure.ConfigFiles)
+	}
+}
+
+func TestMetadataExtractor_ExtractStructure_Swift(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"Package.swift":                             "// swift-tools-version:5.9",
+		"Sources/weather/main.swift":                "print(\"hi\")",
+		"Sources/WeatherKit/Forecast.swift":         "struct Forecast {}",
+		"Tests/WeatherKitTests/ForecastTests.swift": "import XCTest",
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	structure, err := NewMetadataExtractor(tmpDir).ExtractStructure(Swift)
+	if err != nil {
+		t.Fatalf("ExtractStructure() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(structure.EntryPoints, []string{"Sources/weather/main.swift"}) {
+		t.Errorf("EntryPoints = %v, want [Sources/weather/main.swift]", structure.EntryPoints)
+	}
+	if !sliceContains(structure.SourceDirs, "Sources") || !sliceContains(structure.TestDirs, "Tests") {
+		t.Errorf("expected Sources and Tests, got source dirs %v and test dirs %v", structure.SourceDirs, structure.TestDirs)
+	}
+	if structure.BuildOutputDir != ".build" {
+		t.Errorf("BuildOutputDir = %q, want .build", structure.BuildOutputDir)
+	}
+	if !sliceContains(structure.ConfigFiles, "Package.swift") {
+		t.Errorf("ConfigFiles should contain 'Package.swift', got %v", structure.ConfigFiles)
+	}
+}
+
 func TestMetadataExtractor_ExtractStructure_Java_Gradle(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -1053,6 +1388,78 @@ func TestMetadataExtractor_ExtractNodeMetadata_InvalidJSON(t *testing.T) {
 	}
 }
 
+func TestMetadataExtractor_ExtractStructure_GoWorkspace(t *testing.T) {
+	tests := []struct {
+		name        string
+		goWork      string
+		wantModules []string
+		wantMono    bool
+	}{
+		{
+			name: "two modules in a use block",
+			goWork: `go 1.22
+
+use (
+	./service-a
+	./service-b // billing
+)
+`,
+			wantModules: []string{"service-a", "service-b"},
+			wantMono:    true,
+		},
+		{
+			name: "single-line use directives",
+			goWork: `go 1.22
+
+use .
+use "./tools"
+`,
+			wantModules: []string{".", "tools"},
+			wantMono:    true,
+		},
+		{
+			name:        "single module",
+			goWork:      "go 1.22\n\nuse ./app\n",
+			wantModules: []string{"app"},
+			wantMono:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tmpDir, "go.work"), []byte(tt.goWork), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			structure, err := NewMetadataExtractor(tmpDir).ExtractStructure(Go)
+			if err != nil {
+				t.Fatalf("ExtractStructure() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(structure.WorkspaceModules, tt.wantModules) {
+				t.Errorf("WorkspaceModules = %v, want %v", structure.WorkspaceModules, tt.wantModules)
+			}
+			if structure.HasMonorepo != tt.wantMono {
+				t.Errorf("HasMonorepo = %v, want %v", structure.HasMonorepo, tt.wantMono)
+			}
+			if !sliceContains(structure.ConfigFiles, "go.work") {
+				t.Errorf("ConfigFiles should contain 'go.work', got %v", structure.ConfigFiles)
+			}
+		})
+	}
+
+	t.Run("no go.work", func(t *testing.T) {
+		structure, err := NewMetadataExtractor(t.TempDir()).ExtractStructure(Go)
+		if err != nil {
+			t.Fatalf("ExtractStructure() error = %v", err)
+		}
+		if len(structure.WorkspaceModules) != 0 {
+			t.Errorf("WorkspaceModules = %v, want empty", structure.WorkspaceModules)
+		}
+	})
+}
+
 func TestMetadataExtractor_ExtractNodeStructure_WithBin(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -1084,6 +1491,58 @@ func TestMetadataExtractor_ExtractNodeStructure_WithBin(t *testing.T) {
 	if !sliceContains(structure.TestDirs, "__tests__") {
 		t.Errorf("TestDirs should contain '__tests__', got %v", structure.TestDirs)
 	}
+
+	// The bin script is recorded even though bin/cli.js does not exist
+	if !sliceContains(structure.EntryPoints, "bin/cli.js") {
+		t.Errorf("EntryPoints should contain 'bin/cli.js', got %v", structure.EntryPoints)
+	}
+}
+
+func TestMetadataExtractor_ExtractNodeStructure_BinForms(t *testing.T) {
+	tests := []struct {
+		name        string
+		packageJSON string
+		want        []string
+	}{
+		{
+			name:        "string form",
+			packageJSON: `{"name":"tool","bin":"./cli.js"}`,
+			want:        []string{"cli.js"},
+		},
+		{
+			name:        "object form ordered by command name",
+			packageJSON: `{"name":"tool","bin":{"tool":"bin/tool.js","tool-admin":"bin/admin.js"}}`,
+			want:        []string{"bin/tool.js", "bin/admin.js"},
+		},
+		{
+			name:        "deduped against main and module",
+			packageJSON: `{"name":"tool","main":"lib/index.js","module":"lib/index.mjs","bin":{"a":"./lib/index.js","b":"lib/index.mjs","c":"lib/index.js"}}`,
+			want:        []string{"lib/index.js", "lib/index.mjs"},
+		},
+		{
+			name:        "invalid bin values are ignored",
+			packageJSON: `{"name":"tool","bin":{"a":42,"b":""}}`,
+			want:        []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tmpDir, "package.json"), []byte(tt.packageJSON), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			structure, err := NewMetadataExtractor(tmpDir).ExtractStructure(Node)
+			if err != nil {
+				t.Fatalf("ExtractStructure() error = %v", err)
+			}
+
+			if len(structure.EntryPoints) != len(tt.want) || (len(tt.want) > 0 && !reflect.DeepEqual(structure.EntryPoints, tt.want)) {
+				t.Errorf("EntryPoints = %v, want %v", structure.EntryPoints, tt.want)
+			}
+		})
+	}
 }
 
 func TestMetadataExtractor_ExtractPythonStructure_TestDir(t *testing.T) {
@@ -1712,10 +2171,8 @@ requires = ["setuptools"]
 	}
 }
 
-func TestMetadataExtractor_ExtractRustMetadata_MultiplePackageSections(t *testing.T) {
-	// Test boundary: section detection when re-entering [package] section
-	// Note: The code sets inPackageSection=true whenever [package] is found,
-	// so later fields override earlier ones within the scope
+func TestMetadataExtractor_ExtractRustMetadata_DuplicatePackageSection(t *testing.T) {
+	// A repeated [package] table is invalid TOML, so nothing is extracted
 	tmpDir := t.TempDir()
 	cargoToml := `[package]
 name = "rust-pkg"
@@ -1725,7 +2182,6 @@ version = "0.1.0"
 tokio = "1.0"
 
 [package]
-# This DOES get processed (test that we can re-enter package section)
 name = "override"
 `
 	if err := os.WriteFile(filepath.Join(tmpDir, "Cargo.toml"), []byte(cargoToml), 0644); err != nil {
@@ -1738,9 +2194,280 @@ name = "override"
 		t.Fatalf("Extract() error = %v", err)
 	}
 
-	// The code processes all [package] sections, so the later value overrides
-	if metadata.Name != "override" {
-		t.Errorf("Name = %q, want %q (later [package] section overrides)", metadata.Name, "override")
+	if metadata.Name != "" || metadata.Version != "" {
+		t.Errorf("expected empty metadata for invalid Cargo.toml, got name=%q version=%q", metadata.Name, metadata.Version)
+	}
+	if metadata.Extra == nil {
+		t.Error("Extra should be initialized")
+	}
+}
+
+func TestMetadataExtractor_ExtractPythonMetadata_TOMLSyntax(t *testing.T) {
+	tests := []struct {
+		name        string
+		pyproject   string
+		wantName    string
+		wantVersion string
+		wantDesc    string
+		wantAuthor  string
+		wantLicense string
+		wantPython  string
+	}{
+		{
+			name: "inline table authors",
+			pyproject: `[project]
+name = "inline-authors"
+version = "1.2.3"
+authors = [{ name = "Ada Lovelace", email = "ada@example.com" }, { name = "Charles Babbage" }]
+license = { text = "MIT" }
+`,
+			wantName:    "inline-authors",
+			wantVersion: "1.2.3",
+			wantAuthor:  "Ada Lovelace",
+			wantLicense: "MIT",
+		},
+		{
+			name: "author with email only",
+			pyproject: `[project]
+name = "email-author"
+authors = [
+    { email = "team@example.com" },
+]
+`,
+			wantName:   "email-author",
+			wantAuthor: "team@example.com",
+		},
+		{
+			name: "multi-line dependency array before metadata",
+			pyproject: `[project]
+dependencies = [
+    "requests>=2.0",
+    "rich[jupyter]",
+]
+name = "multi-line-deps"
+version = "0.4.0"
+requires-python = ">=3.11"
+`,
+			wantName:    "multi-line-deps",
+			wantVersion: "0.4.0",
+			wantPython:  ">=3.11",
+		},
+		{
+			name: "comments and special characters in values",
+			pyproject: `[project]  # PEP 621 metadata
+name = "hash-pkg" # the distribution name
+version = "2.0.0"   # bumped by release tooling
+description = "Handles # and ] in strings"
+`,
+			wantName:    "hash-pkg",
+			wantVersion: "2.0.0",
+			wantDesc:    "Handles # and ] in strings",
+		},
+		{
+			name: "project table takes precedence over poetry",
+			pyproject: `[tool.poetry]
+name = "poetry-name"
+version = "9.9.9"
+authors = ["Poetry Author <poetry@example.com>"]
+
+[project]
+name = "pep621-name"
+`,
+			wantName:    "pep621-name",
+			wantVersion: "9.9.9",
+			wantAuthor:  "Poetry Author <poetry@example.com>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tmpDir, "pyproject.toml"), []byte(tt.pyproject), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			metadata, err := NewMetadataExtractor(tmpDir).Extract(Python)
+			if err != nil {
+				t.Fatalf("Extract() error = %v", err)
+			}
+
+			if metadata.Name != tt.wantName {
+				t.Errorf("Name = %q, want %q", metadata.Name, tt.wantName)
+			}
+			if metadata.Version != tt.wantVersion {
+				t.Errorf("Version = %q, want %q", metadata.Version, tt.wantVersion)
+			}
+			if metadata.Description != tt.wantDesc {
+				t.Errorf("Description = %q, want %q", metadata.Description, tt.wantDesc)
+			}
+			if metadata.Author != tt.wantAuthor {
+				t.Errorf("Author = %q, want %q", metadata.Author, tt.wantAuthor)
+			}
+			if metadata.License != tt.wantLicense {
+				t.Errorf("License = %q, want %q", metadata.License, tt.wantLicense)
+			}
+			if metadata.Extra["python_version"] != tt.wantPython {
+				t.Errorf("python_version = %q, want %q", metadata.Extra["python_version"], tt.wantPython)
+			}
+		})
+	}
+}
+
+func TestMetadataExtractor_ExtractPythonMetadata_KeywordsAndURLs(t *testing.T) {
+	tests := []struct {
+		name         string
+		pyproject    string
+		wantKeywords []string
+		wantRepo     string
+		wantHomepage string
+		wantDocsURL  string
+	}{
+		{
+			name: "PEP 621 urls table",
+			pyproject: `[project]
+name = "pep621"
+keywords = [
+    "lint",
+    "quality",
+]
+
+[project.urls]
+Homepage = "https://pep621.example.com"
+Documentation = "https://docs.pep621.example.com"
+Repository = "https://github.com/example/pep621"
+`,
+			wantKeywords: []string{"lint", "quality"},
+			wantRepo:     "https://github.com/example/pep621",
+			wantHomepage: "https://pep621.example.com",
+			wantDocsURL:  "https://docs.pep621.example.com",
+		},
+		{
+			name: "PEP 621 source label",
+			pyproject: `[project]
+name = "pep621-source"
+urls = { "Source Code" = "https://gitlab.com/example/source" }
+`,
+			wantRepo: "https://gitlab.com/example/source",
+		},
+		{
+			name: "poetry fields",
+			pyproject: `[tool.poetry]
+name = "poetry-pkg"
+keywords = ["cli", "packaging"]
+repository = "https://github.com/example/poetry-pkg"
+homepage = "https://poetry-pkg.example.com"
+documentation = "https://poetry-pkg.readthedocs.io"
+`,
+			wantKeywords: []string{"cli", "packaging"},
+			wantRepo:     "https://github.com/example/poetry-pkg",
+			wantHomepage: "https://poetry-pkg.example.com",
+			wantDocsURL:  "https://poetry-pkg.readthedocs.io",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tmpDir, "pyproject.toml"), []byte(tt.pyproject), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			metadata, err := NewMetadataExtractor(tmpDir).Extract(Python)
+			if err != nil {
+				t.Fatalf("Extract() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(metadata.Keywords, tt.wantKeywords) {
+				t.Errorf("Keywords = %v, want %v", metadata.Keywords, tt.wantKeywords)
+			}
+			if metadata.Repository != tt.wantRepo {
+				t.Errorf("Repository = %q, want %q", metadata.Repository, tt.wantRepo)
+			}
+			if metadata.Extra["homepage"] != tt.wantHomepage {
+				t.Errorf("homepage = %q, want %q", metadata.Extra["homepage"], tt.wantHomepage)
+			}
+			if metadata.Extra["documentation"] != tt.wantDocsURL {
+				t.Errorf("documentation = %q, want %q", metadata.Extra["documentation"], tt.wantDocsURL)
+			}
+		})
+	}
+}
+
+func TestMetadataExtractor_ExtractRustMetadata_KeywordsAndURLs(t *testing.T) {
+	tmpDir := t.TempDir()
+	cargoToml := `[package]
+name = "url-crate"
+version = "0.1.0"
+keywords = ["cli", "lint", "ci"]
+repository = "https://github.com/example/url-crate"
+homepage = "https://url-crate.example.com"
+documentation = "https://docs.rs/url-crate"
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "Cargo.toml"), []byte(cargoToml), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	metadata, err := NewMetadataExtractor(tmpDir).Extract(Rust)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	if want := []string{"cli", "lint", "ci"}; !reflect.DeepEqual(metadata.Keywords, want) {
+		t.Errorf("Keywords = %v, want %v", metadata.Keywords, want)
+	}
+	if metadata.Repository != "https://github.com/example/url-crate" {
+		t.Errorf("Repository = %q, want %q", metadata.Repository, "https://github.com/example/url-crate")
+	}
+	if metadata.Extra["homepage"] != "https://url-crate.example.com" {
+		t.Errorf("homepage = %q, want %q", metadata.Extra["homepage"], "https://url-crate.example.com")
+	}
+	if metadata.Extra["documentation"] != "https://docs.rs/url-crate" {
+		t.Errorf("documentation = %q, want %q", metadata.Extra["documentation"], "https://docs.rs/url-crate")
+	}
+}
+
+func TestMetadataExtractor_ExtractRustMetadata_TOMLSyntax(t *testing.T) {
+	tmpDir := t.TempDir()
+	cargoToml := `[package]
+name = "tricky-crate" # crate name
+version.workspace = true
+authors = [
+    "Ferris <ferris@example.com>",
+]
+description = """
+A crate with ] and # in its description"""
+license = "MIT OR Apache-2.0"
+edition = "2021"
+
+[package.metadata.docs.rs]
+all-features = true
+
+[dependencies]
+serde = { version = "1.0", features = ["derive"] }
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "Cargo.toml"), []byte(cargoToml), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	metadata, err := NewMetadataExtractor(tmpDir).Extract(Rust)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	if metadata.Name != "tricky-crate" {
+		t.Errorf("Name = %q, want %q", metadata.Name, "tricky-crate")
+	}
+	if metadata.Version != "" {
+		t.Errorf("Version = %q, want empty for workspace-inherited version", metadata.Version)
+	}
+	if metadata.Description != "A crate with ] and # in its description" {
+		t.Errorf("Description = %q", metadata.Description)
+	}
+	if metadata.License != "MIT OR Apache-2.0" {
+		t.Errorf("License = %q, want %q", metadata.License, "MIT OR Apache-2.0")
+	}
+	if metadata.Extra["rust_edition"] != "2021" {
+		t.Errorf("rust_edition = %q, want %q", metadata.Extra["rust_edition"], "2021")
 	}
 }
 
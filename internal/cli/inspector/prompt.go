@@ -3,11 +3,61 @@
 package inspector
 
 import (
+	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/vibeguard/vibeguard/internal/cli/assist"
 )
 
+// ProjectContext is the project information configured prompts can
+// reference as template fields, e.g. {{.Type}} or {{.Tools}}.
+type ProjectContext struct {
+	Name        string      // Project name from its manifest
+	Type        ProjectType // Primary project type
+	Version     string      // Project version from its manifest
+	Description string      // Project description from its manifest
+	Tools       ToolNames   // Detected tools
+}
+
+// ToolNames is a list of tool names. It prints as a comma-separated list.
+type ToolNames []string
+
+// String joins the names with commas.
+func (t ToolNames) String() string {
+	return strings.Join(t, ", ")
+}
+
+// InspectContext detects the type, metadata and tools of the project at root.
+func InspectContext(root string) (*ProjectContext, error) {
+	detection, err := NewDetector(root).DetectPrimary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect project type: %w", err)
+	}
+
+	metadata, err := NewMetadataExtractor(root).Extract(detection.Type)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract project metadata: %w", err)
+	}
+
+	tools, err := NewToolScanner(root).ScanForProjectType(detection.Type)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan tools: %w", err)
+	}
+	names := make(ToolNames, 0, len(tools))
+	for _, tool := range tools {
+		names = append(names, tool.Name)
+	}
+
+	return &ProjectContext{
+		Name:        metadata.Name,
+		Type:        detection.Type,
+		Version:     metadata.Version,
+		Description: metadata.Description,
+		Tools:       names,
+	}, nil
+}
+
 // GenerateSetupPrompt creates a Claude Code-friendly setup prompt based on inspection results.
 // This function uses the assist.Composer to generate the prompt.
 func GenerateSetupPrompt(
@@ -67,6 +117,7 @@ func convertToAssistRecommendations(recs []CheckRecommendation) []assist.CheckRe
 			Rationale:   r.Rationale,
 			Command:     r.Command,
 			File:        r.File,
+			Coverage:    r.Coverage,
 			Grok:        r.Grok,
 			Assert:      r.Assert,
 			Severity:    r.Severity,
@@ -5,6 +5,7 @@ package inspector
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -82,3 +83,25 @@ func TestGenerateSetupPrompt(t *testing.T) {
 		t.Logf("Prompt written to: %s", promptFile)
 	}
 }
+
+func TestInspectContext(t *testing.T) {
+	root := createTestProject(t, map[string]string{
+		"package.json":   `{"name": "shop", "version": "1.2.0", "description": "A shop"}`,
+		".eslintrc.json": "{}",
+	}, nil)
+
+	project, err := InspectContext(root)
+	if err != nil {
+		t.Fatalf("InspectContext() error = %v", err)
+	}
+
+	if project.Type != Node || project.Name != "shop" || project.Version != "1.2.0" || project.Description != "A shop" {
+		t.Errorf("unexpected project context: %+v", project)
+	}
+	if !slices.Contains(project.Tools, "eslint") {
+		t.Errorf("expected eslint in tools, got %v", project.Tools)
+	}
+	if got := (ToolNames{"eslint", "prettier"}).String(); got != "eslint, prettier" {
+		t.Errorf("ToolNames.String() = %q, want %q", got, "eslint, prettier")
+	}
+}
@@ -2,6 +2,8 @@
 // for the AI agent-assisted setup feature.
 package inspector
 
+import "strings"
+
 // CheckRecommendation represents a suggested check based on detected tools.
 type CheckRecommendation struct {
 	ID          string   // Unique check identifier
@@ -9,6 +11,7 @@ type CheckRecommendation struct {
 	Rationale   string   // Why this check is recommended
 	Command     string   // Shell command to execute
 	File        string   // File to read output from instead of command stdout
+	Coverage    string   // Coverage report format read into the coverage variable
 	Grok        []string // Optional grok patterns for output extraction
 	Assert      string   // Optional assertion expression
 	Severity    string   // "error" or "warning"
@@ -52,6 +55,10 @@ func (r *Recommender) Recommend() []CheckRecommendation {
 	projectRecs := r.projectTypeRecommendations()
 	recommendations = append(recommendations, projectRecs...)
 
+	// Fall back to the project's build runner for build and test checks no
+	// language-native tool provides
+	recommendations = append(recommendations, r.buildRunnerRecommendations(recommendations)...)
+
 	// Sort by priority
 	sortRecommendations(recommendations)
 
@@ -84,6 +91,12 @@ func (r *Recommender) recommendationsForTool(tool ToolInfo) []CheckRecommendatio
 		return r.goTestRecommendations(tool)
 	case "goimports":
 		return r.goimportsRecommendations(tool)
+	case "go build":
+		return r.goBuildRecommendations(tool)
+	case "govulncheck":
+		return r.govulncheckRecommendations(tool)
+	case "gosec":
+		return r.gosecRecommendations(tool)
 
 	// Node.js tools
 	case "eslint":
@@ -119,6 +132,40 @@ func (r *Recommender) recommendationsForTool(tool ToolInfo) []CheckRecommendatio
 	case "pip-audit":
 		return r.pipAuditRecommendations(tool)
 
+	// .NET tools
+	case "dotnet format":
+		return r.dotnetFormatRecommendations(tool)
+	case "dotnet test":
+		return r.dotnetTestRecommendations(tool)
+	case "dotnet analyzers":
+		return r.dotnetAnalyzersRecommendations(tool)
+
+	// PHP tools
+	case "php-cs-fixer":
+		return r.phpCSFixerRecommendations(tool)
+	case "phpstan":
+		return r.phpstanRecommendations(tool)
+	case "phpunit":
+		return r.phpunitRecommendations(tool)
+
+	// Swift tools
+	case "swiftlint":
+		return r.swiftlintRecommendations(tool)
+	case "swift test":
+		return r.swiftTestRecommendations(tool)
+
+	// Kotlin tools
+	case "ktlint":
+		return r.ktlintRecommendations(tool)
+	case "detekt":
+		return r.detektRecommendations(tool)
+
+	// Container tools
+	case "hadolint":
+		return r.hadolintRecommendations(tool)
+	case "docker compose":
+		return r.composeRecommendations(tool)
+
 	// Git hooks
 	case "pre-commit":
 		return r.precommitRecommendations(tool)
@@ -141,6 +188,12 @@ func (r *Recommender) projectTypeRecommendations() []CheckRecommendation {
 		return r.nodeProjectRecommendations()
 	case Python:
 		return r.pythonProjectRecommendations()
+	case DotNet:
+		return r.dotnetProjectRecommendations()
+	case Swift:
+		return r.swiftProjectRecommendations()
+	case Kotlin:
+		return r.kotlinProjectRecommendations()
 	default:
 		return nil
 	}
@@ -213,7 +266,8 @@ func (r *Recommender) goTestRecommendations(tool ToolInfo) []CheckRecommendation
 			ID:          "coverage",
 			Description: "Check test coverage meets minimum threshold",
 			Rationale:   "Code coverage helps identify untested code paths",
-			Command:     "go test -cover ./... 2>&1 | tail -1",
+			Command:     "go test -coverprofile=cover.out ./... 2>&1 | tail -1",
+			Coverage:    "go",
 			Grok:        []string{"coverage: %{NUMBER:coverage}%"},
 			Assert:      "coverage >= 70",
 			Severity:    "warning",
@@ -242,6 +296,59 @@ func (r *Recommender) goimportsRecommendations(tool ToolInfo) []CheckRecommendat
 	}
 }
 
+func (r *Recommender) goBuildRecommendations(tool ToolInfo) []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "build",
+			Description: "Verify Go code compiles successfully",
+			Rationale:   "Catch compilation errors before they reach CI",
+			Command:     "go build ./...",
+			Severity:    "error",
+			Suggestion:  "Fix compilation errors before committing.",
+			Category:    "build",
+			Tool:        "go build",
+			Priority:    5,
+		},
+	}
+}
+
+func (r *Recommender) govulncheckRecommendations(tool ToolInfo) []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "security",
+			Description: "Check for known vulnerabilities in Go dependencies",
+			Rationale:   "govulncheck reports vulnerabilities from the Go vulnerability database that your code actually calls",
+			Command:     "govulncheck ./...",
+			Severity:    "warning",
+			Suggestion:  "Upgrade the affected modules to a fixed version. Install govulncheck with 'go install golang.org/x/vuln/cmd/govulncheck@latest'.",
+			Category:    "security",
+			Tool:        "govulncheck",
+			Priority:    50,
+		},
+	}
+}
+
+func (r *Recommender) gosecRecommendations(tool ToolInfo) []CheckRecommendation {
+	command := "gosec ./..."
+	if tool.ConfigFile != "" {
+		// gosec does not load its config file automatically
+		command = "gosec -conf " + tool.ConfigFile + " ./..."
+	}
+	return []CheckRecommendation{
+		{
+			ID:          "gosec",
+			Description: "Scan Go code for security problems with gosec",
+			Rationale:   "gosec finds insecure patterns such as hardcoded credentials, SQL injection and weak crypto",
+			Command:     command,
+			Severity:    "warning",
+			Suggestion:  "Fix the security issues reported above, or annotate false positives with '#nosec' and a justification.",
+			Category:    "security",
+			Tool:        "gosec",
+			Priority:    55,
+		},
+	}
+}
+
 // Node.js tool recommendations
 
 func (r *Recommender) eslintRecommendations(tool ToolInfo) []CheckRecommendation {
@@ -293,7 +400,8 @@ func (r *Recommender) jestRecommendations(tool ToolInfo) []CheckRecommendation {
 			ID:          "coverage",
 			Description: "Check test coverage meets minimum threshold",
 			Rationale:   "Code coverage helps identify untested code paths",
-			Command:     "npx jest --coverage --coverageReporters=text-summary 2>&1 | grep 'Lines'",
+			Command:     "npx jest --coverage --coverageReporters=json-summary --coverageReporters=text-summary 2>&1 | grep 'Lines'",
+			Coverage:    "jest",
 			Grok:        []string{"Lines\\s*:\\s*%{NUMBER:coverage}%"},
 			Assert:      "coverage >= 70",
 			Severity:    "warning",
@@ -421,7 +529,8 @@ func (r *Recommender) pytestRecommendations(tool ToolInfo) []CheckRecommendation
 			ID:          "coverage",
 			Description: "Check test coverage meets minimum threshold",
 			Rationale:   "Code coverage helps identify untested code paths",
-			Command:     "pytest --cov --cov-report=term-missing 2>&1 | grep 'TOTAL'",
+			Command:     "pytest --cov --cov-report=json --cov-report=term-missing 2>&1 | grep 'TOTAL'",
+			Coverage:    "pytest",
 			Grok:        []string{"TOTAL\\s+\\d+\\s+\\d+\\s+%{NUMBER:coverage}%"},
 			Assert:      "coverage >= 70",
 			Severity:    "warning",
@@ -514,6 +623,300 @@ func (r *Recommender) pipAuditRecommendations(tool ToolInfo) []CheckRecommendati
 	}
 }
 
+// .NET tool recommendations
+
+func (r *Recommender) dotnetFormatRecommendations(tool ToolInfo) []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "fmt",
+			Description: "Check .NET code formatting with dotnet format",
+			Rationale:   "dotnet format applies the .editorconfig style rules, keeping formatting consistent",
+			Command:     "dotnet format --verify-no-changes",
+			Severity:    "error",
+			Suggestion:  "Run 'dotnet format' to format your code.",
+			Category:    "format",
+			Tool:        "dotnet format",
+			Priority:    10,
+		},
+	}
+}
+
+func (r *Recommender) dotnetTestRecommendations(tool ToolInfo) []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "test",
+			Description: "Run .NET tests",
+			Rationale:   "Tests verify that code behaves as expected",
+			Command:     "dotnet test --nologo",
+			Severity:    "error",
+			Suggestion:  "Fix failing tests before committing.",
+			Category:    "test",
+			Tool:        "dotnet test",
+			Priority:    30,
+		},
+	}
+}
+
+func (r *Recommender) dotnetAnalyzersRecommendations(tool ToolInfo) []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "lint",
+			Description: "Check for analyzer diagnostics with dotnet format",
+			Rationale:   "Roslyn analyzers configured in .editorconfig catch bugs and style issues at build time",
+			Command:     "dotnet format analyzers --verify-no-changes --severity warn",
+			Severity:    "error",
+			Suggestion:  "Fix the analyzer diagnostics reported above. Run 'dotnet format analyzers' to apply available code fixes.",
+			Category:    "lint",
+			Tool:        "dotnet analyzers",
+			Priority:    20,
+		},
+	}
+}
+
+// PHP tool recommendations
+
+func (r *Recommender) phpCSFixerRecommendations(tool ToolInfo) []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "fmt",
+			Description: "Check PHP code style with PHP-CS-Fixer",
+			Rationale:   "PHP-CS-Fixer enforces a consistent coding standard such as PSR-12",
+			Command:     "vendor/bin/php-cs-fixer fix --dry-run --diff",
+			Severity:    "error",
+			Suggestion:  "Run 'vendor/bin/php-cs-fixer fix' to fix code style issues.",
+			Category:    "format",
+			Tool:        "php-cs-fixer",
+			Priority:    10,
+		},
+	}
+}
+
+func (r *Recommender) phpstanRecommendations(tool ToolInfo) []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "lint",
+			Description: "Run PHPStan static analysis",
+			Rationale:   "PHPStan finds bugs and type errors without running the code",
+			Command:     "vendor/bin/phpstan analyse --no-progress",
+			Severity:    "error",
+			Suggestion:  "Fix the PHPStan errors. Consider adjusting the level in phpstan.neon for existing code.",
+			Category:    "lint",
+			Tool:        "phpstan",
+			Priority:    20,
+		},
+	}
+}
+
+func (r *Recommender) phpunitRecommendations(tool ToolInfo) []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "test",
+			Description: "Run PHPUnit tests",
+			Rationale:   "Tests verify that code behaves as expected",
+			Command:     "vendor/bin/phpunit",
+			Severity:    "error",
+			Suggestion:  "Fix failing tests before committing.",
+			Category:    "test",
+			Tool:        "phpunit",
+			Priority:    30,
+		},
+	}
+}
+
+// Swift tool recommendations
+
+func (r *Recommender) swiftlintRecommendations(tool ToolInfo) []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "lint",
+			Description: "Run SwiftLint to check Swift style and conventions",
+			Rationale:   "SwiftLint enforces the Swift style guide and catches common mistakes",
+			Command:     "swiftlint lint --strict --quiet",
+			Severity:    "error",
+			Suggestion:  "Fix the SwiftLint violations reported above. Run 'swiftlint --fix' to correct some automatically.",
+			Category:    "lint",
+			Tool:        "swiftlint",
+			Priority:    20,
+		},
+	}
+}
+
+func (r *Recommender) swiftTestRecommendations(tool ToolInfo) []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "test",
+			Description: "Run Swift package tests",
+			Rationale:   "Tests verify that code behaves as expected",
+			Command:     "swift test",
+			Severity:    "error",
+			Suggestion:  "Fix failing tests before committing.",
+			Category:    "test",
+			Tool:        "swift test",
+			Priority:    30,
+		},
+	}
+}
+
+// Kotlin tool recommendations
+
+// isGradleBuildFile reports whether a tool's ConfigFile is the Gradle build
+// script, meaning the tool is applied as a Gradle plugin.
+func isGradleBuildFile(configFile string) bool {
+	return configFile == "build.gradle.kts" || configFile == "build.gradle"
+}
+
+func (r *Recommender) ktlintRecommendations(tool ToolInfo) []CheckRecommendation {
+	command, fix := "ktlint", "ktlint --format"
+	if isGradleBuildFile(tool.ConfigFile) {
+		command, fix = "./gradlew ktlintCheck", "./gradlew ktlintFormat"
+	}
+	return []CheckRecommendation{
+		{
+			ID:          "fmt",
+			Description: "Check Kotlin code style with ktlint",
+			Rationale:   "ktlint enforces the Kotlin coding conventions, keeping formatting consistent",
+			Command:     command,
+			Severity:    "error",
+			Suggestion:  "Run '" + fix + "' to fix code style issues.",
+			Category:    "format",
+			Tool:        "ktlint",
+			Priority:    10,
+		},
+	}
+}
+
+func (r *Recommender) detektRecommendations(tool ToolInfo) []CheckRecommendation {
+	command := "detekt"
+	switch {
+	case isGradleBuildFile(tool.ConfigFile):
+		command = "./gradlew detekt"
+	case tool.ConfigFile != "":
+		// The detekt CLI does not load its config file automatically
+		command = "detekt --config " + tool.ConfigFile
+	}
+	return []CheckRecommendation{
+		{
+			ID:          "lint",
+			Description: "Run detekt static analysis",
+			Rationale:   "detekt finds code smells, complexity issues and potential bugs in Kotlin code",
+			Command:     command,
+			Severity:    "error",
+			Suggestion:  "Fix the detekt findings. Consider a baseline file for existing code.",
+			Category:    "lint",
+			Tool:        "detekt",
+			Priority:    20,
+		},
+	}
+}
+
+// Container tool recommendations
+
+func (r *Recommender) hadolintRecommendations(tool ToolInfo) []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "dockerfile-lint",
+			Description: "Lint Dockerfiles with hadolint",
+			Rationale:   "hadolint catches Dockerfile mistakes and shell issues in RUN instructions before images are built",
+			Command:     "hadolint " + strings.Join(r.dockerfiles(), " "),
+			Severity:    "warning",
+			Suggestion:  "Fix the Dockerfile issues reported above, or ignore specific rules in .hadolint.yaml.",
+			Category:    "lint",
+			Tool:        "hadolint",
+			Priority:    25,
+		},
+	}
+}
+
+func (r *Recommender) composeRecommendations(tool ToolInfo) []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "compose-config",
+			Description: "Validate the Docker Compose file",
+			Rationale:   "docker compose config catches syntax errors and invalid references before services are started",
+			Command:     "docker compose -f " + tool.ConfigFile + " config --quiet",
+			Severity:    "warning",
+			Suggestion:  "Run 'docker compose -f " + tool.ConfigFile + " config' to see the validation errors.",
+			Category:    "build",
+			Tool:        "docker compose",
+			Priority:    45,
+		},
+	}
+}
+
+// dockerfiles returns the Dockerfiles found by the scanner, or the default
+// Dockerfile if none were detected.
+func (r *Recommender) dockerfiles() []string {
+	for _, tool := range r.tools {
+		if tool.Name == "Dockerfile" && tool.Detected && len(tool.Indicators) > 0 {
+			return tool.Indicators
+		}
+	}
+	return []string{"Dockerfile"}
+}
+
+// Build runner recommendations
+
+// buildRunnerRecommendations returns build and test checks that call a
+// detected Makefile or justfile target or Bazel, for the checks recs doesn't
+// already have. The first detected runner providing a check wins.
+func (r *Recommender) buildRunnerRecommendations(recs []CheckRecommendation) []CheckRecommendation {
+	have := make(map[string]bool)
+	for _, rec := range recs {
+		have[rec.ID] = true
+	}
+
+	var runnerRecs []CheckRecommendation
+	add := func(rec CheckRecommendation) {
+		if !have[rec.ID] {
+			have[rec.ID] = true
+			runnerRecs = append(runnerRecs, rec)
+		}
+	}
+	for _, tool := range r.tools {
+		if !tool.Detected {
+			continue
+		}
+		switch tool.Name {
+		case "make build", "just build":
+			add(runnerBuildRecommendation(tool.Name, tool.Name))
+		case "make test", "just test":
+			add(runnerTestRecommendation(tool.Name, tool.Name))
+		case "bazel":
+			add(runnerBuildRecommendation("bazel build //...", tool.Name))
+			add(runnerTestRecommendation("bazel test //...", tool.Name))
+		}
+	}
+	return runnerRecs
+}
+
+func runnerBuildRecommendation(command, tool string) CheckRecommendation {
+	return CheckRecommendation{
+		ID:          "build",
+		Description: "Build the project with " + command,
+		Rationale:   "The project's build runner defines how it is built",
+		Command:     command,
+		Severity:    "error",
+		Suggestion:  "Fix build errors before committing.",
+		Category:    "build",
+		Tool:        tool,
+		Priority:    40,
+	}
+}
+
+func runnerTestRecommendation(command, tool string) CheckRecommendation {
+	return CheckRecommendation{
+		ID:          "test",
+		Description: "Run tests with " + command,
+		Rationale:   "No language-native test runner was detected, so tests run through the project's build runner",
+		Command:     command,
+		Severity:    "error",
+		Suggestion:  "Fix failing tests before committing.",
+		Category:    "test",
+		Tool:        tool,
+		Priority:    30,
+	}
+}
+
 // Git hooks tool recommendations (minimal - these are usually run manually)
 
 func (r *Recommender) precommitRecommendations(tool ToolInfo) []CheckRecommendation {
@@ -534,32 +937,9 @@ func (r *Recommender) lefthookRecommendations(tool ToolInfo) []CheckRecommendati
 // Project type specific recommendations (for tools not explicitly detected)
 
 func (r *Recommender) goProjectRecommendations() []CheckRecommendation {
-	var recs []CheckRecommendation
-
-	// Check if we already have these tools detected
-	hasGoBuild := false
-	for _, tool := range r.tools {
-		if tool.Name == "go build" && tool.Detected {
-			hasGoBuild = true
-		}
-	}
-
-	// Add build check if not already covered
-	if !hasGoBuild {
-		recs = append(recs, CheckRecommendation{
-			ID:          "build",
-			Description: "Verify Go code compiles successfully",
-			Rationale:   "Catch compilation errors before they reach CI",
-			Command:     "go build ./...",
-			Severity:    "error",
-			Suggestion:  "Fix compilation errors before committing.",
-			Category:    "build",
-			Tool:        "go build",
-			Priority:    5,
-		})
-	}
-
-	return recs
+	// The build check comes from the detected "go build" tool, like the
+	// other Go toolchain checks
+	return nil
 }
 
 func (r *Recommender) nodeProjectRecommendations() []CheckRecommendation {
@@ -596,6 +976,65 @@ func (r *Recommender) pythonProjectRecommendations() []CheckRecommendation {
 	return nil
 }
 
+func (r *Recommender) dotnetProjectRecommendations() []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "build",
+			Description: "Build the .NET solution",
+			Rationale:   "Verify the project compiles before running tests",
+			Command:     "dotnet build --nologo",
+			Severity:    "error",
+			Suggestion:  "Fix build errors before committing.",
+			Category:    "build",
+			Tool:        "dotnet",
+			Priority:    40,
+		},
+	}
+}
+
+func (r *Recommender) swiftProjectRecommendations() []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "build",
+			Description: "Build the Swift package",
+			Rationale:   "Verify the package compiles before running tests",
+			Command:     "swift build",
+			Severity:    "error",
+			Suggestion:  "Fix build errors before committing.",
+			Category:    "build",
+			Tool:        "swift",
+			Priority:    40,
+		},
+	}
+}
+
+func (r *Recommender) kotlinProjectRecommendations() []CheckRecommendation {
+	return []CheckRecommendation{
+		{
+			ID:          "test",
+			Description: "Run Kotlin tests with Gradle",
+			Rationale:   "Tests verify that code behaves as expected",
+			Command:     "./gradlew test",
+			Severity:    "error",
+			Suggestion:  "Fix failing tests before committing.",
+			Category:    "test",
+			Tool:        "gradle",
+			Priority:    30,
+		},
+		{
+			ID:          "build",
+			Description: "Build the Kotlin project with Gradle",
+			Rationale:   "Verify the project compiles",
+			Command:     "./gradlew assemble",
+			Severity:    "error",
+			Suggestion:  "Fix build errors before committing.",
+			Category:    "build",
+			Tool:        "gradle",
+			Priority:    40,
+		},
+	}
+}
+
 // sortRecommendations sorts recommendations by priority (lower = higher priority).
 func sortRecommendations(recs []CheckRecommendation) {
 	// Simple bubble sort for small lists
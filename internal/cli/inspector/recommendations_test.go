@@ -26,6 +26,7 @@ func TestRecommender_Recommend_GoProject(t *testing.T) {
 		{Name: "gofmt", Detected: true, Confidence: 1.0},
 		{Name: "go vet", Detected: true, Confidence: 1.0},
 		{Name: "go test", Detected: true, Confidence: 1.0},
+		{Name: "go build", Detected: true, Confidence: 1.0},
 	}
 
 	r := NewRecommender(Go, tools)
@@ -108,6 +109,131 @@ func TestRecommender_Recommend_PythonProject(t *testing.T) {
 	}
 }
 
+func TestRecommender_Recommend_DotNetProject(t *testing.T) {
+	tools := []ToolInfo{
+		{Name: "dotnet format", Detected: true, Confidence: 1.0},
+		{Name: "dotnet test", Detected: true, Confidence: 0.9},
+		{Name: "dotnet analyzers", Detected: true, Confidence: 0.85},
+	}
+
+	recs := NewRecommender(DotNet, tools).Recommend()
+
+	commands := make(map[string]string)
+	for _, rec := range recs {
+		commands[rec.ID] = rec.Command
+	}
+
+	expected := map[string]string{
+		"fmt":   "dotnet format --verify-no-changes",
+		"lint":  "dotnet format analyzers --verify-no-changes --severity warn",
+		"test":  "dotnet test --nologo",
+		"build": "dotnet build --nologo",
+	}
+	for id, command := range expected {
+		if commands[id] != command {
+			t.Errorf("expected %s command %q, got %q", id, command, commands[id])
+		}
+	}
+}
+
+func TestRecommender_Recommend_PHPProject(t *testing.T) {
+	tools := []ToolInfo{
+		{Name: "php-cs-fixer", Detected: true, Confidence: 0.9},
+		{Name: "phpstan", Detected: true, Confidence: 0.9},
+		{Name: "phpunit", Detected: true, Confidence: 0.9},
+	}
+
+	recs := NewRecommender(PHP, tools).Recommend()
+
+	commands := make(map[string]string)
+	for _, rec := range recs {
+		commands[rec.ID] = rec.Command
+	}
+
+	expected := map[string]string{
+		"fmt":  "vendor/bin/php-cs-fixer fix --dry-run --diff",
+		"lint": "vendor/bin/phpstan analyse --no-progress",
+		"test": "vendor/bin/phpunit",
+	}
+	for id, command := range expected {
+		if commands[id] != command {
+			t.Errorf("expected %s command %q, got %q", id, command, commands[id])
+		}
+	}
+}
+
+func TestRecommender_Recommend_SwiftProject(t *testing.T) {
+	tools := []ToolInfo{
+		{Name: "swiftlint", Detected: true, Confidence: 0.9},
+		{Name: "swift test", Detected: true, Confidence: 0.9},
+	}
+
+	recs := NewRecommender(Swift, tools).Recommend()
+
+	commands := make(map[string]string)
+	for _, rec := range recs {
+		commands[rec.ID] = rec.Command
+	}
+
+	expected := map[string]string{
+		"lint":  "swiftlint lint --strict --quiet",
+		"test":  "swift test",
+		"build": "swift build",
+	}
+	for id, command := range expected {
+		if commands[id] != command {
+			t.Errorf("expected %s command %q, got %q", id, command, commands[id])
+		}
+	}
+}
+
+func TestRecommender_Recommend_KotlinProject(t *testing.T) {
+	tests := []struct {
+		name     string
+		tools    []ToolInfo
+		expected map[string]string
+	}{
+		{
+			name: "Gradle plugins",
+			tools: []ToolInfo{
+				{Name: "ktlint", Detected: true, Confidence: 0.9, ConfigFile: "build.gradle.kts"},
+				{Name: "detekt", Detected: true, Confidence: 0.9, ConfigFile: "build.gradle.kts"},
+			},
+			expected: map[string]string{
+				"fmt":   "./gradlew ktlintCheck",
+				"lint":  "./gradlew detekt",
+				"test":  "./gradlew test",
+				"build": "./gradlew assemble",
+			},
+		},
+		{
+			name: "command line tools",
+			tools: []ToolInfo{
+				{Name: "ktlint", Detected: true, Confidence: 0.8, ConfigFile: ".editorconfig"},
+				{Name: "detekt", Detected: true, Confidence: 0.9, ConfigFile: "config/detekt/detekt.yml"},
+			},
+			expected: map[string]string{
+				"fmt":  "ktlint",
+				"lint": "detekt --config config/detekt/detekt.yml",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := make(map[string]string)
+			for _, rec := range NewRecommender(Kotlin, tt.tools).Recommend() {
+				commands[rec.ID] = rec.Command
+			}
+			for id, command := range tt.expected {
+				if commands[id] != command {
+					t.Errorf("expected %s command %q, got %q", id, command, commands[id])
+				}
+			}
+		})
+	}
+}
+
 func TestRecommender_Recommend_NoDetectedTools(t *testing.T) {
 	tools := []ToolInfo{
 		{Name: "eslint", Detected: false},
@@ -453,6 +579,117 @@ func TestRecommender_NpmAuditSecurity(t *testing.T) {
 	}
 }
 
+func TestRecommender_GoSecurityTools(t *testing.T) {
+	tools := []ToolInfo{
+		{Name: "govulncheck", Detected: true},
+		{Name: "gosec", Detected: true},
+	}
+
+	recs := NewRecommender(Go, tools).Recommend()
+
+	byTool := make(map[string]CheckRecommendation)
+	for _, rec := range recs {
+		byTool[rec.Tool] = rec
+	}
+
+	vuln, ok := byTool["govulncheck"]
+	if !ok {
+		t.Fatal("govulncheck recommendation not found")
+	}
+	if vuln.ID != "security" || vuln.Command != "govulncheck ./..." {
+		t.Errorf("unexpected govulncheck recommendation: id=%s command=%q", vuln.ID, vuln.Command)
+	}
+	if vuln.Priority != 50 {
+		t.Errorf("expected govulncheck priority 50, got %d", vuln.Priority)
+	}
+
+	gosec, ok := byTool["gosec"]
+	if !ok {
+		t.Fatal("gosec recommendation not found")
+	}
+	if gosec.ID != "gosec" || gosec.Command != "gosec ./..." {
+		t.Errorf("unexpected gosec recommendation: id=%s command=%q", gosec.ID, gosec.Command)
+	}
+
+	for _, rec := range []CheckRecommendation{vuln, gosec} {
+		if rec.Category != "security" {
+			t.Errorf("%s: expected category security, got %s", rec.Tool, rec.Category)
+		}
+		if rec.Severity != "warning" {
+			t.Errorf("%s: expected severity warning, got %s", rec.Tool, rec.Severity)
+		}
+		if rec.Suggestion == "" {
+			t.Errorf("%s: expected a suggestion", rec.Tool)
+		}
+	}
+}
+
+func TestRecommender_GosecWithConfig(t *testing.T) {
+	tools := []ToolInfo{
+		{Name: "gosec", Detected: true, ConfigFile: ".gosec.json"},
+	}
+
+	recs := NewRecommender(Go, tools).Recommend()
+	if len(recs) != 1 {
+		t.Fatalf("expected 1 recommendation, got %d", len(recs))
+	}
+	if want := "gosec -conf .gosec.json ./..."; recs[0].Command != want {
+		t.Errorf("expected command %q, got %q", want, recs[0].Command)
+	}
+}
+
+func TestRecommender_Hadolint(t *testing.T) {
+	tests := []struct {
+		name        string
+		tools       []ToolInfo
+		wantCommand string
+	}{
+		{
+			name: "multiple Dockerfiles",
+			tools: []ToolInfo{
+				{Name: "Dockerfile", Detected: true, Indicators: []string{"Dockerfile", "Dockerfile.prod"}},
+				{Name: "hadolint", Detected: true},
+			},
+			wantCommand: "hadolint Dockerfile Dockerfile.prod",
+		},
+		{
+			name:        "no Dockerfile detected",
+			tools:       []ToolInfo{{Name: "hadolint", Detected: true}},
+			wantCommand: "hadolint Dockerfile",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recs := NewRecommender(Unknown, tt.tools).Recommend()
+			if len(recs) != 1 {
+				t.Fatalf("expected 1 recommendation, got %d", len(recs))
+			}
+			rec := recs[0]
+			if rec.ID != "dockerfile-lint" || rec.Command != tt.wantCommand {
+				t.Errorf("unexpected recommendation: id=%s command=%q", rec.ID, rec.Command)
+			}
+			if rec.Severity != "warning" || rec.Category != "lint" {
+				t.Errorf("expected warning lint check, got %s/%s", rec.Severity, rec.Category)
+			}
+		})
+	}
+}
+
+func TestRecommender_DockerCompose(t *testing.T) {
+	tools := []ToolInfo{
+		{Name: "docker compose", Detected: true, ConfigFile: "compose.yaml"},
+	}
+
+	recs := NewRecommender(Unknown, tools).Recommend()
+	if len(recs) != 1 {
+		t.Fatalf("expected 1 recommendation, got %d", len(recs))
+	}
+	if want := "docker compose -f compose.yaml config --quiet"; recs[0].Command != want {
+		t.Errorf("expected command %q, got %q", want, recs[0].Command)
+	}
+}
+
 func TestRecommender_Goimports(t *testing.T) {
 	tools := []ToolInfo{
 		{Name: "goimports", Detected: true},
@@ -615,3 +852,49 @@ func TestRecommender_PythonFullToolchain(t *testing.T) {
 		}
 	}
 }
+
+func TestRecommender_BuildRunnerFallback(t *testing.T) {
+	runners := []ToolInfo{
+		{Name: "make build", Category: CategoryBuild, Detected: true, Confidence: 0.9},
+		{Name: "make test", Category: CategoryBuild, Detected: true, Confidence: 0.9},
+	}
+
+	// No language-native test or build tool: both come from the Makefile
+	recs := NewRecommender(Unknown, runners).Recommend()
+	commands := make(map[string]string)
+	for _, rec := range recs {
+		commands[rec.ID] = rec.Command
+	}
+	if commands["build"] != "make build" || commands["test"] != "make test" {
+		t.Errorf("expected make build and make test checks, got %v", commands)
+	}
+
+	// go test and go build take precedence over the Makefile targets
+	tools := append([]ToolInfo{
+		{Name: "go test", Detected: true, Confidence: 1.0},
+		{Name: "go build", Detected: true, Confidence: 1.0},
+	}, runners...)
+	for _, rec := range NewRecommender(Go, tools).Recommend() {
+		if rec.Tool == "make build" || rec.Tool == "make test" {
+			t.Errorf("expected no Makefile checks with native Go tools, got %+v", rec)
+		}
+	}
+}
+
+func TestRecommender_BazelFallback(t *testing.T) {
+	tools := []ToolInfo{
+		{Name: "pytest", Detected: true, Confidence: 0.9},
+		{Name: "bazel", Category: CategoryBuild, Detected: true, Confidence: 0.95},
+	}
+
+	commands := make(map[string]string)
+	for _, rec := range NewRecommender(Python, tools).Recommend() {
+		commands[rec.ID] = rec.Command
+	}
+	if commands["build"] != "bazel build //..." {
+		t.Errorf("expected a bazel build check, got %q", commands["build"])
+	}
+	if commands["test"] != "pytest" {
+		t.Errorf("expected pytest to stay the test check, got %q", commands["test"])
+	}
+}
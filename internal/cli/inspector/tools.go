@@ -3,9 +3,12 @@
 package inspector
 
 import (
+	"bufio"
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -61,68 +64,77 @@ func (s *ToolScanner) isPathWithinRoot(path string) bool {
 	return absPath == absRoot || strings.HasPrefix(absPath, absRoot+string(filepath.Separator))
 }
 
+// ScanOptions controls which detected tools a scan returns.
+type ScanOptions struct {
+	// MinConfidence drops detected tools whose confidence is below it.
+	MinConfidence float64
+}
+
 // ScanAll detects all development tools in the project.
 func (s *ToolScanner) ScanAll() ([]ToolInfo, error) {
-	var tools []ToolInfo
-
-	// Scan Go tools
-	goTools, err := s.scanGoTools()
-	if err != nil {
-		return nil, err
-	}
-	tools = append(tools, goTools...)
-
-	// Scan Node tools
-	nodeTools, err := s.scanNodeTools()
-	if err != nil {
-		return nil, err
-	}
-	tools = append(tools, nodeTools...)
-
-	// Scan Python tools
-	pythonTools, err := s.scanPythonTools()
-	if err != nil {
-		return nil, err
-	}
-	tools = append(tools, pythonTools...)
-
-	// Scan CI/CD
-	ciTools, err := s.scanCITools()
-	if err != nil {
-		return nil, err
-	}
-	tools = append(tools, ciTools...)
-
-	// Scan Git hooks
-	hookTools, err := s.scanGitHooks()
-	if err != nil {
-		return nil, err
-	}
-	tools = append(tools, hookTools...)
-
-	// Filter to only detected tools
-	var detected []ToolInfo
-	for _, tool := range tools {
-		if tool.Detected {
-			detected = append(detected, tool)
-		}
-	}
+	return s.ScanAllWithOptions(ScanOptions{})
+}
 
-	return detected, nil
+// ScanAllWithOptions detects all development tools in the project, keeping
+// only those that meet the options.
+func (s *ToolScanner) ScanAllWithOptions(opts ScanOptions) ([]ToolInfo, error) {
+	return s.scanDetected(opts,
+		s.scanGoTools,
+		s.scanNodeTools,
+		s.scanPythonTools,
+		s.scanDotNetTools,
+		s.scanPHPTools,
+		s.scanSwiftTools,
+		s.scanKotlinTools,
+		s.scanContainerTools,
+		s.scanBuildTools,
+		s.scanCITools,
+		s.scanGitHooks,
+	)
 }
 
 // ScanForProjectType scans tools relevant to a specific project type.
+// Container, build runner, CI and git hook tools are language-agnostic, so
+// they are always included.
 func (s *ToolScanner) ScanForProjectType(projectType ProjectType) ([]ToolInfo, error) {
+	var scanLanguage func() ([]ToolInfo, error)
 	switch projectType {
 	case Go:
-		return s.scanGoTools()
+		scanLanguage = s.scanGoTools
 	case Node:
-		return s.scanNodeTools()
+		scanLanguage = s.scanNodeTools
 	case Python:
-		return s.scanPythonTools()
+		scanLanguage = s.scanPythonTools
+	case DotNet:
+		scanLanguage = s.scanDotNetTools
+	case PHP:
+		scanLanguage = s.scanPHPTools
+	case Swift:
+		scanLanguage = s.scanSwiftTools
+	case Kotlin:
+		scanLanguage = s.scanKotlinTools
 	default:
 		return s.ScanAll()
 	}
+	return s.scanDetected(ScanOptions{}, scanLanguage, s.scanContainerTools, s.scanBuildTools, s.scanCITools, s.scanGitHooks)
+}
+
+// scanDetected runs each scanner in order and returns only the detected tools
+// that meet opts.
+func (s *ToolScanner) scanDetected(opts ScanOptions, scanners ...func() ([]ToolInfo, error)) ([]ToolInfo, error) {
+	var detected []ToolInfo
+	for _, scan := range scanners {
+		tools, err := scan()
+		if err != nil {
+			return nil, err
+		}
+		for _, tool := range tools {
+			if tool.Detected && tool.Confidence >= opts.MinConfidence {
+				detected = append(detected, tool)
+			}
+		}
+	}
+	return detected, nil
 }
 
 // scanGoTools detects Go-specific development tools.
@@ -186,6 +198,18 @@ func (s *ToolScanner) scanGoTools() ([]ToolInfo, error) {
 	}
 	tools = append(tools, gotest)
 
+	// go build (always available with Go)
+	gobuild := ToolInfo{
+		Name:     "go build",
+		Category: CategoryBuild,
+	}
+	if s.fileExists("go.mod") {
+		gobuild.Detected = true
+		gobuild.Confidence = 1.0
+		gobuild.Indicators = []string{"go.mod present (go build included with Go)"}
+	}
+	tools = append(tools, gobuild)
+
 	// goimports
 	goimports := ToolInfo{
 		Name:     "goimports",
@@ -199,6 +223,40 @@ func (s *ToolScanner) scanGoTools() ([]ToolInfo, error) {
 	}
 	tools = append(tools, goimports)
 
+	// govulncheck (runnable in any module via go run golang.org/x/vuln/cmd/govulncheck)
+	govulncheck := ToolInfo{
+		Name:     "govulncheck",
+		Category: CategorySecurity,
+	}
+	if s.fileExists("go.mod") {
+		govulncheck.Detected = true
+		if confidence, indicators := s.enhanceToolDetection("govulncheck"); confidence > 0 {
+			govulncheck.Confidence = confidence
+			govulncheck.Indicators = indicators
+		} else {
+			govulncheck.Confidence = 0.6
+			govulncheck.Indicators = []string{"go.mod present (govulncheck recommended for Go modules)"}
+		}
+	}
+	tools = append(tools, govulncheck)
+
+	// gosec
+	gosec := ToolInfo{
+		Name:     "gosec",
+		Category: CategorySecurity,
+	}
+	if configPath := s.findFile(".gosec.json", ".gosec.yml", ".gosec.yaml"); configPath != "" {
+		gosec.Detected = true
+		gosec.ConfigFile = configPath
+		gosec.Confidence = 0.9
+		gosec.Indicators = []string{configPath}
+	} else if confidence, indicators := s.enhanceToolDetection("gosec"); confidence > 0 {
+		gosec.Detected = true
+		gosec.Confidence = confidence
+		gosec.Indicators = indicators
+	}
+	tools = append(tools, gosec)
+
 	return tools, nil
 }
 
@@ -431,6 +489,12 @@ func (s *ToolScanner) scanPythonTools() ([]ToolInfo, error) {
 			pylint.Indicators = []string{configPath}
 		}
 	}
+	if !pylint.Detected && s.fileExists("tox.ini") && s.fileContains("tox.ini", "[pylint") {
+		pylint.Detected = true
+		pylint.ConfigFile = "tox.ini"
+		pylint.Confidence = 0.9
+		pylint.Indicators = []string{"[pylint] in tox.ini"}
+	}
 	if !pylint.Detected && (s.fileContains("requirements.txt", "pylint") || s.fileContains("requirements-dev.txt", "pylint")) {
 		pylint.Detected = true
 		pylint.Confidence = 0.7
@@ -472,6 +536,12 @@ func (s *ToolScanner) scanPythonTools() ([]ToolInfo, error) {
 			pytest.Indicators = []string{configPath}
 		}
 	}
+	if !pytest.Detected && s.fileExists("tox.ini") && s.fileContains("tox.ini", "[pytest]") {
+		pytest.Detected = true
+		pytest.ConfigFile = "tox.ini"
+		pytest.Confidence = 0.9
+		pytest.Indicators = []string{"[pytest] in tox.ini"}
+	}
 	if !pytest.Detected && (s.fileContains("requirements.txt", "pytest") || s.fileContains("requirements-dev.txt", "pytest")) {
 		pytest.Detected = true
 		pytest.Confidence = 0.7
@@ -502,6 +572,11 @@ func (s *ToolScanner) scanPythonTools() ([]ToolInfo, error) {
 		mypy.ConfigFile = "pyproject.toml"
 		mypy.Confidence = 0.9
 		mypy.Indicators = []string{"[tool.mypy] in pyproject.toml"}
+	} else if s.fileExists("tox.ini") && s.fileContains("tox.ini", "[mypy") {
+		mypy.Detected = true
+		mypy.ConfigFile = "tox.ini"
+		mypy.Confidence = 0.9
+		mypy.Indicators = []string{"[mypy] in tox.ini"}
 	} else if s.fileContains("requirements.txt", "mypy") || s.fileContains("requirements-dev.txt", "mypy") {
 		mypy.Detected = true
 		mypy.Confidence = 0.7
@@ -558,6 +633,11 @@ func (s *ToolScanner) scanPythonTools() ([]ToolInfo, error) {
 		flake8.ConfigFile = "setup.cfg"
 		flake8.Confidence = 0.9
 		flake8.Indicators = []string{"[flake8] in setup.cfg"}
+	} else if s.fileExists("tox.ini") && s.fileContains("tox.ini", "[flake8]") {
+		flake8.Detected = true
+		flake8.ConfigFile = "tox.ini"
+		flake8.Confidence = 0.9
+		flake8.Indicators = []string{"[flake8] in tox.ini"}
 	}
 	// Check Makefile and CI configs if not already detected
 	if !flake8.Detected {
@@ -614,6 +694,11 @@ func (s *ToolScanner) scanPythonTools() ([]ToolInfo, error) {
 		pipAudit.Detected = true
 		pipAudit.Confidence = 0.8
 		pipAudit.Indicators = []string{"pip-audit in requirements"}
+	} else if confidence, indicators := s.enhanceToolDetection("pip-audit"); confidence > 0 {
+		// Explicitly run in CI, a Makefile or scripts
+		pipAudit.Detected = true
+		pipAudit.Confidence = confidence
+		pipAudit.Indicators = indicators
 	} else if s.fileExists("requirements.txt") || s.fileExists("pyproject.toml") || s.fileExists("setup.py") {
 		// Recommend pip-audit for any Python project
 		pipAudit.Detected = true
@@ -625,6 +710,407 @@ func (s *ToolScanner) scanPythonTools() ([]ToolInfo, error) {
 	return tools, nil
 }
 
+// scanDotNetTools detects .NET-specific development tools.
+func (s *ToolScanner) scanDotNetTools() ([]ToolInfo, error) {
+	var tools []ToolInfo
+
+	projects := findDotNetProjects(s.root)
+	solutions, _ := filepath.Glob(filepath.Join(s.root, "*.sln"))
+	hasProject := len(projects) > 0 || len(solutions) > 0
+
+	// dotnet format (included with the .NET 6+ SDK)
+	dotnetFormat := ToolInfo{
+		Name:     "dotnet format",
+		Category: CategoryFormatter,
+	}
+	if hasProject {
+		dotnetFormat.Detected = true
+		dotnetFormat.Confidence = 1.0
+		dotnetFormat.Indicators = []string{".NET project present (dotnet format included with the SDK)"}
+		if s.fileExists(".editorconfig") {
+			dotnetFormat.ConfigFile = ".editorconfig"
+		}
+	}
+	tools = append(tools, dotnetFormat)
+
+	// dotnet test (always available with the SDK; stronger with a test project)
+	dotnetTest := ToolInfo{
+		Name:     "dotnet test",
+		Category: CategoryTesting,
+	}
+	for _, project := range projects {
+		if isDotNetTestProject(project) || s.fileContains(project, "Microsoft.NET.Test.Sdk") {
+			dotnetTest.Detected = true
+			dotnetTest.Confidence = 0.9
+			dotnetTest.Indicators = append(dotnetTest.Indicators, filepath.ToSlash(project))
+		}
+	}
+	if !dotnetTest.Detected && hasProject {
+		dotnetTest.Detected = true
+		dotnetTest.Confidence = 0.6
+		dotnetTest.Indicators = []string{".NET project present (dotnet test included with the SDK)"}
+	}
+	tools = append(tools, dotnetTest)
+
+	// Roslyn analyzers, configured through diagnostic severities in .editorconfig
+	analyzers := ToolInfo{
+		Name:     "dotnet analyzers",
+		Category: CategoryLinter,
+	}
+	if hasProject && (s.fileContains(".editorconfig", "dotnet_diagnostic.") || s.fileContains(".editorconfig", "dotnet_analyzer_diagnostic.")) {
+		analyzers.Detected = true
+		analyzers.ConfigFile = ".editorconfig"
+		analyzers.Confidence = 0.85
+		analyzers.Indicators = []string{"analyzer severities in .editorconfig"}
+	}
+	tools = append(tools, analyzers)
+
+	return tools, nil
+}
+
+// scanPHPTools detects PHP-specific development tools.
+func (s *ToolScanner) scanPHPTools() ([]ToolInfo, error) {
+	var tools []ToolInfo
+
+	// PHP-CS-Fixer
+	csFixer := ToolInfo{
+		Name:     "php-cs-fixer",
+		Category: CategoryFormatter,
+	}
+	if configPath := s.findFile(".php-cs-fixer.php", ".php-cs-fixer.dist.php"); configPath != "" {
+		csFixer.Detected = true
+		csFixer.ConfigFile = configPath
+		csFixer.Confidence = 0.9
+		csFixer.Indicators = []string{configPath}
+	} else if s.fileContains("composer.json", "friendsofphp/php-cs-fixer") {
+		csFixer.Detected = true
+		csFixer.Confidence = 0.7
+		csFixer.Indicators = []string{"friendsofphp/php-cs-fixer in composer.json"}
+	}
+	tools = append(tools, csFixer)
+
+	// PHPStan
+	phpstan := ToolInfo{
+		Name:     "phpstan",
+		Category: CategoryLinter,
+	}
+	if configPath := s.findFile("phpstan.neon", "phpstan.neon.dist", "phpstan.dist.neon"); configPath != "" {
+		phpstan.Detected = true
+		phpstan.ConfigFile = configPath
+		phpstan.Confidence = 0.9
+		phpstan.Indicators = []string{configPath}
+	} else if s.fileContains("composer.json", "phpstan/phpstan") {
+		phpstan.Detected = true
+		phpstan.Confidence = 0.7
+		phpstan.Indicators = []string{"phpstan/phpstan in composer.json"}
+	}
+	tools = append(tools, phpstan)
+
+	// PHPUnit
+	phpunit := ToolInfo{
+		Name:     "phpunit",
+		Category: CategoryTesting,
+	}
+	if configPath := s.findFile("phpunit.xml", "phpunit.xml.dist"); configPath != "" {
+		phpunit.Detected = true
+		phpunit.ConfigFile = configPath
+		phpunit.Confidence = 0.9
+		phpunit.Indicators = []string{configPath}
+	} else if s.fileContains("composer.json", "phpunit/phpunit") {
+		phpunit.Detected = true
+		phpunit.Confidence = 0.7
+		phpunit.Indicators = []string{"phpunit/phpunit in composer.json"}
+	}
+	tools = append(tools, phpunit)
+
+	// Check Makefile and CI configs for tools not otherwise detected
+	for i := range tools {
+		if tools[i].Detected {
+			continue
+		}
+		if confidence, indicators := s.enhanceToolDetection(tools[i].Name); confidence > 0 {
+			tools[i].Detected = true
+			tools[i].Confidence = confidence
+			tools[i].Indicators = indicators
+		}
+	}
+
+	return tools, nil
+}
+
+// scanSwiftTools detects Swift-specific development tools.
+func (s *ToolScanner) scanSwiftTools() ([]ToolInfo, error) {
+	var tools []ToolInfo
+
+	// SwiftLint
+	swiftlint := ToolInfo{
+		Name:     "swiftlint",
+		Category: CategoryLinter,
+	}
+	if configPath := s.findFile(".swiftlint.yml", ".swiftlint.yaml"); configPath != "" {
+		swiftlint.Detected = true
+		swiftlint.ConfigFile = configPath
+		swiftlint.Confidence = 0.9
+		swiftlint.Indicators = []string{configPath}
+	} else if confidence, indicators := s.enhanceToolDetection("swiftlint"); confidence > 0 {
+		swiftlint.Detected = true
+		swiftlint.Confidence = confidence
+		swiftlint.Indicators = indicators
+	}
+	tools = append(tools, swiftlint)
+
+	// swift test (included with the toolchain; stronger with test targets)
+	swiftTest := ToolInfo{
+		Name:     "swift test",
+		Category: CategoryTesting,
+	}
+	if s.fileExists("Package.swift") {
+		swiftTest.Detected = true
+		swiftTest.ConfigFile = "Package.swift"
+		if s.dirExists("Tests") {
+			swiftTest.Confidence = 0.9
+			swiftTest.Indicators = []string{"Package.swift", "Tests/"}
+		} else {
+			swiftTest.Confidence = 0.6
+			swiftTest.Indicators = []string{"Package.swift present (swift test included with the toolchain)"}
+		}
+	}
+	tools = append(tools, swiftTest)
+
+	return tools, nil
+}
+
+// scanKotlinTools detects Kotlin-specific development tools. Tools applied
+// as Gradle plugins get the build script as their ConfigFile, so that their
+// checks run through the Gradle wrapper.
+func (s *ToolScanner) scanKotlinTools() ([]ToolInfo, error) {
+	var tools []ToolInfo
+
+	buildFile := s.findFile("build.gradle.kts", "build.gradle")
+
+	// ktlint
+	ktlint := ToolInfo{
+		Name:     "ktlint",
+		Category: CategoryLinter,
+	}
+	if buildFile != "" && s.fileContains(buildFile, "org.jlleitschuh.gradle.ktlint") {
+		ktlint.Detected = true
+		ktlint.ConfigFile = buildFile
+		ktlint.Confidence = 0.9
+		ktlint.Indicators = []string{"org.jlleitschuh.gradle.ktlint plugin in " + buildFile}
+	} else if s.fileContains(".editorconfig", "ktlint_") {
+		ktlint.Detected = true
+		ktlint.ConfigFile = ".editorconfig"
+		ktlint.Confidence = 0.8
+		ktlint.Indicators = []string{"ktlint rules in .editorconfig"}
+	}
+	tools = append(tools, ktlint)
+
+	// detekt
+	detekt := ToolInfo{
+		Name:     "detekt",
+		Category: CategoryLinter,
+	}
+	configPath := s.findFile("detekt.yml", "detekt.yaml", "config/detekt/detekt.yml", "config/detekt.yml")
+	if buildFile != "" && s.fileContains(buildFile, "io.gitlab.arturbosch.detekt") {
+		detekt.Detected = true
+		detekt.ConfigFile = buildFile
+		detekt.Confidence = 0.9
+		detekt.Indicators = []string{"io.gitlab.arturbosch.detekt plugin in " + buildFile}
+		if configPath != "" {
+			detekt.Indicators = append(detekt.Indicators, configPath)
+		}
+	} else if configPath != "" {
+		detekt.Detected = true
+		detekt.ConfigFile = configPath
+		detekt.Confidence = 0.9
+		detekt.Indicators = []string{configPath}
+	}
+	tools = append(tools, detekt)
+
+	// Check Makefile and CI configs for tools not otherwise detected
+	for i := range tools {
+		if tools[i].Detected {
+			continue
+		}
+		if confidence, indicators := s.enhanceToolDetection(tools[i].Name); confidence > 0 {
+			tools[i].Detected = true
+			tools[i].Confidence = confidence
+			tools[i].Indicators = indicators
+		}
+	}
+
+	return tools, nil
+}
+
+// scanContainerTools detects Dockerfiles, Dockerfile linters and Compose files.
+func (s *ToolScanner) scanContainerTools() ([]ToolInfo, error) {
+	var tools []ToolInfo
+
+	// Dockerfile (Dockerfile, Dockerfile.prod, api.Dockerfile, ...)
+	dockerfile := ToolInfo{
+		Name:     "Dockerfile",
+		Category: CategoryBuild,
+	}
+	if dockerfiles := s.findDockerfiles(); len(dockerfiles) > 0 {
+		dockerfile.Detected = true
+		dockerfile.ConfigFile = dockerfiles[0]
+		dockerfile.Confidence = 0.95
+		dockerfile.Indicators = dockerfiles
+	}
+	tools = append(tools, dockerfile)
+
+	// hadolint
+	hadolint := ToolInfo{
+		Name:     "hadolint",
+		Category: CategoryLinter,
+	}
+	if configPath := s.findFile(".hadolint.yaml", ".hadolint.yml"); configPath != "" {
+		hadolint.Detected = true
+		hadolint.ConfigFile = configPath
+		hadolint.Confidence = 0.9
+		hadolint.Indicators = []string{configPath}
+	} else if confidence, indicators := s.enhanceToolDetection("hadolint"); confidence > 0 {
+		hadolint.Detected = true
+		hadolint.Confidence = confidence
+		hadolint.Indicators = indicators
+	}
+	tools = append(tools, hadolint)
+
+	// Docker Compose
+	compose := ToolInfo{
+		Name:     "docker compose",
+		Category: CategoryBuild,
+	}
+	if configPath := s.findFile("compose.yaml", "compose.yml", "docker-compose.yml", "docker-compose.yaml"); configPath != "" {
+		compose.Detected = true
+		compose.ConfigFile = configPath
+		compose.Confidence = 0.9
+		compose.Indicators = []string{configPath}
+	}
+	tools = append(tools, compose)
+
+	return tools, nil
+}
+
+// findDockerfiles returns the Dockerfiles in the project root, with the plain
+// Dockerfile first and the rest sorted by name.
+func (s *ToolScanner) findDockerfiles() []string {
+	entries, err := os.ReadDir(s.root)
+	if err != nil {
+		return nil
+	}
+
+	var dockerfiles []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() {
+			continue
+		}
+		if name == "Dockerfile" || strings.HasPrefix(name, "Dockerfile.") || strings.HasSuffix(strings.ToLower(name), ".dockerfile") {
+			dockerfiles = append(dockerfiles, name)
+		}
+	}
+	sort.SliceStable(dockerfiles, func(i, j int) bool {
+		return dockerfiles[i] == "Dockerfile" && dockerfiles[j] != "Dockerfile"
+	})
+	return dockerfiles
+}
+
+// runnerTargets are the Makefile and justfile targets detected as build
+// tools, named "make <target>" and "just <target>".
+var runnerTargets = []string{"build", "test"}
+
+var (
+	// makeTargetRegex matches a Makefile rule line, capturing its targets.
+	// Variable assignments (:=, ::=) and recipe lines don't match.
+	makeTargetRegex = regexp.MustCompile(`^([^\s#:=][^:=]*)::?(?:[^=]|$)`)
+	// justRecipeRegex matches a justfile recipe header, capturing its name.
+	// Settings and aliases (:=) don't match.
+	justRecipeRegex = regexp.MustCompile(`^@?([A-Za-z_][A-Za-z0-9_-]*)[^:=]*:(?:[^=]|$)`)
+)
+
+// scanBuildTools detects build runners: the build and test targets of a
+// Makefile or justfile, and Bazel.
+func (s *ToolScanner) scanBuildTools() ([]ToolInfo, error) {
+	var tools []ToolInfo
+
+	// make build, make test (GNU make's lookup order)
+	makefile := s.findFile("GNUmakefile", "makefile", "Makefile")
+	makeTargets := s.readTargets(makefile, makeTargetRegex)
+	for _, target := range runnerTargets {
+		tool := ToolInfo{
+			Name:     "make " + target,
+			Category: CategoryBuild,
+		}
+		if makeTargets[target] {
+			tool.Detected = true
+			tool.ConfigFile = makefile
+			tool.Confidence = 0.9
+			tool.Indicators = []string{target + " target in " + makefile}
+		}
+		tools = append(tools, tool)
+	}
+
+	// just build, just test
+	justfile := s.findFile("justfile", "Justfile", ".justfile")
+	justRecipes := s.readTargets(justfile, justRecipeRegex)
+	for _, target := range runnerTargets {
+		tool := ToolInfo{
+			Name:     "just " + target,
+			Category: CategoryBuild,
+		}
+		if justRecipes[target] {
+			tool.Detected = true
+			tool.ConfigFile = justfile
+			tool.Confidence = 0.9
+			tool.Indicators = []string{target + " recipe in " + justfile}
+		}
+		tools = append(tools, tool)
+	}
+
+	// Bazel
+	bazel := ToolInfo{
+		Name:     "bazel",
+		Category: CategoryBuild,
+	}
+	if configPath := s.findFile("MODULE.bazel", "WORKSPACE.bazel", "WORKSPACE", "BUILD.bazel"); configPath != "" {
+		bazel.Detected = true
+		bazel.ConfigFile = configPath
+		bazel.Confidence = 0.95
+		bazel.Indicators = []string{configPath}
+	}
+	tools = append(tools, bazel)
+
+	return tools, nil
+}
+
+// readTargets returns the target names that re captures from the lines of
+// the named file. A missing name or file yields no targets.
+func (s *ToolScanner) readTargets(name string, re *regexp.Regexp) map[string]bool {
+	targets := make(map[string]bool)
+	if name == "" {
+		return targets
+	}
+	path := filepath.Join(s.root, name)
+	if !s.isPathWithinRoot(path) {
+		return targets
+	}
+	data, err := os.ReadFile(path) // #nosec G304 - path is validated by isPathWithinRoot
+	if err != nil {
+		return targets
+	}
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		if match := re.FindStringSubmatch(scanner.Text()); match != nil {
+			for _, target := range strings.Fields(match[1]) {
+				targets[target] = true
+			}
+		}
+	}
+	return targets
+}
+
 // scanCITools detects CI/CD configurations.
 func (s *ToolScanner) scanCITools() ([]ToolInfo, error) {
 	var tools []ToolInfo
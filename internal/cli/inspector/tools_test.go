@@ -3,6 +3,9 @@ package inspector
 import (
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -31,6 +34,7 @@ func TestToolScanner_ScanGoTools(t *testing.T) {
 	var gofmt *ToolInfo
 	var govet *ToolInfo
 	var gotest *ToolInfo
+	var gobuild *ToolInfo
 
 	for i := range tools {
 		switch tools[i].Name {
@@ -42,6 +46,8 @@ func TestToolScanner_ScanGoTools(t *testing.T) {
 			govet = &tools[i]
 		case "go test":
 			gotest = &tools[i]
+		case "go build":
+			gobuild = &tools[i]
 		}
 	}
 
@@ -67,6 +73,12 @@ func TestToolScanner_ScanGoTools(t *testing.T) {
 	if gotest == nil || !gotest.Detected {
 		t.Error("go test should be detected (included with Go)")
 	}
+
+	if gobuild == nil || !gobuild.Detected {
+		t.Error("go build should be detected (included with Go)")
+	} else if gobuild.Category != CategoryBuild || gobuild.Confidence != 1.0 {
+		t.Errorf("go build should be a build tool with confidence 1.0, got %s/%f", gobuild.Category, gobuild.Confidence)
+	}
 }
 
 func TestToolScanner_ScanGoTools_NoGoMod(t *testing.T) {
@@ -89,9 +101,325 @@ func TestToolScanner_ScanGoTools_NoGoMod(t *testing.T) {
 		if tool.Name == "go test" && tool.Detected {
 			t.Error("go test should not be detected without go.mod")
 		}
+		if tool.Name == "go build" && tool.Detected {
+			t.Error("go build should not be detected without go.mod")
+		}
+	}
+}
+
+func TestToolScanner_ScanGoTools_Govulncheck(t *testing.T) {
+	tests := []struct {
+		name           string
+		files          map[string]string
+		wantDetected   bool
+		wantConfidence float64
+	}{
+		{
+			name:           "go.mod only",
+			files:          map[string]string{"go.mod": "module example.com/test\n"},
+			wantDetected:   true,
+			wantConfidence: 0.6,
+		},
+		{
+			name: "referenced in Makefile",
+			files: map[string]string{
+				"go.mod":   "module example.com/test\n",
+				"Makefile": "vuln:\n\tgovulncheck ./...\n",
+			},
+			wantDetected:   true,
+			wantConfidence: 0.7,
+		},
+		{
+			name: "referenced in CI workflow",
+			files: map[string]string{
+				"go.mod":                   "module example.com/test\n",
+				".github/workflows/ci.yml": "steps:\n  - run: go run golang.org/x/vuln/cmd/govulncheck@latest ./...\n",
+			},
+			wantDetected:   true,
+			wantConfidence: 0.75,
+		},
+		{
+			name:         "no go.mod",
+			files:        map[string]string{"Makefile": "vuln:\n\tgovulncheck ./...\n"},
+			wantDetected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for name, content := range tt.files {
+				writeFile(t, tmpDir, name, content)
+			}
+
+			tools, err := NewToolScanner(tmpDir).scanGoTools()
+			if err != nil {
+				t.Fatalf("scanGoTools failed: %v", err)
+			}
+
+			var govulncheck *ToolInfo
+			for i := range tools {
+				if tools[i].Name == "govulncheck" {
+					govulncheck = &tools[i]
+				}
+			}
+			if govulncheck == nil {
+				t.Fatal("govulncheck should always be reported")
+			}
+			if govulncheck.Detected != tt.wantDetected {
+				t.Fatalf("expected detected=%v, got %v", tt.wantDetected, govulncheck.Detected)
+			}
+			if govulncheck.Category != CategorySecurity {
+				t.Errorf("expected category security, got %s", govulncheck.Category)
+			}
+			if tt.wantDetected && govulncheck.Confidence != tt.wantConfidence {
+				t.Errorf("expected confidence %f, got %f", tt.wantConfidence, govulncheck.Confidence)
+			}
+		})
+	}
+}
+
+func TestToolScanner_ScanGoTools_Gosec(t *testing.T) {
+	tests := []struct {
+		name           string
+		files          map[string]string
+		wantDetected   bool
+		wantConfig     string
+		wantConfidence float64
+	}{
+		{
+			name: "config file",
+			files: map[string]string{
+				"go.mod":      "module example.com/test\n",
+				".gosec.json": "{\"global\": {\"nosec\": \"enabled\"}}\n",
+			},
+			wantDetected:   true,
+			wantConfig:     ".gosec.json",
+			wantConfidence: 0.9,
+		},
+		{
+			name: "referenced in CI workflow",
+			files: map[string]string{
+				"go.mod":                         "module example.com/test\n",
+				".github/workflows/security.yml": "steps:\n  - uses: securego/gosec@master\n    with:\n      args: ./...\n",
+			},
+			wantDetected:   true,
+			wantConfidence: 0.75,
+		},
+		{
+			name:         "not referenced",
+			files:        map[string]string{"go.mod": "module example.com/test\n"},
+			wantDetected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for name, content := range tt.files {
+				writeFile(t, tmpDir, name, content)
+			}
+
+			tools, err := NewToolScanner(tmpDir).scanGoTools()
+			if err != nil {
+				t.Fatalf("scanGoTools failed: %v", err)
+			}
+
+			var gosec *ToolInfo
+			for i := range tools {
+				if tools[i].Name == "gosec" {
+					gosec = &tools[i]
+				}
+			}
+			if gosec == nil {
+				t.Fatal("gosec should always be reported")
+			}
+			if gosec.Detected != tt.wantDetected {
+				t.Fatalf("expected detected=%v, got %v", tt.wantDetected, gosec.Detected)
+			}
+			if gosec.Category != CategorySecurity {
+				t.Errorf("expected category security, got %s", gosec.Category)
+			}
+			if gosec.ConfigFile != tt.wantConfig {
+				t.Errorf("expected config file %q, got %q", tt.wantConfig, gosec.ConfigFile)
+			}
+			if tt.wantDetected && gosec.Confidence != tt.wantConfidence {
+				t.Errorf("expected confidence %f, got %f", tt.wantConfidence, gosec.Confidence)
+			}
+		})
+	}
+}
+
+func TestToolScanner_ScanAllWithOptions_MinConfidence(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeFile(t, tmpDir, "go.mod", "module example.com/test\n")
+	writeFile(t, tmpDir, ".golangci.yml", "linters:\n  enable:\n    - errcheck\n")
+	writeFile(t, tmpDir, "Makefile", "fmt:\n\tgoimports -w .\n")
+
+	scanner := NewToolScanner(tmpDir)
+
+	all, err := scanner.ScanAll()
+	if err != nil {
+		t.Fatalf("ScanAll failed: %v", err)
+	}
+	filtered, err := scanner.ScanAllWithOptions(ScanOptions{MinConfidence: 0.75})
+	if err != nil {
+		t.Fatalf("ScanAllWithOptions failed: %v", err)
+	}
+
+	has := func(tools []ToolInfo, name string) bool {
+		for _, tool := range tools {
+			if tool.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !has(all, "goimports") {
+		t.Fatalf("ScanAll should detect goimports from the Makefile, got %v", toolNames(all))
+	}
+	if has(filtered, "goimports") {
+		t.Errorf("goimports (confidence 0.7) should be dropped at 0.75, got %v", toolNames(filtered))
+	}
+	if !has(filtered, "golangci-lint") {
+		t.Errorf("golangci-lint (confidence 0.9) should be kept at 0.75, got %v", toolNames(filtered))
+	}
+	for _, tool := range filtered {
+		if tool.Confidence < 0.75 {
+			t.Errorf("%s has confidence %f below the threshold", tool.Name, tool.Confidence)
+		}
+	}
+}
+
+func TestToolScanner_ScanContainerTools(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeFile(t, tmpDir, "Dockerfile.prod", "FROM alpine\n")
+	writeFile(t, tmpDir, "Dockerfile", "FROM golang:1.22\n")
+	writeFile(t, tmpDir, "worker.Dockerfile", "FROM alpine\n")
+	writeFile(t, tmpDir, "docker-compose.yml", "services: {}\n")
+	writeFile(t, tmpDir, ".hadolint.yaml", "ignored:\n  - DL3008\n")
+	mkdir(t, tmpDir, "Dockerfile.d")
+
+	tools, err := NewToolScanner(tmpDir).scanContainerTools()
+	if err != nil {
+		t.Fatalf("scanContainerTools failed: %v", err)
+	}
+
+	byName := make(map[string]ToolInfo)
+	for _, tool := range tools {
+		byName[tool.Name] = tool
+	}
+
+	dockerfile := byName["Dockerfile"]
+	if !dockerfile.Detected || dockerfile.Category != CategoryBuild {
+		t.Fatalf("Dockerfile should be detected as a build tool, got %+v", dockerfile)
+	}
+	if want := []string{"Dockerfile", "Dockerfile.prod", "worker.Dockerfile"}; !reflect.DeepEqual(dockerfile.Indicators, want) {
+		t.Errorf("Dockerfile indicators = %v, want %v", dockerfile.Indicators, want)
+	}
+	if dockerfile.ConfigFile != "Dockerfile" {
+		t.Errorf("Dockerfile config file = %q, want Dockerfile", dockerfile.ConfigFile)
+	}
+
+	hadolint := byName["hadolint"]
+	if !hadolint.Detected || hadolint.ConfigFile != ".hadolint.yaml" || hadolint.Category != CategoryLinter {
+		t.Errorf("hadolint should be detected from .hadolint.yaml, got %+v", hadolint)
+	}
+
+	compose := byName["docker compose"]
+	if !compose.Detected || compose.ConfigFile != "docker-compose.yml" {
+		t.Errorf("docker compose should be detected from docker-compose.yml, got %+v", compose)
+	}
+}
+
+func TestToolScanner_ScanContainerTools_HadolintInCI(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeFile(t, tmpDir, "Dockerfile.prod", "FROM alpine\n")
+	writeFile(t, tmpDir, ".github/workflows/ci.yml", "steps:\n  - run: hadolint Dockerfile.prod\n")
+
+	tools, err := NewToolScanner(tmpDir).scanContainerTools()
+	if err != nil {
+		t.Fatalf("scanContainerTools failed: %v", err)
+	}
+
+	for _, tool := range tools {
+		switch tool.Name {
+		case "hadolint":
+			if !tool.Detected || tool.Confidence != 0.75 {
+				t.Errorf("hadolint should be detected from CI with confidence 0.75, got %+v", tool)
+			}
+		case "Dockerfile":
+			if !reflect.DeepEqual(tool.Indicators, []string{"Dockerfile.prod"}) {
+				t.Errorf("Dockerfile indicators = %v, want [Dockerfile.prod]", tool.Indicators)
+			}
+		case "docker compose":
+			if tool.Detected {
+				t.Error("docker compose should not be detected without a compose file")
+			}
+		}
+	}
+}
+
+func TestToolScanner_ScanContainerTools_None(t *testing.T) {
+	tools, err := NewToolScanner(t.TempDir()).scanContainerTools()
+	if err != nil {
+		t.Fatalf("scanContainerTools failed: %v", err)
+	}
+	for _, tool := range tools {
+		if tool.Detected {
+			t.Errorf("%s should not be detected in an empty project", tool.Name)
+		}
+	}
+}
+
+func TestToolScanner_ScanAll_IncludesContainerTools(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeFile(t, tmpDir, "go.mod", "module example.com/test\n")
+	writeFile(t, tmpDir, "Dockerfile", "FROM golang:1.22\n")
+
+	for name, scan := range map[string]func() ([]ToolInfo, error){
+		"ScanAll":            NewToolScanner(tmpDir).ScanAll,
+		"ScanForProjectType": func() ([]ToolInfo, error) { return NewToolScanner(tmpDir).ScanForProjectType(Go) },
+	} {
+		tools, err := scan()
+		if err != nil {
+			t.Fatalf("%s failed: %v", name, err)
+		}
+		found := false
+		for _, tool := range tools {
+			if tool.Name == "Dockerfile" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s should include the Dockerfile, got %v", name, toolNames(tools))
+		}
 	}
 }
 
+func TestToolScanner_ScanAll_GoBuild(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module example.com/test\n\ngo 1.21\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tools, err := NewToolScanner(tmpDir).ScanAll()
+	if err != nil {
+		t.Fatalf("ScanAll failed: %v", err)
+	}
+
+	for _, tool := range tools {
+		if tool.Name == "go build" {
+			if tool.Category != CategoryBuild {
+				t.Errorf("go build category = %s, want %s", tool.Category, CategoryBuild)
+			}
+			return
+		}
+	}
+	t.Error("go build should be reported by ScanAll for a Go project")
+}
+
 func TestToolScanner_ScanNodeTools(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -261,6 +589,268 @@ testpaths = ["tests"]
 	}
 }
 
+func TestToolScanner_ScanDotNetTools(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"App.sln":                          "",
+		"src/App/App.csproj":               `<Project Sdk="Microsoft.NET.Sdk"></Project>`,
+		"tests/App.Tests/App.Tests.csproj": `<Project Sdk="Microsoft.NET.Sdk"><ItemGroup><PackageReference Include="Microsoft.NET.Test.Sdk" /></ItemGroup></Project>`,
+		".editorconfig":                    "[*.cs]\ndotnet_diagnostic.CA2007.severity = warning\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tools, err := NewToolScanner(tmpDir).scanDotNetTools()
+	if err != nil {
+		t.Fatalf("scanDotNetTools failed: %v", err)
+	}
+
+	toolMap := make(map[string]*ToolInfo)
+	for i := range tools {
+		toolMap[tools[i].Name] = &tools[i]
+	}
+
+	if format, ok := toolMap["dotnet format"]; !ok || !format.Detected || format.Confidence != 1.0 {
+		t.Errorf("dotnet format should be detected with confidence 1.0, got %+v", format)
+	}
+	if test, ok := toolMap["dotnet test"]; !ok || !test.Detected || test.Confidence != 0.9 {
+		t.Errorf("dotnet test should be detected from the test project with confidence 0.9, got %+v", test)
+	}
+	if analyzers, ok := toolMap["dotnet analyzers"]; !ok || !analyzers.Detected || analyzers.ConfigFile != ".editorconfig" {
+		t.Errorf("dotnet analyzers should be detected from .editorconfig, got %+v", analyzers)
+	}
+}
+
+func TestToolScanner_ScanDotNetTools_NoProject(t *testing.T) {
+	tmpDir := t.TempDir()
+	// An .editorconfig alone doesn't make a .NET project
+	if err := os.WriteFile(filepath.Join(tmpDir, ".editorconfig"), []byte("dotnet_diagnostic.CA1000.severity = none\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tools, err := NewToolScanner(tmpDir).scanDotNetTools()
+	if err != nil {
+		t.Fatalf("scanDotNetTools failed: %v", err)
+	}
+	for _, tool := range tools {
+		if tool.Detected {
+			t.Errorf("expected %s not to be detected without a project", tool.Name)
+		}
+	}
+}
+
+func TestToolScanner_ScanPHPTools(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		".php-cs-fixer.dist.php": "<?php return new PhpCsFixer\\Config();",
+		"phpstan.neon":           "parameters:\n  level: 6\n",
+		"composer.json":          `{"require-dev": {"phpunit/phpunit": "^10.5"}}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tools, err := NewToolScanner(tmpDir).scanPHPTools()
+	if err != nil {
+		t.Fatalf("scanPHPTools failed: %v", err)
+	}
+
+	toolMap := make(map[string]*ToolInfo)
+	for i := range tools {
+		toolMap[tools[i].Name] = &tools[i]
+	}
+
+	if fixer, ok := toolMap["php-cs-fixer"]; !ok || !fixer.Detected || fixer.ConfigFile != ".php-cs-fixer.dist.php" {
+		t.Errorf("php-cs-fixer should be detected from .php-cs-fixer.dist.php, got %+v", fixer)
+	}
+	if phpstan, ok := toolMap["phpstan"]; !ok || !phpstan.Detected || phpstan.ConfigFile != "phpstan.neon" || phpstan.Confidence != 0.9 {
+		t.Errorf("phpstan should be detected from phpstan.neon, got %+v", phpstan)
+	}
+	if phpunit, ok := toolMap["phpunit"]; !ok || !phpunit.Detected || phpunit.Confidence != 0.7 {
+		t.Errorf("phpunit should be detected from composer.json with confidence 0.7, got %+v", phpunit)
+	}
+}
+
+func TestToolScanner_ScanForProjectType_PHP(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "phpunit.xml"), []byte("<phpunit/>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// Python tools are not scanned for a PHP project
+	if err := os.WriteFile(filepath.Join(tmpDir, "pytest.ini"), []byte("[pytest]"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tools, err := NewToolScanner(tmpDir).ScanForProjectType(PHP)
+	if err != nil {
+		t.Fatalf("ScanForProjectType failed: %v", err)
+	}
+
+	var names []string
+	for _, tool := range tools {
+		names = append(names, tool.Name)
+	}
+	if !slices.Contains(names, "phpunit") {
+		t.Errorf("expected phpunit to be detected, got %v", names)
+	}
+	if slices.Contains(names, "pytest") {
+		t.Errorf("expected pytest not to be scanned for a PHP project, got %v", names)
+	}
+}
+
+func TestToolScanner_ScanSwiftTools(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"Package.swift":   "// swift-tools-version:5.9",
+		".swiftlint.yml":  "disabled_rules:\n  - line_length\n",
+		"Tests/README.md": "",
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tools, err := NewToolScanner(tmpDir).scanSwiftTools()
+	if err != nil {
+		t.Fatalf("scanSwiftTools failed: %v", err)
+	}
+
+	toolMap := make(map[string]*ToolInfo)
+	for i := range tools {
+		toolMap[tools[i].Name] = &tools[i]
+	}
+
+	if swiftlint, ok := toolMap["swiftlint"]; !ok || !swiftlint.Detected || swiftlint.ConfigFile != ".swiftlint.yml" {
+		t.Errorf("swiftlint should be detected from .swiftlint.yml, got %+v", swiftlint)
+	}
+	if swiftTest, ok := toolMap["swift test"]; !ok || !swiftTest.Detected || swiftTest.Confidence != 0.9 {
+		t.Errorf("swift test should be detected from Package.swift and Tests/ with confidence 0.9, got %+v", swiftTest)
+	}
+}
+
+func TestToolScanner_ScanSwiftTools_NoPackage(t *testing.T) {
+	tools, err := NewToolScanner(t.TempDir()).scanSwiftTools()
+	if err != nil {
+		t.Fatalf("scanSwiftTools failed: %v", err)
+	}
+	for _, tool := range tools {
+		if tool.Detected {
+			t.Errorf("%s should not be detected without Package.swift, got %+v", tool.Name, tool)
+		}
+	}
+}
+
+func TestToolScanner_ScanKotlinTools(t *testing.T) {
+	tests := []struct {
+		name             string
+		files            map[string]string
+		wantKtlint       string // ConfigFile of ktlint, "" if not detected
+		wantDetektConfig string // ConfigFile of detekt, "" if not detected
+	}{
+		{
+			name: "detekt config",
+			files: map[string]string{
+				"build.gradle.kts":         "plugins { kotlin(\"jvm\") }",
+				"config/detekt/detekt.yml": "complexity:\n  LongMethod:\n    threshold: 80\n",
+			},
+			wantDetektConfig: "config/detekt/detekt.yml",
+		},
+		{
+			name: "Gradle plugins",
+			files: map[string]string{
+				"build.gradle.kts": `plugins {
+    kotlin("jvm") version "2.0.0"
+    id("org.jlleitschuh.gradle.ktlint") version "12.1.0"
+    id("io.gitlab.arturbosch.detekt") version "1.23.6"
+}`,
+				"detekt.yml": "",
+			},
+			wantKtlint:       "build.gradle.kts",
+			wantDetektConfig: "build.gradle.kts",
+		},
+		{
+			name: "ktlint rules in .editorconfig",
+			files: map[string]string{
+				"build.gradle.kts": "plugins { kotlin(\"jvm\") }",
+				".editorconfig":    "[*.{kt,kts}]\nktlint_code_style = ktlint_official\n",
+			},
+			wantKtlint: ".editorconfig",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(tmpDir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			tools, err := NewToolScanner(tmpDir).scanKotlinTools()
+			if err != nil {
+				t.Fatalf("scanKotlinTools failed: %v", err)
+			}
+			toolMap := make(map[string]ToolInfo)
+			for _, tool := range tools {
+				toolMap[tool.Name] = tool
+			}
+
+			for name, want := range map[string]string{"ktlint": tt.wantKtlint, "detekt": tt.wantDetektConfig} {
+				tool := toolMap[name]
+				if tool.Detected != (want != "") || tool.ConfigFile != want {
+					t.Errorf("expected %s detected=%v with config %q, got %+v", name, want != "", want, tool)
+				}
+			}
+		})
+	}
+}
+
+func TestToolScanner_ScanForProjectType_Kotlin(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "detekt.yml"), []byte(""), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// Swift tools are not scanned for a Kotlin project
+	if err := os.WriteFile(filepath.Join(tmpDir, ".swiftlint.yml"), []byte(""), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tools, err := NewToolScanner(tmpDir).ScanForProjectType(Kotlin)
+	if err != nil {
+		t.Fatalf("ScanForProjectType failed: %v", err)
+	}
+
+	var names []string
+	for _, tool := range tools {
+		names = append(names, tool.Name)
+	}
+	if !slices.Contains(names, "detekt") {
+		t.Errorf("expected detekt to be detected, got %v", names)
+	}
+	if slices.Contains(names, "swiftlint") {
+		t.Errorf("expected swiftlint not to be scanned for a Kotlin project, got %v", names)
+	}
+}
+
 func TestToolScanner_ScanPythonTools_Ruff(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -581,6 +1171,52 @@ func TestToolScanner_ScanForProjectType(t *testing.T) {
 	if !hasGoTool {
 		t.Error("ScanForProjectType(Go) should return Go tools")
 	}
+	assertAllDetected(t, tools)
+}
+
+func TestToolScanner_ScanForProjectType_IncludesCIAndHooks(t *testing.T) {
+	for _, tt := range []struct {
+		projectType ProjectType
+		marker      string
+	}{
+		{Go, "go.mod"},
+		{Node, "package.json"},
+		{Python, "pyproject.toml"},
+	} {
+		t.Run(string(tt.projectType), func(t *testing.T) {
+			tmpDir := t.TempDir()
+			writeFile(t, tmpDir, tt.marker, "{}\n")
+			writeFile(t, tmpDir, ".github/workflows/ci.yml", "on: push\njobs: {}\n")
+			writeFile(t, tmpDir, ".pre-commit-config.yaml", "repos: []\n")
+
+			tools, err := NewToolScanner(tmpDir).ScanForProjectType(tt.projectType)
+			if err != nil {
+				t.Fatalf("ScanForProjectType failed: %v", err)
+			}
+
+			names := make(map[string]bool)
+			for _, tool := range tools {
+				names[tool.Name] = true
+			}
+			if !names["GitHub Actions"] {
+				t.Errorf("ScanForProjectType(%s) should include GitHub Actions, got %v", tt.projectType, toolNames(tools))
+			}
+			if !names["pre-commit"] {
+				t.Errorf("ScanForProjectType(%s) should include pre-commit, got %v", tt.projectType, toolNames(tools))
+			}
+			assertAllDetected(t, tools)
+		})
+	}
+}
+
+// assertAllDetected fails the test if any tool in tools is not detected.
+func assertAllDetected(t *testing.T, tools []ToolInfo) {
+	t.Helper()
+	for _, tool := range tools {
+		if !tool.Detected {
+			t.Errorf("expected only detected tools, got undetected %s", tool.Name)
+		}
+	}
 }
 
 func TestToolScanner_EmptyProject(t *testing.T) {
@@ -658,6 +1294,7 @@ func TestToolScanner_ScanForProjectType_Node(t *testing.T) {
 	if !hasNodeTool {
 		t.Error("ScanForProjectType(Node) should return Node tools")
 	}
+	assertAllDetected(t, tools)
 }
 
 func TestToolScanner_ScanForProjectType_Python(t *testing.T) {
@@ -685,6 +1322,7 @@ func TestToolScanner_ScanForProjectType_Python(t *testing.T) {
 	if !hasPythonTool {
 		t.Error("ScanForProjectType(Python) should return Python tools")
 	}
+	assertAllDetected(t, tools)
 }
 
 func TestToolScanner_ScanForProjectType_Unknown(t *testing.T) {
@@ -910,6 +1548,56 @@ max-line-length = 88
 	}
 }
 
+func TestToolScanner_ScanPythonTools_ToxIni(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// Write tox.ini with flake8, mypy and pytest sections
+	toxIni := `[tox]
+envlist = py311
+
+[testenv]
+commands = pytest
+
+[flake8]
+max-line-length = 88
+
+[mypy]
+strict = True
+
+[pytest]
+testpaths = tests
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "tox.ini"), []byte(toxIni), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	scanner := NewToolScanner(tmpDir)
+	tools, err := scanner.scanPythonTools()
+	if err != nil {
+		t.Fatalf("scanPythonTools failed: %v", err)
+	}
+
+	detected := make(map[string]ToolInfo)
+	for _, tool := range tools {
+		if tool.Detected {
+			detected[tool.Name] = tool
+		}
+	}
+	for _, name := range []string{"flake8", "mypy", "pytest"} {
+		tool, ok := detected[name]
+		if !ok {
+			t.Errorf("%s should be detected from tox.ini", name)
+			continue
+		}
+		if tool.ConfigFile != "tox.ini" {
+			t.Errorf("%s config should be tox.ini, got %q", name, tool.ConfigFile)
+		}
+	}
+	if _, ok := detected["pylint"]; ok {
+		t.Error("pylint should not be detected without a [pylint] section")
+	}
+}
+
 func TestToolScanner_ScanPythonTools_PytestIni(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -1980,6 +2668,45 @@ func TestToolScanner_ScanPythonTools_PipAudit(t *testing.T) {
 	}
 }
 
+func TestToolScanner_ScanPythonTools_PipAuditInCIWorkflow(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(tmpDir, "pyproject.toml"), []byte("[project]\nname = \"demo\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	workflowDir := filepath.Join(tmpDir, ".github", "workflows")
+	if err := os.MkdirAll(workflowDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	workflow := "jobs:\n  audit:\n    steps:\n      - run: pip install pip-audit && pip-audit\n"
+	if err := os.WriteFile(filepath.Join(workflowDir, "ci.yml"), []byte(workflow), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tools, err := NewToolScanner(tmpDir).scanPythonTools()
+	if err != nil {
+		t.Fatalf("scanPythonTools failed: %v", err)
+	}
+
+	var pipAudit *ToolInfo
+	for i := range tools {
+		if tools[i].Name == "pip-audit" {
+			pipAudit = &tools[i]
+			break
+		}
+	}
+
+	if pipAudit == nil || !pipAudit.Detected {
+		t.Fatal("pip-audit should be detected from a CI workflow")
+	}
+	if pipAudit.Confidence != 0.75 {
+		t.Errorf("pip-audit confidence should be 0.75 when referenced in CI, got %f", pipAudit.Confidence)
+	}
+	if len(pipAudit.Indicators) == 0 || !strings.Contains(pipAudit.Indicators[0], "ci.yml") {
+		t.Errorf("expected CI workflow indicator, got %v", pipAudit.Indicators)
+	}
+}
+
 func TestToolScanner_ScanPythonTools_PipAuditRecommended(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -3292,3 +4019,81 @@ func TestToolScanner_EnhanceToolDetection_MakefileAndScripts(t *testing.T) {
 		t.Errorf("should have indicators from both Makefile and scripts, got %d: %v", len(indicators), indicators)
 	}
 }
+
+func TestToolScanner_ScanBuildTools_Makefile(t *testing.T) {
+	root := createTestProject(t, map[string]string{
+		"Makefile": `GO := go
+BIN = bin/app
+
+.PHONY: build test lint
+
+build: deps
+	$(GO) build -o $(BIN) ./cmd/app
+
+test:
+	$(GO) test ./...
+
+lint:
+	golangci-lint run
+`,
+	}, nil)
+
+	tools, err := NewToolScanner(root).scanBuildTools()
+	if err != nil {
+		t.Fatalf("scanBuildTools failed: %v", err)
+	}
+
+	detected := make(map[string]ToolInfo)
+	for _, tool := range tools {
+		if tool.Detected {
+			detected[tool.Name] = tool
+		}
+	}
+	if len(detected) != 2 {
+		t.Errorf("expected only make build and make test, got %v", detected)
+	}
+	for _, name := range []string{"make build", "make test"} {
+		tool, ok := detected[name]
+		if !ok {
+			t.Errorf("%s should be detected", name)
+			continue
+		}
+		if tool.Category != CategoryBuild || tool.ConfigFile != "Makefile" {
+			t.Errorf("%s: expected build tool with Makefile config, got %+v", name, tool)
+		}
+	}
+}
+
+func TestToolScanner_ScanBuildTools_JustAndBazel(t *testing.T) {
+	root := createTestProject(t, map[string]string{
+		"justfile": `set shell := ["bash", "-c"]
+alias t := test
+
+test *args:
+    cargo test {{args}}
+`,
+		"MODULE.bazel": `module(name = "app")`,
+		"BUILD.bazel":  "",
+	}, nil)
+
+	tools, err := NewToolScanner(root).scanBuildTools()
+	if err != nil {
+		t.Fatalf("scanBuildTools failed: %v", err)
+	}
+
+	detected := make(map[string]ToolInfo)
+	for _, tool := range tools {
+		if tool.Detected {
+			detected[tool.Name] = tool
+		}
+	}
+	if tool, ok := detected["just test"]; !ok || tool.ConfigFile != "justfile" {
+		t.Errorf("expected just test from justfile, got %+v (found: %v)", tool, ok)
+	}
+	if _, ok := detected["just build"]; ok {
+		t.Error("just build should not be detected without a build recipe")
+	}
+	if tool, ok := detected["bazel"]; !ok || tool.ConfigFile != "MODULE.bazel" {
+		t.Errorf("expected bazel from MODULE.bazel, got %+v (found: %v)", tool, ok)
+	}
+}
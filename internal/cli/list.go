@@ -1,26 +1,38 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"slices"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
 	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+	"github.com/vibeguard/vibeguard/internal/output"
 )
 
+var listCategories bool
+
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List configured checks",
 	Long: `List all checks defined in the configuration file.
 
-This command shows the check IDs, their commands, and dependencies.
+This command shows each check's ID, severity, timeout, command and
+dependencies, followed by the execution order: checks in the same level
+have no dependencies on each other and run in parallel.
 
 Examples:
   vibeguard list           List all checks
   vibeguard list -v        List all checks with verbose output
+  vibeguard list --json    List checks and execution levels as JSON
   vibeguard list --tags security   List only security checks
-  vibeguard list --exclude-tags slow   List all checks except slow ones`,
+  vibeguard list --exclude-tags slow   List all checks except slow ones
+  vibeguard list --list-categories     List the checks of each category`,
 	RunE: runList,
 }
 
@@ -28,11 +40,12 @@ func init() {
 	rootCmd.AddCommand(listCmd)
 	listCmd.Flags().StringSliceVar(&tags, "tags", nil, "List only checks matching ANY of these tags (comma-separated)")
 	listCmd.Flags().StringSliceVar(&excludeTags, "exclude-tags", nil, "Exclude checks matching ANY of these tags (comma-separated)")
+	listCmd.Flags().BoolVar(&listCategories, "list-categories", false, "List the categories of the checks, with the checks in each")
 }
 
 func runList(cmd *cobra.Command, args []string) error {
 	// Load configuration
-	cfg, err := config.Load(configFile)
+	cfg, err := loadConfig()
 	if err != nil {
 		return err
 	}
@@ -40,22 +53,66 @@ func runList(cmd *cobra.Command, args []string) error {
 	// Apply tag filtering
 	checksToShow := filterChecksForList(cfg.Checks)
 
+	if listCategories {
+		return writeCategories(cmd.OutOrStdout(), checksToShow)
+	}
+
+	levels, err := listLevels(checksToShow)
+	if err != nil {
+		return err
+	}
+
 	out := cmd.OutOrStdout()
-	_, _ = fmt.Fprintf(out, "Checks (%d):\n\n", len(checksToShow))
+	if jsonOutput {
+		return writeListJSON(out, checksToShow, levels)
+	}
 
-	for _, check := range checksToShow {
-		_, _ = fmt.Fprintf(out, "  %s\n", check.ID)
+	_, _ = fmt.Fprintf(out, "Checks (%d):\n\n", len(checksToShow))
 
-		if verbose {
+	if !verbose {
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		for _, check := range checksToShow {
+			_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\t%s", check.ID, check.Severity, check.Timeout.AsDuration(), output.TruncateCommand(checkCommand(check)))
+			if len(check.Requires) > 0 {
+				_, _ = fmt.Fprintf(w, "\trequires: %s", strings.Join(check.Requires, ", "))
+			}
+			if len(check.OptionalRequires) > 0 {
+				_, _ = fmt.Fprintf(w, "\tafter: %s", strings.Join(check.OptionalRequires, ", "))
+			}
+			if len(check.RequiresAny) > 0 {
+				_, _ = fmt.Fprintf(w, "\trequires any: %s", strings.Join(check.RequiresAny, ", "))
+			}
+			_, _ = fmt.Fprintln(w)
+		}
+		_ = w.Flush()
+	} else {
+		for _, check := range checksToShow {
+			_, _ = fmt.Fprintf(out, "  %s\n", check.ID)
+			if check.Description != "" {
+				_, _ = fmt.Fprintf(out, "    Description: %s\n", check.Description)
+			}
+			if check.Category != "" {
+				_, _ = fmt.Fprintf(out, "    Category: %s\n", check.Category)
+			}
 			if len(check.Tags) > 0 {
 				_, _ = fmt.Fprintf(out, "    Tags:     %s\n", strings.Join(check.Tags, ", "))
 			}
-			_, _ = fmt.Fprintf(out, "    Command:  %s\n", check.Run)
+			if check.Run != "" {
+				_, _ = fmt.Fprintf(out, "    Command:  %s\n", check.Run)
+			} else {
+				_, _ = fmt.Fprintf(out, "    File:     %s\n", check.File)
+			}
 			_, _ = fmt.Fprintf(out, "    Severity: %s\n", check.Severity)
 			_, _ = fmt.Fprintf(out, "    Timeout:  %s\n", check.Timeout.AsDuration())
 			if len(check.Requires) > 0 {
 				_, _ = fmt.Fprintf(out, "    Requires: %s\n", strings.Join(check.Requires, ", "))
 			}
+			if len(check.OptionalRequires) > 0 {
+				_, _ = fmt.Fprintf(out, "    After:    %s\n", strings.Join(check.OptionalRequires, ", "))
+			}
+			if len(check.RequiresAny) > 0 {
+				_, _ = fmt.Fprintf(out, "    Requires any: %s\n", strings.Join(check.RequiresAny, ", "))
+			}
 			if check.Suggestion != "" {
 				_, _ = fmt.Fprintf(out, "    Suggestion: %s\n", check.Suggestion)
 			}
@@ -63,9 +120,145 @@ func runList(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if len(levels) > 0 {
+		if !verbose {
+			_, _ = fmt.Fprintln(out)
+		}
+		_, _ = fmt.Fprintln(out, "Execution order:")
+		for i, level := range levels {
+			_, _ = fmt.Fprintf(out, "  %d. %s\n", i+1, strings.Join(level, ", "))
+		}
+	}
+
 	return nil
 }
 
+// listCheckJSON is the JSON representation of a check in `vibeguard list --json`.
+type listCheckJSON struct {
+	ID               string   `json:"id"`
+	Description      string   `json:"description,omitempty"`
+	Category         string   `json:"category,omitempty"`
+	Severity         string   `json:"severity"`
+	Timeout          string   `json:"timeout"`
+	Command          string   `json:"command"`
+	Tags             []string `json:"tags,omitempty"`
+	Requires         []string `json:"requires,omitempty"`
+	OptionalRequires []string `json:"optional_requires,omitempty"`
+	RequiresAny      []string `json:"requires_any,omitempty"`
+}
+
+// listJSON is the JSON document written by `vibeguard list --json`.
+type listJSON struct {
+	Checks []listCheckJSON `json:"checks"`
+	Levels [][]string      `json:"levels"`
+}
+
+// writeListJSON writes the checks and their execution levels as JSON.
+func writeListJSON(out io.Writer, checks []config.Check, levels [][]string) error {
+	doc := listJSON{
+		Checks: make([]listCheckJSON, 0, len(checks)),
+		Levels: levels,
+	}
+	if doc.Levels == nil {
+		doc.Levels = [][]string{}
+	}
+	for _, check := range checks {
+		doc.Checks = append(doc.Checks, listCheckJSON{
+			ID:               check.ID,
+			Description:      check.Description,
+			Category:         check.Category,
+			Severity:         string(check.Severity),
+			Timeout:          check.Timeout.AsDuration().String(),
+			Command:          check.Run,
+			Tags:             check.Tags,
+			Requires:         check.Requires,
+			OptionalRequires: check.OptionalRequires,
+			RequiresAny:      check.RequiresAny,
+		})
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(doc)
+}
+
+// listCategoryJSON is the JSON representation of a category in
+// `vibeguard list --list-categories --json`.
+type listCategoryJSON struct {
+	Category string   `json:"category"`
+	Checks   []string `json:"checks"`
+}
+
+// writeCategories writes the categories of checks, in the order the run
+// output groups them, each with the IDs of its checks. Checks without a
+// category are listed last, as uncategorized.
+func writeCategories(out io.Writer, checks []config.Check) error {
+	byCategory := make(map[string][]string)
+	for _, check := range checks {
+		category := check.Category
+		if category == "" {
+			category = orchestrator.Uncategorized
+		}
+		byCategory[category] = append(byCategory[category], check.ID)
+	}
+
+	categories := make([]listCategoryJSON, 0, len(byCategory))
+	for _, category := range slices.Concat(config.Categories, []string{orchestrator.Uncategorized}) {
+		if ids := byCategory[category]; len(ids) > 0 {
+			categories = append(categories, listCategoryJSON{Category: category, Checks: ids})
+		}
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(struct {
+			Categories []listCategoryJSON `json:"categories"`
+		}{categories})
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, c := range categories {
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", c.Category, strings.Join(c.Checks, ", "))
+	}
+	return w.Flush()
+}
+
+// checkCommand returns what a check runs, for display: its command, or the
+// file read by a check without one.
+func checkCommand(check config.Check) string {
+	if check.Run == "" {
+		return "file: " + check.File
+	}
+	return check.Run
+}
+
+// listLevels returns the execution levels of checks as computed by the
+// orchestrator. Requires on checks that were filtered out of the list are
+// ignored, so the levels describe the listed checks only.
+func listLevels(checks []config.Check) ([][]string, error) {
+	listed := make(map[string]bool, len(checks))
+	for _, check := range checks {
+		listed[check.ID] = true
+	}
+
+	pruned := make([]config.Check, len(checks))
+	for i, check := range checks {
+		pruned[i] = check
+		pruned[i].Requires = nil
+		for _, dep := range check.Requires {
+			if listed[dep] {
+				pruned[i].Requires = append(pruned[i].Requires, dep)
+			}
+		}
+	}
+
+	graph, err := orchestrator.BuildGraph(pruned)
+	if err != nil {
+		return nil, err
+	}
+	return graph.Levels(), nil
+}
+
 // filterChecksForList applies tag-based filtering to checks for list display.
 func filterChecksForList(checks []config.Check) []config.Check {
 	if len(tags) == 0 && len(excludeTags) == 0 {
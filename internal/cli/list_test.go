@@ -2,9 +2,14 @@ package cli
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
 )
 
 func TestRunList_Success(t *testing.T) {
@@ -262,3 +267,191 @@ checks:
 		t.Errorf("expected 'security' tag in output, got: %s", output)
 	}
 }
+
+// writeListConfig writes a config with a small dependency chain and returns its path.
+func writeListConfig(t *testing.T) string {
+	t.Helper()
+	configContent := `version: "1"
+checks:
+  - id: fmt
+    run: "gofmt -l ."
+    severity: warning
+    timeout: 10s
+    tags: [format]
+    category: format
+  - id: vet
+    run: "go vet ./..."
+    timeout: 20s
+    category: lint
+  - id: test
+    run: "go test -race -count=1 -coverprofile=coverage.out -covermode=atomic ./internal/... ./cmd/..."
+    requires: [fmt, vet]
+`
+	configPath := filepath.Join(t.TempDir(), "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	return configPath
+}
+
+// runListForTest runs the list command against configPath and returns its output.
+func runListForTest(t *testing.T, configPath string, asJSON bool) string {
+	t.Helper()
+	oldConfig, oldVerbose, oldJSON := configFile, verbose, jsonOutput
+	oldTags, oldExclude := tags, excludeTags
+	defer func() {
+		configFile, verbose, jsonOutput = oldConfig, oldVerbose, oldJSON
+		tags, excludeTags = oldTags, oldExclude
+	}()
+
+	configFile = configPath
+	verbose = false
+	jsonOutput = asJSON
+	tags, excludeTags = nil, nil
+
+	var buf bytes.Buffer
+	rootCmd.SetOut(&buf)
+	defer rootCmd.SetOut(nil)
+
+	if err := runList(listCmd, nil); err != nil {
+		t.Fatalf("runList failed: %v", err)
+	}
+	return buf.String()
+}
+
+func TestRunList_TextOutput(t *testing.T) {
+	out := runListForTest(t, writeListConfig(t), false)
+
+	for _, want := range []string{
+		"Checks (3):",
+		"fmt", "warning", "10s", "gofmt -l .",
+		"vet", "error", "20s",
+		"requires: fmt, vet",
+		"Execution order:",
+		"1. fmt, vet",
+		"2. test",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	// Long commands are truncated in the summary view
+	if strings.Contains(out, "./internal/... ./cmd/...") {
+		t.Errorf("expected long command to be truncated, got:\n%s", out)
+	}
+	if !strings.Contains(out, "...") {
+		t.Errorf("expected truncated command to end with ..., got:\n%s", out)
+	}
+}
+
+func TestRunList_VerboseLabelsAligned(t *testing.T) {
+	oldConfig, oldVerbose, oldJSON := configFile, verbose, jsonOutput
+	oldTags, oldExclude := tags, excludeTags
+	defer func() {
+		configFile, verbose, jsonOutput = oldConfig, oldVerbose, oldJSON
+		tags, excludeTags = oldTags, oldExclude
+	}()
+
+	configFile = writeListConfig(t)
+	verbose = true
+	jsonOutput = false
+	tags, excludeTags = nil, nil
+
+	var buf bytes.Buffer
+	rootCmd.SetOut(&buf)
+	defer rootCmd.SetOut(nil)
+
+	if err := runList(listCmd, nil); err != nil {
+		t.Fatalf("runList failed: %v", err)
+	}
+
+	// Every value of the fmt check starts in the same column
+	out := buf.String()
+	for _, line := range []string{
+		"    Category: format\n",
+		"    Tags:     format\n",
+		"    Command:  gofmt -l .\n",
+		"    Severity: warning\n",
+		"    Timeout:  10s\n",
+	} {
+		if !strings.Contains(out, line) {
+			t.Errorf("expected line %q, got:\n%s", line, out)
+		}
+	}
+}
+
+func TestRunList_JSONOutput(t *testing.T) {
+	out := runListForTest(t, writeListConfig(t), true)
+
+	var doc listJSON
+	if err := json.Unmarshal([]byte(out), &doc); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, out)
+	}
+
+	if len(doc.Checks) != 3 {
+		t.Fatalf("expected 3 checks, got %d", len(doc.Checks))
+	}
+	test := doc.Checks[2]
+	if test.ID != "test" || test.Severity != "error" || test.Timeout != "30s" {
+		t.Errorf("unexpected test check: %+v", test)
+	}
+	if !strings.HasSuffix(test.Command, "./internal/... ./cmd/...") {
+		t.Errorf("expected full command in JSON, got %q", test.Command)
+	}
+	if !reflect.DeepEqual(test.Requires, []string{"fmt", "vet"}) {
+		t.Errorf("expected requires [fmt vet], got %v", test.Requires)
+	}
+	if !reflect.DeepEqual(doc.Checks[0].Tags, []string{"format"}) {
+		t.Errorf("expected fmt tags [format], got %v", doc.Checks[0].Tags)
+	}
+
+	wantLevels := [][]string{{"fmt", "vet"}, {"test"}}
+	if !reflect.DeepEqual(doc.Levels, wantLevels) {
+		t.Errorf("expected levels %v, got %v", wantLevels, doc.Levels)
+	}
+}
+
+func TestRunList_ListCategories(t *testing.T) {
+	oldListCategories := listCategories
+	defer func() { listCategories = oldListCategories }()
+	listCategories = true
+	configPath := writeListConfig(t)
+
+	out := runListForTest(t, configPath, false)
+	want := "lint           vet\nformat         fmt\nuncategorized  test\n"
+	if out != want {
+		t.Errorf("expected output:\n%s\ngot:\n%s", want, out)
+	}
+
+	var doc struct {
+		Categories []listCategoryJSON `json:"categories"`
+	}
+	out = runListForTest(t, configPath, true)
+	if err := json.Unmarshal([]byte(out), &doc); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, out)
+	}
+	wantCategories := []listCategoryJSON{
+		{Category: "lint", Checks: []string{"vet"}},
+		{Category: "format", Checks: []string{"fmt"}},
+		{Category: "uncategorized", Checks: []string{"test"}},
+	}
+	if !reflect.DeepEqual(doc.Categories, wantCategories) {
+		t.Errorf("expected categories %+v, got %+v", wantCategories, doc.Categories)
+	}
+}
+
+func TestListLevels_IgnoresFilteredDependencies(t *testing.T) {
+	// fmt was filtered out of the list, so test only waits on vet
+	levels, err := listLevels([]config.Check{
+		{ID: "vet", Run: "go vet ./..."},
+		{ID: "test", Run: "go test ./...", Requires: []string{"fmt", "vet"}},
+	})
+	if err != nil {
+		t.Fatalf("listLevels failed: %v", err)
+	}
+	wantLevels := [][]string{{"vet"}, {"test"}}
+	if !reflect.DeepEqual(levels, wantLevels) {
+		t.Errorf("expected levels %v, got %v", wantLevels, levels)
+	}
+}
@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
+	"text/template"
+	"text/template/parse"
 
 	"github.com/spf13/cobra"
 
+	"github.com/vibeguard/vibeguard/internal/cli/inspector"
 	"github.com/vibeguard/vibeguard/internal/config"
 )
 
@@ -21,33 +26,51 @@ Without a prompt ID, lists all available prompts:
   vibeguard prompt -v        List prompts with descriptions and tags
   vibeguard prompt --json    List prompts in JSON format
 
+--tag lists only the configured prompts with ANY of the given tags. It can be
+repeated or given a comma-separated list. The built-in init prompt has no
+tags, so it is not listed when filtering.
+
 With a prompt ID, outputs the prompt content:
   vibeguard prompt init                    Show the init prompt
   vibeguard prompt init | less             Pipe prompt to less
   vibeguard prompt code-review | llm ...   Pipe to LLM tools
 
+Prompt content can reference the inspected project as Go template fields:
+{{.Name}}, {{.Type}}, {{.Version}}, {{.Description}} and {{.Tools}}, e.g.
+"This is a {{.Type}} project using {{.Tools}}." Content that fails to
+render is output unchanged.
+
 Examples:
   vibeguard prompt                    List all prompts
   vibeguard prompt -v                 List with descriptions
   vibeguard prompt --json             Machine-readable list
+  vibeguard prompt --tag security     List prompts tagged security
+  vibeguard prompt --tag security --tag review   List prompts tagged security or review
   vibeguard prompt init               Get init prompt content
   vibeguard prompt code-review | less Pipe to less`,
 	RunE: runPrompt,
 }
 
+// promptTags holds the --tag values of the prompt command.
+var promptTags []string
+
 func init() {
 	rootCmd.AddCommand(promptCmd)
+	promptCmd.Flags().StringSliceVar(&promptTags, "tag", nil, "List only prompts with ANY of these tags (repeatable or comma-separated)")
 }
 
 func runPrompt(cmd *cobra.Command, args []string) error {
 	// Load configuration
-	cfg, err := config.Load(configFile)
+	cfg, err := loadConfig()
 	if err != nil {
 		return err
 	}
 
-	// If no prompt ID provided, list all prompts
+	// If no prompt ID provided, list all prompts, or those with the given tags
 	if len(args) == 0 {
+		if len(promptTags) > 0 {
+			return listTaggedPrompts(cmd, filterPromptsByTags(cfg.Prompts, promptTags))
+		}
 		return listPromptsWithBuiltin(cmd, cfg.Prompts)
 	}
 
@@ -55,9 +78,9 @@ func runPrompt(cmd *cobra.Command, args []string) error {
 	promptID := args[0]
 	for _, prompt := range cfg.Prompts {
 		if prompt.ID == promptID {
-			// Output raw prompt content to stdout
+			// Output prompt content, with project fields expanded, to stdout
 			out := cmd.OutOrStdout()
-			_, _ = fmt.Fprint(out, prompt.Content)
+			_, _ = fmt.Fprint(out, renderPrompt(prompt.Content, cfg.Dir()))
 			return nil
 		}
 	}
@@ -73,6 +96,41 @@ func runPrompt(cmd *cobra.Command, args []string) error {
 	return fmt.Errorf("prompt not found: %s", promptID)
 }
 
+// renderPrompt expands the template actions in a prompt's content against
+// the project at root. The project is only inspected when the content has
+// actions. Content without actions, or that fails to parse or execute, is
+// returned unchanged.
+func renderPrompt(content, root string) string {
+	tmpl, err := template.New("prompt").Parse(content)
+	if err != nil || !hasActions(tmpl.Tree) {
+		return content
+	}
+
+	project, err := inspector.InspectContext(root)
+	if err != nil {
+		return content
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, project); err != nil {
+		return content
+	}
+	return buf.String()
+}
+
+// hasActions reports whether a parsed template contains anything but text.
+func hasActions(tree *parse.Tree) bool {
+	if tree == nil || tree.Root == nil {
+		return false
+	}
+	for _, node := range tree.Root.Nodes {
+		if node.Type() != parse.NodeText {
+			return true
+		}
+	}
+	return false
+}
+
 // listPromptsWithBuiltin displays prompts including built-in prompts in various formats
 func listPromptsWithBuiltin(cmd *cobra.Command, prompts []config.Prompt) error {
 	out := cmd.OutOrStdout()
@@ -105,17 +163,7 @@ func listPromptsWithBuiltin(cmd *cobra.Command, prompts []config.Prompt) error {
 	_, _ = fmt.Fprintf(out, "Prompts (%d):\n\n", len(prompts))
 
 	for _, prompt := range prompts {
-		_, _ = fmt.Fprintf(out, "  %s\n", prompt.ID)
-
-		if verbose {
-			if prompt.Description != "" {
-				_, _ = fmt.Fprintf(out, "    Description: %s\n", prompt.Description)
-			}
-			if len(prompt.Tags) > 0 {
-				_, _ = fmt.Fprintf(out, "    Tags:        %s\n", strings.Join(prompt.Tags, ", "))
-			}
-			_, _ = fmt.Fprintln(out)
-		}
+		writePromptEntry(out, prompt)
 	}
 
 	// Show built-in init prompt
@@ -127,6 +175,63 @@ func listPromptsWithBuiltin(cmd *cobra.Command, prompts []config.Prompt) error {
 	return nil
 }
 
+// listTaggedPrompts displays prompts selected by --tag. Built-in prompts are
+// untagged, so they are never included.
+func listTaggedPrompts(cmd *cobra.Command, prompts []config.Prompt) error {
+	out := cmd.OutOrStdout()
+
+	if jsonOutput {
+		jsonBytes, err := json.MarshalIndent(promptJSONItems(prompts), "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		_, err = fmt.Fprintf(out, "%s\n", string(jsonBytes))
+		return err
+	}
+
+	if len(prompts) == 0 {
+		_, _ = fmt.Fprintf(out, "No prompts tagged %s\n", strings.Join(promptTags, " or "))
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(out, "Prompts (%d):\n\n", len(prompts))
+	for _, prompt := range prompts {
+		writePromptEntry(out, prompt)
+	}
+	return nil
+}
+
+// filterPromptsByTags returns the prompts with any of the given tags, in
+// config order.
+func filterPromptsByTags(prompts []config.Prompt, tags []string) []config.Prompt {
+	var filtered []config.Prompt
+	for _, prompt := range prompts {
+		for _, tag := range tags {
+			if slices.Contains(prompt.Tags, tag) {
+				filtered = append(filtered, prompt)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// writePromptEntry writes a prompt's line in the human-readable list, with
+// its description and tags in verbose mode.
+func writePromptEntry(out io.Writer, prompt config.Prompt) {
+	_, _ = fmt.Fprintf(out, "  %s\n", prompt.ID)
+
+	if verbose {
+		if prompt.Description != "" {
+			_, _ = fmt.Fprintf(out, "    Description: %s\n", prompt.Description)
+		}
+		if len(prompt.Tags) > 0 {
+			_, _ = fmt.Fprintf(out, "    Tags:        %s\n", strings.Join(prompt.Tags, ", "))
+		}
+		_, _ = fmt.Fprintln(out)
+	}
+}
+
 // outputBuiltinPromptsJSON outputs only built-in prompts in JSON format
 func outputBuiltinPromptsJSON(out io.Writer) error {
 	jsonPrompts := []map[string]interface{}{
@@ -148,21 +253,7 @@ func outputBuiltinPromptsJSON(out io.Writer) error {
 
 // outputPromptsJSON outputs prompts in JSON format including built-in prompts
 func outputPromptsJSON(out io.Writer, prompts []config.Prompt) error {
-	// Create JSON-friendly output with only essential fields (omit Content for list)
-	jsonPrompts := make([]map[string]interface{}, 0, len(prompts)+1)
-
-	for _, prompt := range prompts {
-		item := map[string]interface{}{
-			"id": prompt.ID,
-		}
-		if prompt.Description != "" {
-			item["description"] = prompt.Description
-		}
-		if len(prompt.Tags) > 0 {
-			item["tags"] = prompt.Tags
-		}
-		jsonPrompts = append(jsonPrompts, item)
-	}
+	jsonPrompts := promptJSONItems(prompts)
 
 	// Add built-in init prompt
 	jsonPrompts = append(jsonPrompts, map[string]interface{}{
@@ -179,3 +270,23 @@ func outputPromptsJSON(out io.Writer, prompts []config.Prompt) error {
 	_, err = fmt.Fprintf(out, "%s\n", string(jsonBytes))
 	return err
 }
+
+// promptJSONItems converts prompts to JSON list items.
+func promptJSONItems(prompts []config.Prompt) []map[string]interface{} {
+	// Create JSON-friendly output with only essential fields (omit Content for list)
+	jsonPrompts := make([]map[string]interface{}, 0, len(prompts)+1)
+
+	for _, prompt := range prompts {
+		item := map[string]interface{}{
+			"id": prompt.ID,
+		}
+		if prompt.Description != "" {
+			item["description"] = prompt.Description
+		}
+		if len(prompt.Tags) > 0 {
+			item["tags"] = prompt.Tags
+		}
+		jsonPrompts = append(jsonPrompts, item)
+	}
+	return jsonPrompts
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -661,3 +662,148 @@ checks:
 		t.Errorf("expected built_in: true in JSON output")
 	}
 }
+
+func TestRunPrompt_TemplatedContent(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"go.mod":        "module example.com/shop\n\ngo 1.22\n",
+		"main.go":       "package main\n",
+		".golangci.yml": "linters: {}\n",
+		"vibeguard.yaml": `version: "1"
+prompts:
+  - id: setup
+    content: "This is a {{.Type}} project ({{.Name}}) using {{.Tools}}."
+  - id: static
+    content: "No template here."
+  - id: example
+    content: "Suggest: Coverage is {{.coverage}}%"
+checks:
+  - id: test
+    run: "true"
+`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	oldConfig := configFile
+	defer func() { configFile = oldConfig }()
+	configFile = filepath.Join(tmpDir, "vibeguard.yaml")
+
+	tests := []struct {
+		id   string
+		want string
+	}{
+		// Fields expand against the config's project, not the current directory
+		{"setup", "This is a go project (example.com/shop) using golangci-lint"},
+		{"static", "No template here."},
+		// Content that fails to render is printed as written
+		{"example", "Suggest: Coverage is {{.coverage}}%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			var buf bytes.Buffer
+			promptCmd.SetOut(&buf)
+			defer promptCmd.SetOut(nil)
+
+			if err := runPrompt(promptCmd, []string{tt.id}); err != nil {
+				t.Fatalf("runPrompt failed: %v", err)
+			}
+			if !strings.HasPrefix(buf.String(), tt.want) {
+				t.Errorf("expected output starting with %q, got %q", tt.want, buf.String())
+			}
+		})
+	}
+}
+
+func TestRunPrompt_TagFilter(t *testing.T) {
+	configContent := `version: "1"
+prompts:
+  - id: init
+    description: "Guidance for initializing vibeguard configuration"
+    content: |
+      You are an expert in helping users set up VibeGuard.
+    tags: [setup, initialization, guidance]
+  - id: code-review
+    description: "System prompt for code review assistance"
+    content: |
+      You are an expert code reviewer.
+    tags: [review, quality]
+  - id: security-audit
+    description: "Security-focused code analysis"
+    content: |
+      You are a security auditor.
+    tags: [security, audit]
+checks:
+  - id: test
+    run: "true"
+`
+	configPath := filepath.Join(t.TempDir(), "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig, oldVerbose, oldJSON, oldTags := configFile, verbose, jsonOutput, promptTags
+	defer func() {
+		configFile, verbose, jsonOutput, promptTags = oldConfig, oldVerbose, oldJSON, oldTags
+	}()
+	configFile = configPath
+	verbose = false
+
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{"single tag", []string{"security"}, []string{"security-audit"}},
+		{"any of several tags", []string{"security", "review"}, []string{"code-review", "security-audit"}},
+		{"no match", []string{"performance"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			promptTags = tt.tags
+
+			// JSON lists exactly the matching prompts, without built-ins
+			jsonOutput = true
+			var buf bytes.Buffer
+			promptCmd.SetOut(&buf)
+			defer promptCmd.SetOut(nil)
+			if err := runPrompt(promptCmd, nil); err != nil {
+				t.Fatalf("runPrompt failed: %v", err)
+			}
+			var result []map[string]interface{}
+			if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+				t.Fatalf("failed to parse JSON output: %v, output: %s", err, buf.String())
+			}
+			var ids []string
+			for _, prompt := range result {
+				ids = append(ids, prompt["id"].(string))
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("expected prompts %v, got %v", tt.want, ids)
+			}
+
+			// Text output agrees
+			jsonOutput = false
+			buf.Reset()
+			if err := runPrompt(promptCmd, nil); err != nil {
+				t.Fatalf("runPrompt failed: %v", err)
+			}
+			output := buf.String()
+			if len(tt.want) == 0 {
+				if !strings.Contains(output, "No prompts tagged performance") {
+					t.Errorf("expected no-match message, got: %s", output)
+				}
+				return
+			}
+			if !strings.Contains(output, fmt.Sprintf("Prompts (%d):", len(tt.want))) {
+				t.Errorf("expected %d prompts, got: %s", len(tt.want), output)
+			}
+			if strings.Contains(output, "init") {
+				t.Errorf("expected init to be filtered out, got: %s", output)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vibeguard/vibeguard/internal/cli/inspector"
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+	"github.com/vibeguard/vibeguard/internal/output"
+)
+
+var (
+	recursive         bool
+	recursiveMaxDepth int
+)
+
+func init() {
+	checkCmd.Flags().BoolVar(&recursive, "recursive", false, "Run every config file found in the current directory and below, each from its own directory")
+	checkCmd.Flags().IntVar(&recursiveMaxDepth, "max-depth", -1, "With --recursive, search at most this many directory levels below the current one (-1: no limit)")
+}
+
+// runRecursive runs the checks of every config file findConfigFiles finds
+// below the current directory, one config after another, and reports the
+// results of each. The exit code is the highest of any config.
+func runRecursive(cmd *cobra.Command, args []string, format string) error {
+	switch {
+	case len(args) > 0:
+		return fmt.Errorf("--recursive cannot be combined with a check ID argument")
+	case len(selectedChecks) > 0:
+		return fmt.Errorf("--recursive cannot be combined with --check")
+	case configFile != "":
+		return fmt.Errorf("--recursive cannot be combined with --config")
+	case baselineFile != "":
+		return fmt.Errorf("--recursive cannot be combined with --baseline")
+	case dryRun:
+		return fmt.Errorf("--recursive cannot be combined with --dry-run")
+	case profileFile != "":
+		return fmt.Errorf("--recursive cannot be combined with --profile")
+	}
+	if format != formatText && format != formatJSON && format != formatGitHub {
+		return fmt.Errorf("--recursive supports the %s, %s and %s formats, got %q", formatText, formatJSON, formatGitHub, format)
+	}
+
+	paths, err := findConfigFiles(".", recursiveMaxDepth)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return &config.ConfigError{Message: fmt.Sprintf("no config file found in the current directory or below (tried: %v)", config.ConfigFileNames)}
+	}
+
+	// Load every config first so an invalid one fails before any check runs
+	cfgs := make([]*config.Config, len(paths))
+	for i, path := range paths {
+		if cfgs[i], err = loadConfigFrom(path); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	results := make([]output.ConfigResult, 0, len(cfgs))
+	startTimes := make([]time.Time, 0, len(cfgs))
+	exitCode := 0
+	for i, cfg := range cfgs {
+		orch, err := newCheckOrchestrator(cfg, format, recursiveLogDir(paths[i]))
+		if err != nil {
+			return fmt.Errorf("%s: %w", paths[i], err)
+		}
+		startedAt := time.Now()
+		result, err := executeChecks(orch, format, "")
+		if err != nil {
+			return fmt.Errorf("%s: %w", paths[i], err)
+		}
+		results = append(results, output.ConfigResult{Config: paths[i], Result: result})
+		startTimes = append(startTimes, startedAt)
+		exitCode = max(exitCode, result.ExitCode)
+	}
+
+	write := func(out io.Writer) error {
+		return writeRecursiveReport(out, format, results, exitCode)
+	}
+	if outputFile != "" {
+		if err := writeFileAtomic(outputFile, write); err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "%s\nReport written to %s\n", recursiveSummary(results), outputFile)
+	} else if err := write(reportOutput(cmd, format)); err != nil {
+		return err
+	}
+
+	// Record history after the report so a database error cannot hide it
+	if historyDB != "" {
+		for i, r := range results {
+			if err := recordHistory(historyDB, r.Result, startTimes[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if exitCode != 0 {
+		return &ExitError{Code: exitCode}
+	}
+	return nil
+}
+
+// findConfigFiles returns the config files in root and the directories below
+// it, at most maxDepth levels down (no limit if maxDepth is negative), in
+// walk order. Each directory contributes the first of config.ConfigFileNames
+// it contains, the one FindConfigFile would pick there. Directories the
+// inspector skips, such as node_modules and vendor, and paths the root
+// .gitignore ignores are not searched.
+func findConfigFiles(root string, maxDepth int) ([]string, error) {
+	filter := inspector.NewTreeFilter(root)
+	var paths []string
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+		if filter.Skip(path, true) {
+			return filepath.SkipDir
+		}
+		if rel, err := filepath.Rel(root, path); err == nil && rel != "." && maxDepth >= 0 && strings.Count(rel, string(filepath.Separator)) >= maxDepth {
+			return filepath.SkipDir
+		}
+		for _, name := range config.ConfigFileNames {
+			candidate := filepath.Join(path, name)
+			if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+				if !filter.Skip(candidate, false) {
+					paths = append(paths, candidate)
+				}
+				break
+			}
+		}
+		return nil
+	})
+	return paths, err
+}
+
+// recursiveLogDir returns the directory for the check output logs of the
+// config at configPath: its own .vibeguard/log by default, or a directory
+// mirroring its location under --log-dir, so checks with the same ID in
+// different configs don't overwrite each other's logs.
+func recursiveLogDir(configPath string) string {
+	if logDir == "" {
+		return filepath.Join(filepath.Dir(configPath), orchestrator.DefaultLogDir)
+	}
+	return filepath.Join(logDir, filepath.Dir(configPath))
+}
+
+// writeRecursiveReport writes the results of several configs: one JSON
+// document, or a section per config headed by its path followed by the
+// totals of all of them.
+func writeRecursiveReport(out io.Writer, format string, results []output.ConfigResult, exitCode int) error {
+	if format == formatJSON {
+		return output.FormatRecursiveJSON(out, results, exitCode)
+	}
+	for _, r := range results {
+		if _, err := fmt.Fprintf(out, "== %s ==\n", r.Config); err != nil {
+			return err
+		}
+		if err := writeReport(out, format, r.Result); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(out); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(out, recursiveSummary(results))
+	return err
+}
+
+// recursiveSummary renders the totals of several configs as a single line.
+func recursiveSummary(results []output.ConfigResult) string {
+	var summary orchestrator.Summary
+	failed := 0
+	for _, r := range results {
+		summary.Add(r.Result.Summary())
+		if r.Result.ExitCode != 0 {
+			failed++
+		}
+	}
+	noun := "configs"
+	if len(results) == 1 {
+		noun = "config"
+	}
+	return fmt.Sprintf("%d %s (%d failed): %s", len(results), noun, failed, output.FormatSummary(summary))
+}
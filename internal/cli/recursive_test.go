@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/output"
+)
+
+// writeMonorepo creates a repository with a passing config in pkg/a, a
+// failing one in pkg/b, and configs in an ignored and a vendored directory.
+// Each check only passes when it runs from its own config's directory.
+func writeMonorepo(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	files := map[string]string{
+		".gitignore":                      "generated/\n",
+		"pkg/a/vibeguard.yaml":            "version: \"1\"\nchecks:\n  - id: test\n    run: test -f a.txt\n",
+		"pkg/a/a.txt":                     "",
+		"pkg/b/vibeguard.yaml":            "version: \"1\"\nchecks:\n  - id: test\n    run: test -f a.txt\n",
+		"generated/vibeguard.yaml":        "version: \"1\"\nchecks:\n  - id: ignored\n    run: \"false\"\n",
+		"node_modules/dep/vibeguard.yaml": "version: \"1\"\nchecks:\n  - id: vendored\n    run: \"false\"\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestFindConfigFiles(t *testing.T) {
+	t.Chdir(writeMonorepo(t))
+
+	paths, err := findConfigFiles(".", -1)
+	if err != nil {
+		t.Fatalf("findConfigFiles failed: %v", err)
+	}
+	want := []string{filepath.Join("pkg", "a", "vibeguard.yaml"), filepath.Join("pkg", "b", "vibeguard.yaml")}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("expected %v, got %v", want, paths)
+	}
+
+	// pkg/a and pkg/b are two levels down
+	if paths, err = findConfigFiles(".", 1); err != nil || len(paths) != 0 {
+		t.Errorf("expected no configs within one level, got %v (err: %v)", paths, err)
+	}
+}
+
+func TestRunCheck_RecursiveJSON(t *testing.T) {
+	t.Chdir(writeMonorepo(t))
+	reportPath := filepath.Join(t.TempDir(), "report.json")
+
+	oldRecursive, oldDepth, oldFormat, oldOutput, oldLogDir := recursive, recursiveMaxDepth, outputFormat, outputFile, logDir
+	defer func() {
+		recursive, recursiveMaxDepth, outputFormat, outputFile, logDir = oldRecursive, oldDepth, oldFormat, oldOutput, oldLogDir
+	}()
+	recursive = true
+	recursiveMaxDepth = -1
+	outputFormat = "json"
+	outputFile = reportPath
+	logDir = ""
+
+	err := runCheck(checkCmd, []string{})
+	exitErr, ok := err.(*ExitError)
+	if !ok || exitErr.Code != 1 {
+		t.Fatalf("expected ExitError with code 1, got %v", err)
+	}
+
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("expected report file to be written: %v", err)
+	}
+	var report output.JSONRecursiveOutput
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("report is not valid JSON: %v\n%s", err, data)
+	}
+	if report.ExitCode != 1 || len(report.Configs) != 2 {
+		t.Fatalf("expected exit code 1 and two configs, got %d and %d", report.ExitCode, len(report.Configs))
+	}
+	exitCodes := make(map[string]int)
+	for _, c := range report.Configs {
+		exitCodes[filepath.ToSlash(c.Config)] = c.Report.ExitCode
+	}
+	if exitCodes["pkg/a/vibeguard.yaml"] != 0 || exitCodes["pkg/b/vibeguard.yaml"] != 1 {
+		t.Errorf("expected pkg/a to pass and pkg/b to fail, got %v", exitCodes)
+	}
+	if report.Summary.Total != 2 || report.Summary.Passed != 1 || report.Summary.Failed != 1 {
+		t.Errorf("unexpected totals: %+v", report.Summary)
+	}
+
+	// Each config keeps its own logs
+	if _, err := os.Stat(filepath.Join("pkg", "b", ".vibeguard", "log", "test.log")); err != nil {
+		t.Errorf("expected pkg/b's log in its own directory: %v", err)
+	}
+}
+
+func TestWriteRecursiveReport_Text(t *testing.T) {
+	t.Chdir(writeMonorepo(t))
+
+	oldVerbose := verbose
+	defer func() { verbose = oldVerbose }()
+	verbose = false
+
+	var results []output.ConfigResult
+	for _, path := range []string{filepath.Join("pkg", "a", "vibeguard.yaml"), filepath.Join("pkg", "b", "vibeguard.yaml")} {
+		cfg, err := loadConfigFrom(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		orch, err := newCheckOrchestrator(cfg, formatText, t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := executeChecks(orch, formatText, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, output.ConfigResult{Config: path, Result: result})
+	}
+
+	var buf bytes.Buffer
+	if err := writeRecursiveReport(&buf, formatText, results, 1); err != nil {
+		t.Fatalf("writeRecursiveReport failed: %v", err)
+	}
+	text := buf.String()
+	for _, want := range []string{"== " + results[0].Config + " ==", "== " + results[1].Config + " ==", "2 configs (1 failed): 2 checks: 1 passed, 1 failed"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, text)
+		}
+	}
+}
+
+func TestRunCheck_RecursiveRejectsCheckID(t *testing.T) {
+	oldRecursive := recursive
+	defer func() { recursive = oldRecursive }()
+	recursive = true
+
+	err := runCheck(checkCmd, []string{"test"})
+	if err == nil || !strings.Contains(err.Error(), "--recursive cannot be combined with a check ID argument") {
+		t.Errorf("expected check ID error, got %v", err)
+	}
+}
@@ -3,8 +3,13 @@ package cli
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
 	"github.com/vibeguard/vibeguard/internal/version"
 )
 
@@ -13,11 +18,14 @@ var (
 	configFile    string
 	verbose       bool
 	jsonOutput    bool
-	parallel      int
+	parallel      string
 	failFast      bool
+	failFastMode  orchestrator.FailFastMode
 	showVersion   bool
 	logDir        string
 	errorExitCode int
+	varFlags      []string
+	noColor       bool
 )
 
 // rootCmd is the base command for vibeguard
@@ -58,13 +66,98 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&configFile, "config", "c", "", "Path to config file")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Show all check results, not just failures")
 	rootCmd.PersistentFlags().BoolVar(&jsonOutput, "json", false, "Output in JSON format")
-	rootCmd.PersistentFlags().IntVarP(&parallel, "parallel", "p", 4, "Max parallel checks")
-	rootCmd.PersistentFlags().BoolVar(&failFast, "fail-fast", false, "Stop on first failure")
+	rootCmd.PersistentFlags().StringVarP(&parallel, "parallel", "p", "", "Max parallel checks: a number or auto for one per CPU (default: config parallel, or 4)")
+	rootCmd.PersistentFlags().VarPF(failFastValue{}, "fail-fast", "", "Stop on first failure; =cancel also kills checks still running").NoOptDefVal = "true"
 	rootCmd.PersistentFlags().StringVar(&logDir, "log-dir", "", "Directory for check output logs (default: .vibeguard/log)")
-	rootCmd.PersistentFlags().IntVar(&errorExitCode, "error-exit-code", 1, "Exit code for check failures and timeouts")
+	rootCmd.PersistentFlags().IntVar(&errorExitCode, "error-exit-code", executor.ExitCodeFailure, "Exit code for check failures and timeouts")
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colored output (also disabled by the NO_COLOR environment variable and when output isn't a terminal)")
+	rootCmd.PersistentFlags().StringArrayVar(&varFlags, "var", nil, "Set a variable for interpolation as NAME=VALUE, overriding .env and config vars (repeatable)")
+}
+
+// resolveParallel returns the maximum number of parallel checks: --parallel
+// if set, otherwise the config's parallel setting, otherwise the default.
+func resolveParallel(cfg *config.Config) (int, error) {
+	if parallel != "" {
+		n, err := config.ResolveParallel(parallel)
+		if err != nil {
+			return 0, &config.ConfigError{Message: fmt.Sprintf("invalid --parallel: %v", err)}
+		}
+		return n, nil
+	}
+	if cfg.Parallel != "" {
+		// Validated when the config was loaded
+		return config.ResolveParallel(cfg.Parallel)
+	}
+	return config.DefaultParallel, nil
+}
+
+// loadConfig loads the configuration file with the variables set by --var.
+func loadConfig() (*config.Config, error) {
+	return loadConfigFrom(configFile)
+}
+
+// loadConfigFrom loads the configuration file at path with the variables
+// set by --var. An empty path searches the default locations.
+func loadConfigFrom(path string) (*config.Config, error) {
+	vars := make(map[string]string, len(varFlags))
+	for _, v := range varFlags {
+		name, value, err := config.ParseVar(v)
+		if err != nil {
+			return nil, &config.ConfigError{Message: "invalid --var", Cause: err}
+		}
+		vars[name] = value
+	}
+	return config.LoadWithVars(path, vars)
+}
+
+// projectDir returns the directory of the config file set by --config or
+// found by config.FindConfigFile, or "" (the current directory) if there is
+// none.
+func projectDir() string {
+	path := configFile
+	if path == "" {
+		var err error
+		if path, err = config.FindConfigFile(); err != nil {
+			return ""
+		}
+	}
+	return filepath.Dir(path)
 }
 
 // GetErrorExitCode returns the configured error exit code
 func GetErrorExitCode() int {
 	return errorExitCode
 }
+
+// failFastValue implements --fail-fast. It works as a boolean flag and also
+// accepts a mode: level (the same as true) lets running checks finish, and
+// cancel kills them.
+type failFastValue struct{}
+
+func (failFastValue) String() string {
+	if !failFast {
+		return "false"
+	}
+	if failFastMode == "" {
+		return string(orchestrator.FailFastLevel)
+	}
+	return string(failFastMode)
+}
+
+func (failFastValue) Set(s string) error {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", string(orchestrator.FailFastLevel):
+		failFast, failFastMode = true, orchestrator.FailFastLevel
+	case string(orchestrator.FailFastCancel):
+		failFast, failFastMode = true, orchestrator.FailFastCancel
+	case "false":
+		failFast, failFastMode = false, ""
+	default:
+		return fmt.Errorf("invalid mode %q (valid modes: level, cancel)", s)
+	}
+	return nil
+}
+
+func (failFastValue) Type() string {
+	return "mode"
+}
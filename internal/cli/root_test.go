@@ -1,7 +1,16 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
 )
 
 func TestExitError_Error(t *testing.T) {
@@ -49,3 +58,134 @@ func TestExecute_WithNoArgs(t *testing.T) {
 	// This is a smoke test to ensure Execute() doesn't panic
 	// We can't easily test the full CLI without side effects
 }
+
+func TestLoadConfig_VarFlag(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "vibeguard.yaml")
+	configContent := `version: "1"
+vars:
+  packages: ./...
+checks:
+  - id: test
+    run: go test {{.packages}}
+`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig, oldVars := configFile, varFlags
+	defer func() { configFile, varFlags = oldConfig, oldVars }()
+	configFile = configPath
+
+	varFlags = []string{"packages=./internal/..."}
+	cfg, err := loadConfig()
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+	if got, want := cfg.Checks[0].Run, "go test ./internal/..."; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	varFlags = []string{"packages"}
+	if _, err := loadConfig(); !config.IsConfigError(err) {
+		t.Errorf("expected ConfigError for malformed --var, got: %v", err)
+	}
+}
+
+func TestFailFastFlag(t *testing.T) {
+	oldFailFast, oldMode := failFast, failFastMode
+	defer func() { failFast, failFastMode = oldFailFast, oldMode }()
+
+	tests := []struct {
+		args     []string
+		wantOn   bool
+		wantMode orchestrator.FailFastMode
+		wantErr  bool
+	}{
+		{args: []string{"--fail-fast"}, wantOn: true, wantMode: orchestrator.FailFastLevel},
+		{args: []string{"--fail-fast=level"}, wantOn: true, wantMode: orchestrator.FailFastLevel},
+		{args: []string{"--fail-fast=cancel"}, wantOn: true, wantMode: orchestrator.FailFastCancel},
+		{args: []string{"--fail-fast=false"}, wantOn: false},
+		{args: []string{"--fail-fast=later"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.args[0], func(t *testing.T) {
+			failFast, failFastMode = false, ""
+			err := rootCmd.PersistentFlags().Parse(tt.args)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error for invalid mode")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if failFast != tt.wantOn || failFastMode != tt.wantMode {
+				t.Errorf("expected on=%v mode=%q, got on=%v mode=%q", tt.wantOn, tt.wantMode, failFast, failFastMode)
+			}
+		})
+	}
+}
+
+func TestResolveParallel(t *testing.T) {
+	oldParallel := parallel
+	defer func() { parallel = oldParallel }()
+
+	tests := []struct {
+		name    string
+		flag    string
+		config  string
+		want    int
+		wantErr bool
+	}{
+		{name: "default", want: config.DefaultParallel},
+		{name: "config default", config: "2", want: 2},
+		{name: "config auto", config: "auto", want: runtime.NumCPU()},
+		{name: "flag overrides config", flag: "1", config: "8", want: 1},
+		{name: "flag auto", flag: "auto", config: "2", want: runtime.NumCPU()},
+		{name: "invalid flag", flag: "0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parallel = tt.flag
+			got, err := resolveParallel(&config.Config{Parallel: tt.config})
+			if tt.wantErr {
+				if !config.IsConfigError(err) {
+					t.Errorf("expected ConfigError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestExitCodeFor(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"no error", nil, executor.ExitCodeSuccess},
+		{"exit error", &ExitError{Code: 42}, 42},
+		{"config error", &config.ConfigError{Message: "bad"}, executor.ExitCodeConfigError},
+		{"wrapped config error", fmt.Errorf("validation failed: %w", &config.ConfigError{Message: "bad"}), executor.ExitCodeConfigError},
+		{"other error", errors.New("boom"), executor.ExitCodeFailure},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCodeFor(tt.err); got != tt.want {
+				t.Errorf("ExitCodeFor() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print the JSON Schema of the configuration file",
+	Long: `Print a JSON Schema describing the vibeguard configuration format.
+
+Point your editor's YAML language server at the schema to get completion
+and validation while editing vibeguard.yaml. The schema is generated from
+the configuration structs, so it always matches this version of vibeguard.
+
+Examples:
+  vibeguard schema > vibeguard.schema.json`,
+	Args: cobra.NoArgs,
+	RunE: runSchema,
+}
+
+func init() {
+	rootCmd.AddCommand(schemaCmd)
+}
+
+func runSchema(cmd *cobra.Command, args []string) error {
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(config.JSONSchema())
+}
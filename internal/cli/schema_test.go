@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestRunSchema(t *testing.T) {
+	var buf bytes.Buffer
+	rootCmd.SetOut(&buf)
+	defer rootCmd.SetOut(nil)
+
+	if err := runSchema(schemaCmd, nil); err != nil {
+		t.Fatalf("runSchema failed: %v", err)
+	}
+
+	var schema map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &schema); err != nil {
+		t.Fatalf("schema is not valid JSON: %v\n%s", err, buf.String())
+	}
+	if schema["title"] != "VibeGuard configuration" {
+		t.Errorf("unexpected schema title: %v", schema["title"])
+	}
+	if _, ok := schema["properties"].(map[string]any)["checks"]; !ok {
+		t.Error("expected checks property in schema")
+	}
+}
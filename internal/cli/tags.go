@@ -5,8 +5,6 @@ import (
 	"sort"
 
 	"github.com/spf13/cobra"
-
-	"github.com/vibeguard/vibeguard/internal/config"
 )
 
 var tagsCmd = &cobra.Command{
@@ -29,7 +27,7 @@ func init() {
 
 func runTags(cmd *cobra.Command, args []string) error {
 	// Load configuration
-	cfg, err := config.Load(configFile)
+	cfg, err := loadConfig()
 	if err != nil {
 		return err
 	}
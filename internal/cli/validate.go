@@ -5,29 +5,59 @@ import (
 
 	"github.com/spf13/cobra"
 
-	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/output"
 )
 
+var dumpResolvedChecks string
+
 var validateCmd = &cobra.Command{
-	Use:   "validate",
+	Use:   "validate [path]",
 	Short: "Validate configuration",
 	Long: `Validate the vibeguard configuration file without running any checks.
 
-This command is useful for CI/CD pipelines to catch configuration errors early.`,
+This command is useful for CI/CD pipelines to catch configuration errors early.
+The configuration is read from path if given, otherwise from --config or the
+default locations. An invalid configuration exits with code 2 and reports the
+offending line where possible.
+
+With --dump-resolved-checks json, the validated check set is printed to stdout
+after defaults and variable interpolation have been applied, including each
+check's resolved dependencies. This gives AI agents an exact view of the
+active policy without running anything.
+
+Examples:
+  vibeguard validate
+  vibeguard validate ci/vibeguard.yaml
+  vibeguard validate --dump-resolved-checks json`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runValidate,
 }
 
 func init() {
 	rootCmd.AddCommand(validateCmd)
+	validateCmd.Flags().StringVar(&dumpResolvedChecks, "dump-resolved-checks", "", "Print the resolved check definitions in the given format (json)")
 }
 
 func runValidate(cmd *cobra.Command, args []string) error {
+	path := configFile
+	if len(args) > 0 {
+		path = args[0]
+	}
+
 	// Load and validate configuration (Load already validates)
-	cfg, err := config.Load(configFile)
+	cfg, err := loadConfigFrom(path)
 	if err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
 
+	// Dump resolved definitions instead of the summary
+	if dumpResolvedChecks != "" {
+		if dumpResolvedChecks != formatJSON {
+			return fmt.Errorf("unsupported --dump-resolved-checks format %q (supported: json)", dumpResolvedChecks)
+		}
+		return output.FormatResolvedChecks(cmd.OutOrStdout(), cfg)
+	}
+
 	// Print validation success
 	fmt.Printf("Configuration is valid (%d checks defined)\n", len(cfg.Checks))
 
@@ -2,10 +2,13 @@ package cli
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/output"
 )
 
 func TestRunValidate_ValidConfig(t *testing.T) {
@@ -317,3 +320,93 @@ checks:
 		t.Fatal("expected error for duplicate check ID")
 	}
 }
+
+func TestRunValidate_DumpResolvedChecks(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `version: "1"
+vars:
+  packages: "./..."
+checks:
+  - id: vet
+    run: go vet {{.packages}}
+    severity: error
+  - id: test
+    run: go test {{.packages}}
+    requires: [vet]
+    severity: error
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig := configFile
+	oldDump := dumpResolvedChecks
+	defer func() {
+		configFile = oldConfig
+		dumpResolvedChecks = oldDump
+		validateCmd.SetOut(nil)
+	}()
+
+	configFile = configPath
+	dumpResolvedChecks = "json"
+
+	var buf bytes.Buffer
+	validateCmd.SetOut(&buf)
+
+	if err := runValidate(validateCmd, []string{}); err != nil {
+		t.Fatalf("runValidate failed: %v", err)
+	}
+
+	var resolved output.ResolvedChecksOutput
+	if err := json.Unmarshal(buf.Bytes(), &resolved); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, buf.String())
+	}
+	if len(resolved.Checks) != 2 {
+		t.Fatalf("expected 2 checks, got %d", len(resolved.Checks))
+	}
+	if resolved.Checks[1].Run != "go test ./..." {
+		t.Errorf("expected interpolated command, got %q", resolved.Checks[1].Run)
+	}
+	if strings.Join(resolved.Checks[1].AllRequires, ",") != "vet" {
+		t.Errorf("expected test to depend on vet, got %v", resolved.Checks[1].AllRequires)
+	}
+
+	dumpResolvedChecks = "yaml"
+	if err := runValidate(validateCmd, []string{}); err == nil {
+		t.Error("expected error for unsupported dump format")
+	}
+}
+
+func TestRunValidate_PathArgument(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "ci.yaml")
+	configContent := `version: "1"
+checks:
+  - id: ok
+    run: "true"
+  - id: bad
+    run: "true"
+    severity: critical
+`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig := configFile
+	defer func() { configFile = oldConfig }()
+	configFile = "/nonexistent/vibeguard.yaml"
+
+	// The path argument takes precedence over --config
+	err := runValidate(validateCmd, []string{configPath})
+	if err == nil {
+		t.Fatal("expected error for invalid severity")
+	}
+	if ExitCodeFor(err) != 2 {
+		t.Errorf("expected exit code 2, got %d", ExitCodeFor(err))
+	}
+	if !strings.Contains(err.Error(), "(line 5)") {
+		t.Errorf("expected line number in error, got: %v", err)
+	}
+}
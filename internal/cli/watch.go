@@ -0,0 +1,219 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+	"github.com/vibeguard/vibeguard/internal/watch"
+)
+
+// clearScreen moves the cursor home and clears the terminal.
+const clearScreen = "\033[H\033[2J"
+
+var watchDebounce time.Duration
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Re-run checks when files change",
+	Long: `Watch the config file's directory and re-run checks whenever files change.
+
+All checks run once at startup. After that, each batch of changes (debounced
+so a burst of saves triggers a single run) re-runs only the checks affected
+by the changed files: checks whose paths globs match a changed file, checks
+without paths, and the checks they require. Editing the config file re-runs
+every check.
+
+Version control metadata (.git), dependency caches (node_modules, vendor) and
+common build output directories (bin, build, dist, target) are ignored.
+
+Examples:
+  vibeguard watch                   Watch and re-run affected checks
+  vibeguard watch --tags fast       Only re-run checks tagged 'fast'
+  vibeguard watch --debounce 1s     Wait 1s after the last change`,
+	Args: cobra.NoArgs,
+	RunE: runWatch,
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+	watchCmd.Flags().StringSliceVar(&tags, "tags", nil, "Run checks matching ANY of these tags (comma-separated)")
+	watchCmd.Flags().StringSliceVar(&excludeTags, "exclude-tags", nil, "Exclude checks matching ANY of these tags (comma-separated)")
+	watchCmd.Flags().DurationVar(&watchDebounce, "debounce", watch.DefaultDebounce, "Wait this long after the last change before re-running")
+}
+
+func runWatch(cmd *cobra.Command, args []string) error {
+	// Validate the configuration up front so obvious errors fail fast
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	// Watch the directory checks run in, so changed paths are relative to it
+	// like the config's paths globs, even when run from a subdirectory
+	configName := filepath.Base(cfg.Path())
+	w, err := watch.New(cfg.Dir(), watch.DefaultIgnoreDirs, watchDebounce)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = w.Close() }()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	out := cmd.OutOrStdout()
+	runWatchIteration(ctx, out, nil)
+
+	return w.Run(ctx, func(files []string) {
+		if ctx.Err() != nil {
+			return
+		}
+		if touchesConfig(files, configName) {
+			// Config changes can affect any check
+			files = nil
+		}
+		runWatchIteration(ctx, out, files)
+	})
+}
+
+// runWatchIteration clears the screen, runs the checks affected by the
+// changed files (all checks when changed is nil), and prints a summary.
+// Errors are reported rather than returned so watching continues.
+func runWatchIteration(ctx context.Context, out io.Writer, changed []string) {
+	_, _ = fmt.Fprint(out, clearScreen)
+	_, _ = fmt.Fprintf(out, "[%s] ", time.Now().Format("15:04:05"))
+	if changed == nil {
+		_, _ = fmt.Fprintln(out, "Running all checks")
+	} else {
+		_, _ = fmt.Fprintf(out, "Changed: %s\n", summarizeFiles(changed, 5))
+	}
+	_, _ = fmt.Fprintln(out)
+
+	// Reload configuration each iteration so edits take effect
+	cfg, err := loadConfig()
+	if err != nil {
+		_, _ = fmt.Fprintf(out, "Error: %v\n", err)
+		return
+	}
+
+	maxParallel, err := resolveParallel(cfg)
+	if err != nil {
+		_, _ = fmt.Fprintf(out, "Error: %v\n", err)
+		return
+	}
+
+	orch := orchestrator.New(cfg, executor.New(cfg.Dir()), maxParallel, failFast, verbose, logDir, GetErrorExitCode())
+	orch.SetFailFastMode(failFastMode)
+	if len(tags) > 0 || len(excludeTags) > 0 {
+		orch.SetTagFilter(orchestrator.TagFilter{
+			Include: tags,
+			Exclude: excludeTags,
+		})
+	}
+	if changed != nil {
+		orch.SetChangedFiles(changed)
+	}
+
+	result, err := orch.Run(ctx)
+	if err != nil {
+		_, _ = fmt.Fprintf(out, "Error: %v\n", err)
+		return
+	}
+
+	formatWatchSummary(out, result)
+	_, _ = fmt.Fprintln(out, "\nWatching for changes (Ctrl+C to stop)...")
+}
+
+// formatWatchSummary prints one line per affected check and a pass/fail
+// total. Checks skipped because no changed file matched their paths are
+// omitted.
+func formatWatchSummary(out io.Writer, result *orchestrator.RunResult) {
+	affected := make([]*orchestrator.CheckResult, 0, len(result.Results))
+	for _, r := range result.Results {
+		if !r.PathSkipped {
+			affected = append(affected, r)
+		}
+	}
+	if len(affected) == 0 {
+		_, _ = fmt.Fprintln(out, "No checks affected by these changes")
+		return
+	}
+
+	violationByID := make(map[string]*orchestrator.Violation, len(result.Violations))
+	for _, v := range result.Violations {
+		violationByID[v.CheckID] = v
+	}
+
+	passed, failed, warnings, infos, skipped := 0, 0, 0, 0, 0
+	for _, r := range affected {
+		symbol, label := "✓", ""
+		switch {
+		case r.Skipped || r.Execution.Cancelled:
+			symbol, label = "⊘", "skipped"
+			skipped++
+		case r.Passed:
+			passed++
+		case r.Execution.Timedout:
+			symbol, label = "✗", "timeout"
+			failed++
+		case violationByID[r.Check.ID] != nil && violationByID[r.Check.ID].Severity == config.SeverityWarning:
+			symbol, label = "!", "warning"
+			warnings++
+		case violationByID[r.Check.ID] != nil && violationByID[r.Check.ID].Severity == config.SeverityInfo:
+			symbol, label = "i", "info"
+			infos++
+		default:
+			symbol, label = "✗", "failed"
+			failed++
+		}
+
+		line := fmt.Sprintf("%s %-20s %6.1fs", symbol, r.Check.ID, r.Execution.Duration.Seconds())
+		if label != "" {
+			line += "  " + label
+		}
+		_, _ = fmt.Fprintln(out, line)
+	}
+
+	_, _ = fmt.Fprintf(out, "\n%d passed, %d failed", passed, failed)
+	if warnings > 0 {
+		_, _ = fmt.Fprintf(out, ", %d warnings", warnings)
+	}
+	if infos > 0 {
+		_, _ = fmt.Fprintf(out, ", %d info", infos)
+	}
+	if skipped > 0 {
+		_, _ = fmt.Fprintf(out, ", %d skipped", skipped)
+	}
+	_, _ = fmt.Fprintf(out, " (%.1fs)\n", result.Duration.Seconds())
+}
+
+// touchesConfig reports whether any changed file, relative to the config's
+// directory, is the config file configName or another vibeguard config file.
+func touchesConfig(files []string, configName string) bool {
+	for _, f := range files {
+		if f == configName || slices.Contains(config.ConfigFileNames, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// summarizeFiles joins up to max file names, noting how many were omitted.
+func summarizeFiles(files []string, max int) string {
+	if len(files) <= max {
+		return strings.Join(files, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(files[:max], ", "), len(files)-max)
+}
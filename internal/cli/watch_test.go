@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+func TestFormatWatchSummary(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{Check: &config.Check{ID: "fmt"}, Execution: &executor.Result{Duration: 100 * time.Millisecond}, Passed: true},
+			{Check: &config.Check{ID: "vet", Severity: config.SeverityError}, Execution: &executor.Result{ExitCode: 1}},
+			{Check: &config.Check{ID: "lint", Severity: config.SeverityWarning}, Execution: &executor.Result{ExitCode: 1}},
+			{Check: &config.Check{ID: "test"}, Execution: &executor.Result{ExitCode: -1}, Skipped: true},
+		},
+		Violations: []*orchestrator.Violation{
+			{CheckID: "vet", Severity: config.SeverityError},
+			{CheckID: "lint", Severity: config.SeverityWarning},
+			{CheckID: "test", Severity: config.SeverityError},
+		},
+		Duration: 1500 * time.Millisecond,
+	}
+
+	var buf bytes.Buffer
+	formatWatchSummary(&buf, result)
+	out := buf.String()
+
+	for _, want := range []string{
+		"✓ fmt",
+		"✗ vet",
+		"! lint",
+		"⊘ test",
+		"1 passed, 1 failed, 1 warnings, 1 skipped (1.5s)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected summary to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestFormatWatchSummary_NoChecks(t *testing.T) {
+	var buf bytes.Buffer
+	formatWatchSummary(&buf, &orchestrator.RunResult{})
+	if !strings.Contains(buf.String(), "No checks affected") {
+		t.Errorf("unexpected output: %s", buf.String())
+	}
+}
+
+func TestRunWatchIteration_RunsOnlyAffectedChecks(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `version: "1"
+checks:
+  - id: go-check
+    run: "true"
+    paths: ["**/*.go"]
+  - id: docs-check
+    run: "true"
+    paths: ["docs/**"]
+`
+	configPath := filepath.Join(tmpDir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	oldConfig := configFile
+	oldLogDir := logDir
+	defer func() {
+		configFile = oldConfig
+		logDir = oldLogDir
+	}()
+	configFile = configPath
+	logDir = filepath.Join(tmpDir, "logs")
+
+	var buf bytes.Buffer
+	runWatchIteration(context.Background(), &buf, []string{"internal/cli/watch.go"})
+	out := buf.String()
+
+	if !strings.HasPrefix(out, clearScreen) {
+		t.Error("expected output to start by clearing the screen")
+	}
+	if !strings.Contains(out, "Changed: internal/cli/watch.go") {
+		t.Errorf("expected changed files header, got:\n%s", out)
+	}
+	if !strings.Contains(out, "go-check") || strings.Contains(out, "docs-check") {
+		t.Errorf("expected only go-check to run, got:\n%s", out)
+	}
+	if !strings.Contains(out, "1 passed, 0 failed") {
+		t.Errorf("expected summary line, got:\n%s", out)
+	}
+}
+
+func TestTouchesConfig(t *testing.T) {
+	if !touchesConfig([]string{"main.go", "vibeguard.yaml"}, "vibeguard.yaml") {
+		t.Error("expected vibeguard.yaml to be detected as config change")
+	}
+	if touchesConfig([]string{"main.go"}, "vibeguard.yaml") {
+		t.Error("expected main.go not to be a config change")
+	}
+
+	// Paths are relative to the config's directory, not the working directory
+	if !touchesConfig([]string{"custom.yaml"}, "custom.yaml") {
+		t.Error("expected explicit config file to be detected")
+	}
+	if touchesConfig([]string{"sub/vibeguard.yaml"}, "vibeguard.yaml") {
+		t.Error("expected a config in a subdirectory not to be this config")
+	}
+}
+
+func TestSummarizeFiles(t *testing.T) {
+	if got := summarizeFiles([]string{"a", "b"}, 5); got != "a, b" {
+		t.Errorf("unexpected summary %q", got)
+	}
+	if got := summarizeFiles([]string{"a", "b", "c"}, 2); got != "a, b and 1 more" {
+		t.Errorf("unexpected summary %q", got)
+	}
+}
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// checkLocation is where a check is defined, for error messages.
+type checkLocation struct {
+	file string // Path relative to the config's directory
+	line int
+}
+
+func (l checkLocation) String() string {
+	if l.line > 0 {
+		return fmt.Sprintf("%s line %d", l.file, l.line)
+	}
+	return l.file
+}
+
+// loadChecksFiles appends the checks of the files matched by the checks_files
+// glob patterns to the config's, in pattern order and, per pattern, in
+// lexical order. Patterns are relative to the config's directory; a file is
+// read once even if several patterns match it, and the config file itself is
+// never read as a checks file. A checks file holds a mapping with just a
+// checks sequence: vars, prompts and settings stay in the main config. A
+// check ID defined in two files is an error naming both.
+func (c *Config) loadChecksFiles(configPath string) error {
+	if len(c.ChecksFiles) == 0 {
+		return nil
+	}
+	line := c.findTopLevelKeyLine("checks_files")
+
+	seen := map[string]bool{configPath: true}
+	var files []string
+	for _, pattern := range c.ChecksFiles {
+		abs := pattern
+		if !filepath.IsAbs(abs) {
+			abs = filepath.Join(c.dir, abs)
+		}
+		matches, err := filepath.Glob(abs)
+		if err != nil {
+			return &ConfigError{Message: fmt.Sprintf("invalid checks_files pattern %q", pattern), Cause: err, LineNum: line}
+		}
+		matched := false
+		for _, match := range matches {
+			if info, err := os.Stat(match); err != nil || info.IsDir() {
+				continue
+			}
+			matched = true
+			if !seen[match] {
+				seen[match] = true
+				files = append(files, match)
+			}
+		}
+		if !matched {
+			return &ConfigError{Message: fmt.Sprintf("checks_files pattern %q matches no files", pattern), LineNum: line}
+		}
+	}
+
+	// Where each check ID is first defined, to name both files of a duplicate
+	defined := make(map[string]checkLocation, len(c.Checks))
+	mainFile := filepath.Base(configPath)
+	for i, check := range c.Checks {
+		if _, ok := defined[check.ID]; !ok {
+			defined[check.ID] = checkLocation{file: mainFile, line: c.FindCheckNodeLine(check.ID, i)}
+		}
+	}
+
+	for _, path := range files {
+		rel, err := filepath.Rel(c.dir, path)
+		if err != nil {
+			rel = path
+		}
+		checks, lines, err := readChecksFile(path, rel)
+		if err != nil {
+			return err
+		}
+		for i, check := range checks {
+			here := checkLocation{file: rel, line: lines[i]}
+			if first, ok := defined[check.ID]; ok && check.ID != "" {
+				return &ConfigError{
+					Message:  fmt.Sprintf("duplicate check id %q: defined in %s and %s", check.ID, first, here),
+					FileName: rel,
+				}
+			}
+			defined[check.ID] = here
+		}
+		c.Checks = append(c.Checks, checks...)
+	}
+	return nil
+}
+
+// readChecksFile parses the checks file at path, named rel in errors, and
+// returns its checks with the line each starts on.
+func readChecksFile(path, rel string) ([]Check, []int, error) {
+	data, err := os.ReadFile(path) // #nosec G304 - path matched a checks_files pattern of the config
+	if err != nil {
+		return nil, nil, &ConfigError{Message: "failed to read checks file " + rel, Cause: err, FileName: rel}
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, nil, &ConfigError{Message: "failed to parse checks file " + rel, Cause: err, FileName: rel}
+	}
+	if len(root.Content) == 0 {
+		return nil, nil, nil // An empty file defines no checks
+	}
+	mapping := root.Content[0]
+	if mapping.Kind != yaml.MappingNode {
+		return nil, nil, &ConfigError{
+			Message:  fmt.Sprintf("checks file %s must be a mapping with a checks key (line %d)", rel, mapping.Line),
+			FileName: rel,
+		}
+	}
+
+	var checksNode *yaml.Node
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key := mapping.Content[i]
+		if key.Value != "checks" {
+			return nil, nil, &ConfigError{
+				Message:  fmt.Sprintf("checks file %s may only contain checks, found %q (line %d): vars, prompts and settings belong in the main config", rel, key.Value, key.Line),
+				FileName: rel,
+			}
+		}
+		checksNode = mapping.Content[i+1]
+	}
+	if checksNode == nil {
+		return nil, nil, nil
+	}
+
+	var checks []Check
+	if err := checksNode.Decode(&checks); err != nil {
+		return nil, nil, &ConfigError{Message: "failed to parse checks file " + rel, Cause: err, FileName: rel}
+	}
+	lines := make([]int, len(checks))
+	for i := range checks {
+		lines[i] = checksNode.Content[i].Line
+	}
+	return checks, lines, nil
+}
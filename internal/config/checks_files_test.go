@@ -0,0 +1,133 @@
+package config
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeFiles writes files, keyed by path relative to dir, creating their
+// directories.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLoad_ChecksFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"vibeguard.yaml": `version: "1"
+vars:
+  pkg: ./...
+checks_files:
+  - checks/*.yaml
+checks:
+  - id: build
+    run: go build {{.pkg}}
+`,
+		"checks/lint.yaml": `checks:
+  - id: lint
+    run: golangci-lint run {{.pkg}}
+    requires: [build]
+`,
+		"checks/test.yaml": `checks:
+  - id: test
+    run: go test {{.pkg}}
+    severity: warning
+    requires: [lint]
+`,
+		"checks/README.md": "not a checks file\n",
+	})
+
+	cfg, err := Load(filepath.Join(dir, "vibeguard.yaml"))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	var ids []string
+	for _, check := range cfg.Checks {
+		ids = append(ids, check.ID)
+	}
+	if want := []string{"build", "lint", "test"}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("expected checks %v, got %v", want, ids)
+	}
+	// Checks from checks files use the main config's vars and get its defaults
+	if got := cfg.Checks[1].Run; got != "golangci-lint run ./..." {
+		t.Errorf("expected interpolated run command, got %q", got)
+	}
+	if cfg.Checks[1].Severity != SeverityError || cfg.Checks[2].Severity != SeverityWarning {
+		t.Errorf("unexpected severities: %s, %s", cfg.Checks[1].Severity, cfg.Checks[2].Severity)
+	}
+}
+
+func TestLoad_ChecksFilesDuplicateID(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"vibeguard.yaml": `version: "1"
+checks_files: ["checks/*.yaml"]
+checks:
+  - id: build
+    run: go build ./...
+`,
+		"checks/a.yaml": "checks:\n  - id: lint\n    run: golangci-lint run\n",
+		"checks/b.yaml": "checks:\n  - id: test\n    run: go test ./...\n  - id: lint\n    run: eslint .\n",
+	})
+
+	_, err := Load(filepath.Join(dir, "vibeguard.yaml"))
+	if !IsConfigError(err) {
+		t.Fatalf("expected a ConfigError, got %v", err)
+	}
+	want := `duplicate check id "lint": defined in ` + filepath.Join("checks", "a.yaml") + " line 2 and " + filepath.Join("checks", "b.yaml") + " line 4"
+	if err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestLoad_ChecksFilesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name:    "duplicate of a main config check",
+			files:   map[string]string{"checks/build.yaml": "checks:\n  - id: build\n    run: make\n"},
+			wantErr: `duplicate check id "build": defined in vibeguard.yaml line 4 and ` + filepath.Join("checks", "build.yaml") + " line 2",
+		},
+		{
+			name:    "vars in a checks file",
+			files:   map[string]string{"checks/lint.yaml": "vars:\n  pkg: ./...\nchecks:\n  - id: lint\n    run: golangci-lint run\n"},
+			wantErr: `may only contain checks, found "vars" (line 1)`,
+		},
+		{
+			name:    "no matching files",
+			wantErr: `checks_files pattern "checks/*.yaml" matches no files (line 2)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			files := map[string]string{
+				"vibeguard.yaml": "version: \"1\"\nchecks_files: [\"checks/*.yaml\"]\nchecks:\n  - id: build\n    run: go build ./...\n",
+			}
+			maps.Copy(files, tt.files)
+			writeFiles(t, dir, files)
+
+			_, err := Load(filepath.Join(dir, "vibeguard.yaml"))
+			if !IsConfigError(err) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected ConfigError containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
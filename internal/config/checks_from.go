@@ -0,0 +1,96 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+// ChecksFromTimeout is how long the checks_from command may run.
+const ChecksFromTimeout = 30 * time.Second
+
+// ChecksFromEnv is set in the environment of the checks_from command. A
+// config with checks_from fails to load while it is set, so a command that
+// runs vibeguard on its own config cannot recurse forever.
+const ChecksFromEnv = "VIBEGUARD_CHECKS_FROM"
+
+// loadChecksFrom runs the checks_from command and appends the checks it
+// prints to the config's. The command must print a JSON array of check
+// objects with the same fields as checks in the config file; unknown fields
+// are rejected. The generated checks are validated with the rest once the
+// config is loaded.
+func (c *Config) loadChecksFrom() error {
+	if c.ChecksFrom == "" || (c.Shell != "" && !isValidShell(c.Shell)) {
+		// Validate reports an invalid shell
+		return nil
+	}
+	line := c.findTopLevelKeyLine("checks_from")
+
+	if strings.TrimSpace(c.ChecksFrom) == "" {
+		return &ConfigError{Message: "checks_from has an empty command", LineNum: line}
+	}
+	if os.Getenv(ChecksFromEnv) != "" {
+		return &ConfigError{
+			Message: fmt.Sprintf("checks_from cannot be used while loading the config from a checks_from command (%s is set)", ChecksFromEnv),
+			LineNum: line,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ChecksFromTimeout)
+	defer cancel()
+	result, err := executor.New(c.dir).ExecuteWithOptions(ctx, "checks_from", c.ChecksFrom, executor.Options{
+		Shell: c.Shell,
+		Env:   []string{ChecksFromEnv + "=1"},
+	})
+	if err != nil {
+		return &ConfigError{Message: "checks_from failed", Cause: err, LineNum: line}
+	}
+	switch {
+	case result.Timedout:
+		return &ConfigError{
+			Message: fmt.Sprintf("checks_from timed out after %s", ChecksFromTimeout),
+			LineNum: line,
+		}
+	case !result.Success:
+		return &ConfigError{
+			Message: fmt.Sprintf("checks_from failed with exit code %d: %s", result.ExitCode, strings.TrimSpace(result.Combined)),
+			LineNum: line,
+		}
+	}
+
+	checks, err := parseChecksJSON([]byte(result.Stdout))
+	if err != nil {
+		return &ConfigError{Message: "checks_from printed invalid checks", Cause: err, LineNum: line}
+	}
+	c.Checks = append(c.Checks, checks...)
+	return nil
+}
+
+// parseChecksJSON parses a JSON array of checks. JSON is decoded as YAML, of
+// which it is a subset, so checks use the config file's field names and
+// value formats.
+func parseChecksJSON(data []byte) ([]Check, error) {
+	data = bytes.TrimSpace(data)
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("output is not valid JSON")
+	}
+	if data[0] != '[' {
+		return nil, fmt.Errorf("output must be a JSON array of checks")
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var checks []Check
+	if err := decoder.Decode(&checks); err != nil {
+		return nil, err
+	}
+	return checks, nil
+}
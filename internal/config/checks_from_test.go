@@ -0,0 +1,114 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoad_ChecksFrom(t *testing.T) {
+	dir := t.TempDir()
+	script := `#!/bin/sh
+cat <<EOF
+[
+  {"id": "lint", "run": "golangci-lint run", "severity": "warning", "requires": ["build"], "timeout": "2m"},
+  {"id": "generated-env", "run": "echo $VIBEGUARD_CHECKS_FROM {{.pkg}}", "tags": ["generated"]}
+]
+EOF
+`
+	if err := os.WriteFile(filepath.Join(dir, "gen-checks.sh"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	configPath := filepath.Join(dir, "vibeguard.yaml")
+	content := `
+version: "1"
+vars:
+  pkg: ./...
+checks_from: ./gen-checks.sh
+checks:
+  - id: build
+    run: go build {{.pkg}}
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Checks) != 3 {
+		t.Fatalf("expected 3 checks, got %d", len(cfg.Checks))
+	}
+
+	lint := cfg.Checks[1]
+	if lint.ID != "lint" || lint.Severity != SeverityWarning || lint.Timeout.AsDuration() != 2*time.Minute {
+		t.Errorf("unexpected lint check: %+v", lint)
+	}
+	if len(lint.Requires) != 1 || lint.Requires[0] != "build" {
+		t.Errorf("expected lint to require build, got %v", lint.Requires)
+	}
+
+	// Generated checks get defaults and variable interpolation like the others
+	env := cfg.Checks[2]
+	if env.Severity != SeverityError {
+		t.Errorf("expected default severity error, got %q", env.Severity)
+	}
+	if got, want := env.Run, "echo 1 ./..."; got != want {
+		t.Errorf("expected run %q, got %q", want, got)
+	}
+}
+
+func TestLoad_ChecksFromErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		wantErr string
+	}{
+		{name: "command fails", command: "echo no generator >&2; exit 3", wantErr: "checks_from failed with exit code 3: no generator"},
+		{name: "invalid JSON", command: "echo not json", wantErr: "checks_from printed invalid checks: output is not valid JSON"},
+		{name: "not an array", command: `echo '{"id": "a", "run": "true"}'`, wantErr: "output must be a JSON array of checks"},
+		{name: "unknown field", command: `echo '[{"id": "a", "run": "true", "comand": "x"}]'`, wantErr: `field comand not found`},
+		{name: "invalid check", command: `echo '[{"id": "a", "run": "true", "severity": "fatal"}]'`, wantErr: `check "a" has invalid severity`},
+		{name: "duplicate ID", command: `echo '[{"id": "build", "run": "true"}]'`, wantErr: `duplicate check id: build`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "vibeguard.yaml")
+			content := "version: \"1\"\nchecks_from: " + quoteYAML(tt.command) + "\nchecks:\n  - id: build\n    run: go build ./...\n"
+			if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := Load(configPath)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestLoad_ChecksFromRecursion(t *testing.T) {
+	// A checks_from command that runs vibeguard on the same config would
+	// load it again with ChecksFromEnv set
+	t.Setenv(ChecksFromEnv, "1")
+
+	configPath := filepath.Join(t.TempDir(), "vibeguard.yaml")
+	content := "version: \"1\"\nchecks_from: echo '[]'\nchecks:\n  - id: build\n    run: go build ./...\n"
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Load(configPath)
+	if err == nil || !strings.Contains(err.Error(), "checks_from cannot be used while loading the config from a checks_from command") {
+		t.Errorf("expected recursion error, got: %v", err)
+	}
+}
+
+// quoteYAML returns s as a double-quoted YAML scalar.
+func quoteYAML(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -1,13 +1,25 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"path/filepath"
 	"regexp"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/vibeguard/vibeguard/internal/assert"
+	"github.com/vibeguard/vibeguard/internal/glob"
+	"github.com/vibeguard/vibeguard/internal/grok"
 )
 
 // validCheckID matches alphanumeric characters, underscores, and hyphens.
@@ -85,21 +97,31 @@ func IsExecutionError(err error) bool {
 }
 
 // Load reads and parses a VibeGuard configuration file.
-// If path is empty, it searches for config files in the default locations.
+// If path is empty, it searches for a config file with FindConfigFile.
 // Returns a ConfigError for any configuration-related errors (exit code 2).
+// Variables defined in a .env file next to the config override its vars.
 func Load(path string) (*Config, error) {
+	return load(path, nil)
+}
+
+// load implements Load and LoadWithVars.
+func load(path string, vars map[string]string) (*Config, error) {
 	if path == "" {
 		var err error
-		path, err = findConfigFile()
+		path, err = FindConfigFile()
 		if err != nil {
 			return nil, &ConfigError{Message: "no config file found", Cause: err}
 		}
 	}
 
-	data, err := os.ReadFile(path) // #nosec G304 - path is a validated config file from FindConfig
+	data, err := os.ReadFile(path) // #nosec G304 - path is a validated config file from FindConfigFile
 	if err != nil {
 		return nil, &ConfigError{Message: "failed to read config file", Cause: err}
 	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, &ConfigError{Message: "failed to resolve config file path", Cause: err}
+	}
 
 	// Parse with nodes to preserve line information
 	var root yaml.Node
@@ -114,29 +136,140 @@ func Load(path string) (*Config, error) {
 
 	// Store the root node for line number lookups during validation
 	cfg.yamlRoot = &root
+	cfg.dir = filepath.Dir(absPath)
+	cfg.path = absPath
+	sum := sha256.Sum256(data)
+	cfg.hash = hex.EncodeToString(sum[:])
+
+	// Add the checks of checks files and generated checks, which are then
+	// treated like the config's own
+	if err := cfg.loadChecksFiles(absPath); err != nil {
+		return nil, err
+	}
+	if err := cfg.loadChecksFrom(); err != nil {
+		return nil, err
+	}
+
+	// Expand matrix checks before anything looks at individual checks
+	if err := cfg.expandMatrix(); err != nil {
+		return nil, err
+	}
+
+	// Resolve requires patterns such as "test-*" against the final check IDs
+	if err := cfg.expandRequiresGlobs(); err != nil {
+		return nil, err
+	}
 
 	// Apply defaults
 	cfg.applyDefaults()
 
+	// Layer runtime variables over the config's: command output, .env,
+	// then the caller's
+	envVars, err := loadEnvFile(cfg.dir)
+	if err != nil {
+		return nil, err
+	}
+	cmdVars, err := cfg.runVarCommands(envVars, vars)
+	if err != nil {
+		return nil, err
+	}
+	cfg.mergeVars(cmdVars, envVars, vars)
+
 	// Validate configuration
 	if err := cfg.Validate(); err != nil {
 		return nil, err
 	}
+	if err := cfg.validateVarReferences(); err != nil {
+		return nil, err
+	}
 
 	// Interpolate variables
 	cfg.Interpolate()
 
+	// Compile grok patterns once variables are resolved, so references to
+	// undefined patterns fail at load time rather than mid-run
+	if err := cfg.validateCheckGrok(); err != nil {
+		return nil, err
+	}
+	if err := cfg.validateAssertRefs(); err != nil {
+		return nil, err
+	}
+
 	return &cfg, nil
 }
 
-// findConfigFile searches for a config file in the default locations.
-func findConfigFile() (string, error) {
-	for _, name := range ConfigFileNames {
-		if _, err := os.Stat(name); err == nil {
-			return name, nil
+// FindConfigFile searches for a config file named one of ConfigFileNames in
+// the current directory and then in each parent directory, stopping after
+// the root of the git repository or at the root of the filesystem. A file in
+// the current directory is returned by name; one found in a parent
+// directory by its absolute path.
+func FindConfigFile() (string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("failed to get working directory: %w", err)
+	}
+
+	for dir := wd; ; {
+		for _, name := range ConfigFileNames {
+			path := filepath.Join(dir, name)
+			if info, err := os.Stat(path); err == nil && !info.IsDir() {
+				if dir == wd {
+					return name, nil
+				}
+				return path, nil
+			}
+		}
+
+		// Don't look outside the repository
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			break
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
 		}
+		dir = parent
+	}
+	return "", fmt.Errorf("no config file found in %s or its parent directories (tried: %v)", wd, ConfigFileNames)
+}
+
+// Dir returns the absolute path of the directory containing the config
+// file. Checks run in it, and relative paths in the config resolve against
+// it. It is empty for a Config that was not loaded from a file.
+func (c *Config) Dir() string {
+	return c.dir
+}
+
+// Path returns the absolute path of the config file, or "" for a Config
+// that was not loaded from a file.
+func (c *Config) Path() string {
+	return c.path
+}
+
+// Hash returns the SHA-256 of the config file's content, in hex, or "" for
+// a Config that was not loaded from a file. Checks files and generated
+// checks are not included.
+func (c *Config) Hash() string {
+	return c.hash
+}
+
+// DisplayName returns the name that labels the config's runs: its name, or
+// the name of the directory containing the config file if it doesn't set
+// one.
+func (c *Config) DisplayName() string {
+	if c.Name != "" || c.dir == "" {
+		return c.Name
 	}
-	return "", fmt.Errorf("no config file found (tried: %v)", ConfigFileNames)
+	return filepath.Base(c.dir)
+}
+
+// SeverityOf returns the severity check runs with: its severity_overrides
+// entry, or else its own severity.
+func (c *Config) SeverityOf(check *Check) Severity {
+	if s, ok := c.SeverityOverrides[check.ID]; ok {
+		return s
+	}
+	return check.Severity
 }
 
 // applyDefaults sets default values for optional fields.
@@ -149,12 +282,39 @@ func (c *Config) applyDefaults() {
 	}
 
 	for i := range c.Checks {
+		c.Checks[i].applyDefaults(c.Defaults)
 		if c.Checks[i].Severity == "" {
 			c.Checks[i].Severity = SeverityError
 		}
 		if c.Checks[i].Timeout == 0 {
 			c.Checks[i].Timeout = Duration(DefaultTimeout)
 		}
+		if c.Checks[i].Shell == "" {
+			c.Checks[i].Shell = c.Shell
+		}
+	}
+}
+
+// applyDefaults sets the fields the check leaves unset to the config's
+// defaults.
+func (check *Check) applyDefaults(defaults CheckDefaults) {
+	if check.Severity == "" {
+		check.Severity = defaults.Severity
+	}
+	if check.Timeout == 0 {
+		check.Timeout = defaults.Timeout
+	}
+	if check.KillGrace == 0 {
+		check.KillGrace = defaults.KillGrace
+	}
+	if check.KillSignal == "" {
+		check.KillSignal = defaults.KillSignal
+	}
+	if check.MaxOutputBytes == 0 {
+		check.MaxOutputBytes = defaults.MaxOutputBytes
+	}
+	if check.Capture == "" && check.File == "" {
+		check.Capture = defaults.Capture
 	}
 }
 
@@ -168,11 +328,74 @@ func (c *Config) Validate() error {
 		return &ConfigError{Message: "no checks defined"}
 	}
 
+	for _, key := range []string{"name", "description"} {
+		// Decoding accepts numbers and booleans into strings
+		if value := c.findTopLevelValue(key); value != nil && value.Tag != "!!str" && value.Tag != "!!null" {
+			return &ConfigError{
+				Message: fmt.Sprintf("%s must be a string", key),
+				LineNum: value.Line,
+			}
+		}
+	}
+	if strings.ContainsAny(c.Name, "\r\n") {
+		return &ConfigError{
+			Message: "name must be a single line",
+			LineNum: c.findTopLevelKeyLine("name"),
+		}
+	}
+
+	if c.Shell != "" && !isValidShell(c.Shell) {
+		return &ConfigError{
+			Message: fmt.Sprintf("invalid shell %q: must be one of %s", c.Shell, strings.Join(Shells, ", ")),
+			LineNum: c.findTopLevelKeyLine("shell"),
+		}
+	}
+
+	for _, hook := range c.hooks() {
+		for i, command := range hook.commands {
+			if strings.TrimSpace(command) == "" {
+				return &ConfigError{
+					Message: fmt.Sprintf("%s hook %d has an empty command", hook.key, i+1),
+					LineNum: c.findTopLevelKeyLine(hook.key),
+				}
+			}
+		}
+	}
+
+	if err := validateRedactPatterns(c.Redact); err != nil {
+		return &ConfigError{
+			Message: err.Error(),
+			Cause:   err,
+			LineNum: c.findTopLevelKeyLine("redact"),
+		}
+	}
+
+	if err := c.validateDefaults(); err != nil {
+		return err
+	}
+
+	if c.Parallel != "" {
+		if _, err := ResolveParallel(c.Parallel); err != nil {
+			return &ConfigError{
+				Message: fmt.Sprintf("invalid parallel: %v", err),
+				LineNum: c.findTopLevelKeyLine("parallel"),
+			}
+		}
+	}
+
 	// Validate prompts if present
 	if err := c.validatePrompts(); err != nil {
 		return err
 	}
 
+	// Validate custom grok pattern definitions
+	if err := grok.ValidateDefinitions(c.GrokPatterns); err != nil {
+		return &ConfigError{
+			Message: fmt.Sprintf("invalid grok_patterns: %v", err),
+			LineNum: c.findTopLevelKeyLine("grok_patterns"),
+		}
+	}
+
 	checkIDs := make(map[string]bool)
 	for i, check := range c.Checks {
 		if check.ID == "" {
@@ -195,17 +418,106 @@ func (c *Config) Validate() error {
 		}
 		checkIDs[check.ID] = true
 
-		if check.Run == "" {
+		// A check without a command analyzes its file as it is
+		if check.Run == "" && check.File == "" {
+			return &ConfigError{
+				Message: fmt.Sprintf("check %q has no run command or file", check.ID),
+				LineNum: c.FindCheckNodeLine(check.ID, i),
+			}
+		}
+
+		// ...and without grok patterns or an assertion it could never fail
+		if check.Run == "" && len(check.Grok) == 0 && check.Assert == "" {
 			return &ConfigError{
-				Message: fmt.Sprintf("check %q has no run command", check.ID),
+				Message: fmt.Sprintf("check %q reads file %q but has no grok patterns or assert to evaluate it", check.ID, check.File),
 				LineNum: c.FindCheckNodeLine(check.ID, i),
 			}
 		}
 
 		// Validate severity
-		if check.Severity != SeverityError && check.Severity != SeverityWarning {
+		if !isValidSeverity(check.Severity) {
+			return &ConfigError{
+				Message: fmt.Sprintf("check %q has invalid severity: %s (must be error, warning or info)", check.ID, check.Severity),
+				LineNum: c.FindCheckNodeLine(check.ID, i),
+			}
+		}
+
+		if check.Shell != "" && !isValidShell(check.Shell) {
+			return &ConfigError{
+				Message: fmt.Sprintf("check %q has invalid shell %q: must be one of %s", check.ID, check.Shell, strings.Join(Shells, ", ")),
+				LineNum: c.FindCheckNodeLine(check.ID, i),
+			}
+		}
+
+		if check.KillSignal != "" && !slices.Contains(KillSignals, check.KillSignal) {
+			return &ConfigError{
+				Message: fmt.Sprintf("check %q has invalid kill_signal %q: must be one of %s", check.ID, check.KillSignal, strings.Join(KillSignals, ", ")),
+				LineNum: c.FindCheckNodeLine(check.ID, i),
+			}
+		}
+
+		if err := validateRedactPatterns(check.Redact); err != nil {
+			return &ConfigError{
+				Message: fmt.Sprintf("check %q has %v", check.ID, err),
+				Cause:   err,
+				LineNum: c.FindCheckNodeLine(check.ID, i),
+			}
+		}
+
+		if check.MaxOutputBytes < 0 {
+			return &ConfigError{
+				Message: fmt.Sprintf("check %q has negative max_output_bytes: %d", check.ID, check.MaxOutputBytes),
+				LineNum: c.FindCheckNodeLine(check.ID, i),
+			}
+		}
+
+		if err := validateCoverage(check); err != nil {
+			return &ConfigError{
+				Message: err.Error(),
+				LineNum: c.FindCheckNodeLine(check.ID, i),
+			}
+		}
+
+		if check.Scope != "" {
+			if !slices.Contains(Scopes, check.Scope) {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q has invalid scope %q: must be one of %s", check.ID, check.Scope, strings.Join(Scopes, ", ")),
+					LineNum: c.FindCheckNodeLine(check.ID, i),
+				}
+			}
+			if !strings.Contains(check.Run, "{{."+ScopeVar+"}}") {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q has scope %q but its run command does not use {{.%s}}", check.ID, check.Scope, ScopeVar),
+					LineNum: c.FindCheckNodeLine(check.ID, i),
+				}
+			}
+		}
+
+		if check.Capture != "" {
+			if !isValidCapture(check.Capture) {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q has invalid capture %q: must be one of %s", check.ID, check.Capture, strings.Join(Captures, ", ")),
+					LineNum: c.FindCheckNodeLine(check.ID, i),
+				}
+			}
+			if check.File != "" {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q cannot set both capture and file", check.ID),
+					LineNum: c.FindCheckNodeLine(check.ID, i),
+				}
+			}
+		}
+
+		if check.Category != "" && !slices.Contains(Categories, check.Category) {
 			return &ConfigError{
-				Message: fmt.Sprintf("check %q has invalid severity: %s", check.ID, check.Severity),
+				Message: fmt.Sprintf("check %q has invalid category %q: must be one of %s", check.ID, check.Category, strings.Join(Categories, ", ")),
+				LineNum: c.FindCheckNodeLine(check.ID, i),
+			}
+		}
+
+		if check.GrokRequired && len(check.Grok) == 0 {
+			return &ConfigError{
+				Message: fmt.Sprintf("check %q sets grok_required without grok patterns", check.ID),
 				LineNum: c.FindCheckNodeLine(check.ID, i),
 			}
 		}
@@ -220,6 +532,16 @@ func (c *Config) Validate() error {
 			}
 		}
 
+		// Validate paths globs
+		for _, pattern := range check.Paths {
+			if err := glob.Validate(pattern); err != nil {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q has invalid paths entry: %v", check.ID, err),
+					LineNum: c.FindCheckNodeLine(check.ID, i),
+				}
+			}
+		}
+
 		// Validate requires references
 		for _, reqID := range check.Requires {
 			// Check for self-reference
@@ -248,12 +570,48 @@ func (c *Config) Validate() error {
 			}
 		}
 
+		// Validate optional_requires references
+		for _, reqID := range check.OptionalRequires {
+			if reqID == check.ID {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q cannot require itself", check.ID),
+					LineNum: c.FindCheckNodeLine(check.ID, i),
+				}
+			}
+			if !c.hasCheck(reqID) {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q optional_requires unknown check: %s", check.ID, reqID),
+					LineNum: c.FindCheckNodeLine(check.ID, i),
+				}
+			}
+		}
+
+		// Validate requires_any references
+		for _, reqID := range check.RequiresAny {
+			if reqID == check.ID {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q cannot require itself", check.ID),
+					LineNum: c.FindCheckNodeLine(check.ID, i),
+				}
+			}
+			if !c.hasCheck(reqID) {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q requires_any unknown check: %s", check.ID, reqID),
+					LineNum: c.FindCheckNodeLine(check.ID, i),
+				}
+			}
+		}
+
 		// Validate event handlers
 		if err := c.validateEventHandlers(check, i); err != nil {
 			return err
 		}
 	}
 
+	if err := c.validateSeverityOverrides(); err != nil {
+		return err
+	}
+
 	// Validate no cyclic dependencies
 	if err := c.validateNoCycles(); err != nil {
 		return err
@@ -262,6 +620,184 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// validateDefaults checks the values of the defaults block, before they are
+// reported as errors of the checks they were applied to.
+func (c *Config) validateDefaults() error {
+	d := c.Defaults
+	var msg string
+	switch {
+	case d.Severity != "" && !isValidSeverity(d.Severity):
+		msg = fmt.Sprintf("defaults has invalid severity: %s (must be error, warning or info)", d.Severity)
+	case d.KillSignal != "" && !slices.Contains(KillSignals, d.KillSignal):
+		msg = fmt.Sprintf("defaults has invalid kill_signal %q: must be one of %s", d.KillSignal, strings.Join(KillSignals, ", "))
+	case d.Capture != "" && !isValidCapture(d.Capture):
+		msg = fmt.Sprintf("defaults has invalid capture %q: must be one of %s", d.Capture, strings.Join(Captures, ", "))
+	case d.MaxOutputBytes < 0:
+		msg = fmt.Sprintf("defaults has negative max_output_bytes: %d", d.MaxOutputBytes)
+	default:
+		return nil
+	}
+	return &ConfigError{Message: msg, LineNum: c.findTopLevelKeyLine("defaults")}
+}
+
+// validateSeverityOverrides checks that every severity_overrides entry names
+// a defined check and a valid severity.
+func (c *Config) validateSeverityOverrides() error {
+	for _, id := range slices.Sorted(maps.Keys(c.SeverityOverrides)) {
+		if !c.hasCheck(id) {
+			return &ConfigError{
+				Message: fmt.Sprintf("severity_overrides references unknown check: %s", id),
+				LineNum: c.findTopLevelKeyLine("severity_overrides"),
+			}
+		}
+		if severity := c.SeverityOverrides[id]; !isValidSeverity(severity) {
+			return &ConfigError{
+				Message: fmt.Sprintf("severity_overrides has invalid severity for check %q: %s (must be error, warning or info)", id, severity),
+				LineNum: c.findTopLevelKeyLine("severity_overrides"),
+			}
+		}
+	}
+	return nil
+}
+
+// hasCheck reports whether a check with the given ID is defined.
+func (c *Config) hasCheck(id string) bool {
+	for _, check := range c.Checks {
+		if check.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// hookList is the list of commands of one hook, with its YAML key.
+type hookList struct {
+	key      string
+	commands []string
+}
+
+// hooks returns the before and after hook commands.
+func (c *Config) hooks() []hookList {
+	return []hookList{{"before", c.Before}, {"after", c.After}}
+}
+
+// ResolveParallel converts a parallel setting, a number of at least 1 or
+// "auto" for one check per CPU, into a concurrency limit.
+func ResolveParallel(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if strings.EqualFold(value, ParallelAuto) {
+		return runtime.NumCPU(), nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%q must be a number of at least 1 or %s", value, ParallelAuto)
+	}
+	return n, nil
+}
+
+// isValidSeverity reports whether severity is one of Severities.
+func isValidSeverity(severity Severity) bool {
+	for _, s := range Severities {
+		if severity == s {
+			return true
+		}
+	}
+	return false
+}
+
+// isValidShell reports whether shell is one of Shells.
+func isValidShell(shell string) bool {
+	for _, s := range Shells {
+		if shell == s {
+			return true
+		}
+	}
+	return false
+}
+
+// isValidCapture reports whether capture is one of Captures.
+func isValidCapture(capture string) bool {
+	for _, c := range Captures {
+		if capture == c {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAssertRefs checks the variables of other checks that assertions
+// reference as check.capture: the check must be one of the check's requires,
+// which run before it, and the capture one of its grok captures or its
+// coverage variable. Assertions that don't parse are reported when they are
+// evaluated.
+func (c *Config) validateAssertRefs() error {
+	captures := make(map[string][]string, len(c.Checks))
+	for _, check := range c.Checks {
+		if len(check.Grok) > 0 {
+			if matcher, err := grok.NewWithDefinitions(check.Grok, c.GrokPatterns); err == nil {
+				captures[check.ID] = matcher.Captures()
+			}
+		}
+		if check.Coverage.Format != "" {
+			captures[check.ID] = append(captures[check.ID], CoverageVar)
+		}
+	}
+
+	for i, check := range c.Checks {
+		names, err := assert.Variables(check.Assert)
+		if err != nil {
+			continue
+		}
+		for _, name := range names {
+			id, capture, ok := strings.Cut(name, ".")
+			if !ok {
+				continue
+			}
+			if !slices.Contains(check.Requires, id) {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q assert references %q, but only the checks in its requires are visible: add %q to requires", check.ID, name, id),
+					LineNum: c.FindCheckNodeLine(check.ID, i),
+				}
+			}
+			if !slices.Contains(captures[id], capture) {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q assert references %q, but check %q has no %q capture", check.ID, name, id, capture),
+					LineNum: c.FindCheckNodeLine(check.ID, i),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateCheckGrok compiles each check's grok patterns against the built-in
+// and custom pattern definitions, and rejects captures that would shadow a
+// built-in assertion variable.
+func (c *Config) validateCheckGrok() error {
+	for i, check := range c.Checks {
+		if len(check.Grok) == 0 {
+			continue
+		}
+		matcher, err := grok.NewWithDefinitions(check.Grok, c.GrokPatterns)
+		if err != nil {
+			return &ConfigError{
+				Message: fmt.Sprintf("check %q has invalid grok pattern", check.ID),
+				Cause:   err,
+				LineNum: c.FindCheckNodeLine(check.ID, i),
+			}
+		}
+		for _, name := range matcher.Captures() {
+			if slices.Contains(ReservedAssertVars, name) {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q grok capture %q conflicts with the built-in assert variable of the same name", check.ID, name),
+					LineNum: c.FindCheckNodeLine(check.ID, i),
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // validatePrompts checks the prompts for errors.
 func (c *Config) validatePrompts() error {
 	if len(c.Prompts) == 0 {
@@ -357,84 +893,22 @@ func (c *Config) validateEventPromptIDs(checkID string, checkIndex int, eventNam
 }
 
 // validateNoCycles checks for cyclic dependencies in the requires graph.
-// It uses DFS with three states: unvisited, visiting (in current path), and visited (fully processed).
 func (c *Config) validateNoCycles() error {
-	// Build adjacency list: check ID -> list of required check IDs
-	// Also build a map of check ID to index for line number lookup
-	graph := make(map[string][]string)
-	idToIndex := make(map[string]int)
-	for i, check := range c.Checks {
-		graph[check.ID] = check.Requires
-		idToIndex[check.ID] = i
-	}
-
-	// Track visited state: 0 = unvisited, 1 = visiting (in current path), 2 = visited
-	state := make(map[string]int)
-
-	// Track the current path for error reporting
-	var path []string
-
-	// DFS function to detect cycles
-	var dfs func(id string) error
-	dfs = func(id string) error {
-		if state[id] == 2 {
-			// Already fully visited, no cycle through this node
-			return nil
-		}
-		if state[id] == 1 {
-			// Found a cycle - build cycle description
-			cycleStart := -1
-			for i, p := range path {
-				if p == id {
-					cycleStart = i
-					break
-				}
-			}
-			cyclePath := append(path[cycleStart:], id)
-			// Get line number of the first check in the cycle
-			lineNum := c.FindCheckNodeLine(cyclePath[0], idToIndex[cyclePath[0]])
-			return &ConfigError{
-				Message: fmt.Sprintf("cyclic dependency detected: %s", formatCycle(cyclePath)),
-				LineNum: lineNum,
-			}
-		}
-
-		// Mark as visiting
-		state[id] = 1
-		path = append(path, id)
-
-		// Visit all dependencies
-		for _, reqID := range graph[id] {
-			if err := dfs(reqID); err != nil {
-				return err
-			}
-		}
-
-		// Mark as fully visited
-		state[id] = 2
-		path = path[:len(path)-1]
+	cycle := FindCycle(c.Checks)
+	if cycle == nil {
 		return nil
 	}
 
-	// Run DFS from each unvisited node
-	for _, check := range c.Checks {
-		if state[check.ID] == 0 {
-			if err := dfs(check.ID); err != nil {
-				return err
+	// Report the line of the first check in the cycle
+	for i, check := range c.Checks {
+		if check.ID == cycle[0] {
+			return &ConfigError{
+				Message: fmt.Sprintf("cyclic dependency detected: %s", FormatCycle(cycle)),
+				LineNum: c.FindCheckNodeLine(check.ID, i),
 			}
 		}
 	}
-
-	return nil
-}
-
-// formatCycle formats a cycle path for display (e.g., "a -> b -> c -> a").
-func formatCycle(path []string) string {
-	result := path[0]
-	for i := 1; i < len(path); i++ {
-		result += " -> " + path[i]
-	}
-	return result
+	return &ConfigError{Message: fmt.Sprintf("cyclic dependency detected: %s", FormatCycle(cycle))}
 }
 
 // FindPromptNodeLine returns the line number of a prompt in the YAML, or 0 if not found.
@@ -478,6 +952,51 @@ func (c *Config) FindPromptNodeLine(promptID string, promptIndex int) int {
 	return 0
 }
 
+// findTopLevelKeyLine returns the line number of a top-level key in the YAML,
+// or 0 if not found.
+func (c *Config) findTopLevelKeyLine(key string) int {
+	keyNode, _ := c.findTopLevelNodes(key)
+	if keyNode == nil {
+		return 0
+	}
+	return keyNode.Line
+}
+
+// findTopLevelValue returns the YAML value node of a top-level key, or nil
+// if the key is not set.
+func (c *Config) findTopLevelValue(key string) *yaml.Node {
+	_, value := c.findTopLevelNodes(key)
+	return value
+}
+
+// findTopLevelNodes returns the key and value nodes of a top-level key, or
+// nils if the key is not set.
+func (c *Config) findTopLevelNodes(key string) (*yaml.Node, *yaml.Node) {
+	root, ok := c.yamlRoot.(*yaml.Node)
+	if !ok || root == nil {
+		return nil, nil
+	}
+
+	mapping := root
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		mapping = root.Content[0]
+	}
+	if mapping.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			value := mapping.Content[i+1]
+			if value.Kind == yaml.AliasNode {
+				value = value.Alias // The anchored value the key refers to
+			}
+			return mapping.Content[i], value
+		}
+	}
+	return nil, nil
+}
+
 // FindCheckNodeLine returns the line number of a check in the YAML, or 0 if not found.
 func (c *Config) FindCheckNodeLine(checkID string, checkIndex int) int {
 	root, ok := c.yamlRoot.(*yaml.Node)
@@ -508,7 +1027,11 @@ func (c *Config) FindCheckNodeLine(checkID string, checkIndex int) int {
 		valueNode := mapping.Content[i+1]
 
 		if keyNode.Value == "checks" && valueNode.Kind == yaml.SequenceNode {
-			// Found the checks sequence, get the check at the given index
+			// Found the checks sequence, get the check at the given index;
+			// checks generated from a matrix share their template's node
+			if checkIndex >= 0 && checkIndex < len(c.checkSource) {
+				checkIndex = c.checkSource[checkIndex]
+			}
 			if checkIndex >= 0 && checkIndex < len(valueNode.Content) {
 				return valueNode.Content[checkIndex].Line
 			}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Coverage report formats a check can read its coverage from
+const (
+	CoverageGo     = "go"     // Coverage profile written by go test -coverprofile
+	CoverageJest   = "jest"   // coverage-summary.json written by jest --coverageReporters=json-summary
+	CoveragePytest = "pytest" // coverage.json written by pytest --cov-report=json
+)
+
+// CoverageFormats lists the values accepted for the coverage format.
+var CoverageFormats = []string{CoverageGo, CoverageJest, CoveragePytest}
+
+// CoverageVar is the variable a check's coverage percentage is read into.
+const CoverageVar = "coverage"
+
+// defaultCoverageFiles are the report files each format reads when the
+// check doesn't name one: where the tools write them by default, and the
+// profile name the Go templates use.
+var defaultCoverageFiles = map[string]string{
+	CoverageGo:     "cover.out",
+	CoverageJest:   "coverage/coverage-summary.json",
+	CoveragePytest: "coverage.json",
+}
+
+// CoverageSpec makes a check read the coverage percentage from a tool's
+// structured report into the coverage variable. It is written either as the
+// format name alone or as a mapping with a format and a file.
+type CoverageSpec struct {
+	Format string `yaml:"format"`         // One of CoverageFormats
+	File   string `yaml:"file,omitempty"` // Report path, relative to the config (default: the format's usual file)
+}
+
+// UnmarshalYAML implements custom YAML unmarshaling for CoverageSpec.
+func (s *CoverageSpec) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		return value.Decode(&s.Format)
+	}
+	type plain CoverageSpec
+	return value.Decode((*plain)(s))
+}
+
+// ReportFile returns the path of the coverage report the check reads.
+func (s CoverageSpec) ReportFile() string {
+	if s.File != "" {
+		return s.File
+	}
+	return defaultCoverageFiles[s.Format]
+}
+
+// validateCoverage checks a check's coverage setting.
+func validateCoverage(check Check) error {
+	if check.Coverage.Format == "" {
+		if check.Coverage.File != "" {
+			return fmt.Errorf("check %q has a coverage file but no format: must be one of %s", check.ID, strings.Join(CoverageFormats, ", "))
+		}
+		return nil
+	}
+	if _, ok := defaultCoverageFiles[check.Coverage.Format]; !ok {
+		return fmt.Errorf("check %q has invalid coverage format %q: must be one of %s", check.ID, check.Coverage.Format, strings.Join(CoverageFormats, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoad_Coverage(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"vibeguard.yaml": `version: "1"
+vars:
+  reports: build/reports
+checks:
+  - id: go-coverage
+    run: go test -coverprofile=cover.out ./...
+    coverage: go
+    assert: coverage >= 70
+  - id: py-coverage
+    run: pytest --cov --cov-report=json:{{.reports}}/coverage.json
+    coverage:
+      format: pytest
+      file: "{{.reports}}/coverage.json"
+    assert: coverage >= 70
+`,
+	})
+
+	cfg, err := Load(filepath.Join(dir, "vibeguard.yaml"))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	goCov, pyCov := cfg.Checks[0].Coverage, cfg.Checks[1].Coverage
+	if goCov.Format != CoverageGo || goCov.ReportFile() != "cover.out" {
+		t.Errorf("expected go coverage from cover.out, got %+v (file %q)", goCov, goCov.ReportFile())
+	}
+	if pyCov.Format != CoveragePytest || pyCov.ReportFile() != "build/reports/coverage.json" {
+		t.Errorf("expected pytest coverage from the interpolated file, got %+v", pyCov)
+	}
+}
+
+func TestValidate_Coverage(t *testing.T) {
+	tests := []struct {
+		name     string
+		coverage CoverageSpec
+		wantErr  string
+	}{
+		{name: "jest", coverage: CoverageSpec{Format: CoverageJest}},
+		{name: "unknown format", coverage: CoverageSpec{Format: "cobertura"}, wantErr: `invalid coverage format "cobertura": must be one of go, jest, pytest`},
+		{name: "file without format", coverage: CoverageSpec{File: "coverage.json"}, wantErr: "has a coverage file but no format"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				Version: "1",
+				Checks:  []Check{{ID: "coverage", Run: "make coverage", Severity: SeverityWarning, Coverage: tt.coverage}},
+			}
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package config
+
+import "strings"
+
+// FindCycle returns the first dependency cycle in the requires,
+// optional_requires and requires_any graph of checks, as a path that starts
+// and ends with the same check ID (e.g. [a b c a]), or nil if there is none.
+// Checks are visited in order, so the result is deterministic. Entries
+// naming unknown checks are ignored.
+func FindCycle(checks []Check) []string {
+	// Adjacency list: check ID -> list of required check IDs
+	graph := make(map[string][]string, len(checks))
+	for _, check := range checks {
+		deps := append(append([]string(nil), check.Requires...), check.OptionalRequires...)
+		graph[check.ID] = append(deps, check.RequiresAny...)
+	}
+
+	// DFS with three states: 0 = unvisited, 1 = visiting (in current path),
+	// 2 = visited (fully processed)
+	state := make(map[string]int)
+	var path []string
+
+	var dfs func(id string) []string
+	dfs = func(id string) []string {
+		switch state[id] {
+		case 2:
+			// Already fully visited, no cycle through this node
+			return nil
+		case 1:
+			// Found a cycle: it runs from the earlier visit of id back to id
+			for i, p := range path {
+				if p == id {
+					return append(append([]string(nil), path[i:]...), id)
+				}
+			}
+		}
+
+		state[id] = 1
+		path = append(path, id)
+		for _, reqID := range graph[id] {
+			if cycle := dfs(reqID); cycle != nil {
+				return cycle
+			}
+		}
+		state[id] = 2
+		path = path[:len(path)-1]
+		return nil
+	}
+
+	for _, check := range checks {
+		if state[check.ID] == 0 {
+			if cycle := dfs(check.ID); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// FormatCycle formats a cycle path for display (e.g., "a -> b -> c -> a").
+func FormatCycle(path []string) string {
+	return strings.Join(path, " -> ")
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindCycle(t *testing.T) {
+	tests := []struct {
+		name   string
+		checks []Check
+		want   []string
+	}{
+		{
+			name:   "no dependencies",
+			checks: []Check{{ID: "a"}, {ID: "b"}},
+		},
+		{
+			name: "diamond",
+			checks: []Check{
+				{ID: "a"},
+				{ID: "b", Requires: []string{"a"}},
+				{ID: "c", Requires: []string{"a"}},
+				{ID: "d", Requires: []string{"b", "c"}},
+			},
+		},
+		{
+			name:   "self reference",
+			checks: []Check{{ID: "a", Requires: []string{"a"}}},
+			want:   []string{"a", "a"},
+		},
+		{
+			name: "cycle below an acyclic prefix",
+			checks: []Check{
+				{ID: "app", Requires: []string{"b"}},
+				{ID: "b", Requires: []string{"c"}},
+				{ID: "c", Requires: []string{"d"}},
+				{ID: "d", Requires: []string{"b"}},
+			},
+			want: []string{"b", "c", "d", "b"},
+		},
+		{
+			name:   "unknown dependency is ignored",
+			checks: []Check{{ID: "a", Requires: []string{"missing"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FindCycle(tt.checks); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindCycle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -14,8 +14,9 @@ import (
 //
 // 1. Shell metacharacters are intentionally NOT escaped - the config author can
 //    already execute arbitrary commands via the 'run' field
-// 2. There is no external/untrusted input - variables come only from the static
-//    YAML config file, not from environment variables or user input at runtime
+// 2. There is no external/untrusted input - variables come from the YAML config,
+//    the .env file next to it, and --var flags, all controlled by the person
+//    running vibeguard; process environment variables are never read
 // 3. The trust boundary is at the config file level, not the variable level
 //
 // Grok-extracted values (from command output) are ONLY used for display purposes
@@ -29,12 +30,25 @@ import (
 
 // Interpolate replaces {{.VAR}} placeholders in the config with variable values.
 func (c *Config) Interpolate() {
+	for i := range c.Before {
+		c.Before[i] = c.interpolateString(c.Before[i])
+	}
+	for i := range c.After {
+		c.After[i] = c.interpolateString(c.After[i])
+	}
 	for i := range c.Checks {
-		c.Checks[i].Run = c.interpolateString(c.Checks[i].Run)
+		c.Checks[i].Description = c.interpolateString(c.Checks[i].Description)
+		if c.Checks[i].Scope != "" {
+			// The orchestrator substitutes the scoped packages when the check runs
+			c.Checks[i].Run = c.interpolateStringExcept(c.Checks[i].Run, ScopeVar)
+		} else {
+			c.Checks[i].Run = c.interpolateString(c.Checks[i].Run)
+		}
 		c.Checks[i].Assert = c.interpolateString(c.Checks[i].Assert)
 		c.Checks[i].Suggestion = c.interpolateString(c.Checks[i].Suggestion)
 		c.Checks[i].Fix = c.interpolateString(c.Checks[i].Fix)
 		c.Checks[i].File = c.interpolateString(c.Checks[i].File)
+		c.Checks[i].Coverage.File = c.interpolateString(c.Checks[i].Coverage.File)
 
 		for j := range c.Checks[i].Grok {
 			c.Checks[i].Grok[j] = c.interpolateString(c.Checks[i].Grok[j])
@@ -44,12 +58,21 @@ func (c *Config) Interpolate() {
 
 // interpolateString replaces {{.VAR}} with variable values.
 func (c *Config) interpolateString(s string) string {
+	return c.interpolateStringExcept(s, "")
+}
+
+// interpolateStringExcept replaces {{.VAR}} with variable values, leaving the
+// placeholder of the except variable in place.
+func (c *Config) interpolateStringExcept(s, except string) string {
 	if s == "" {
 		return s
 	}
 
 	result := s
 	for key, value := range c.Vars {
+		if key == except {
+			continue
+		}
 		placeholder := "{{." + key + "}}"
 		result = strings.ReplaceAll(result, placeholder, value)
 	}
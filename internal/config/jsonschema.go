@@ -0,0 +1,223 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+// JSONSchemaURL identifies the JSON Schema dialect of the generated schema.
+const JSONSchemaURL = "https://json-schema.org/draft/2020-12/schema"
+
+// durationPattern matches the durations accepted by time.ParseDuration.
+const durationPattern = `^(0|([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+)$`
+
+// schemaDescriptions documents each configuration field, keyed by the Go
+// type name and the field's YAML key.
+var schemaDescriptions = map[string]string{
+	"Config.version":            `Config format version. Only "1" is supported.`,
+	"Config.name":               "Label of the config's runs, shown in report headers and metadata. Defaults to the name of the config file's directory.",
+	"Config.description":        "What the config checks, shown in report headers and metadata.",
+	"Config.vars":               "Variables interpolated into checks as {{.name}}.",
+	"Config.vars_from_cmd":      "Variables set to the trimmed stdout of a shell command, run once when the config loads.",
+	"Config.grok_patterns":      "Custom named grok patterns, usable as %{NAME} in check grok patterns.",
+	"Config.prompts":            "Stored prompts that checks can reference from their on handlers.",
+	"Config.shell":              "Default shell for checks that don't set their own.",
+	"Config.parallel":           `Default maximum number of checks to run in parallel, or "auto" for one per CPU.`,
+	"Config.before":             "Commands run in order once before any check; a failure aborts the run.",
+	"Config.after":              "Commands run in order once after the checks, even if they failed.",
+	"Config.redact":             "Regular expressions whose matches are replaced with *** in the captured output of every command.",
+	"Config.redact_builtins":    "Also redact built-in secret patterns: AWS keys, bearer tokens and GitHub tokens.",
+	"Config.severity_overrides": "Severities by check ID that replace the checks' own, for example to demote a check to warning during a rollout.",
+	"Config.defaults":           "Values for the fields checks leave unset. A check's own value takes precedence, then the default, then the built-in default.",
+	"Config.checks":             "Checks to run.",
+	"Config.checks_files":       "Glob patterns, relative to the config file, of YAML files whose checks key adds checks. Check IDs must be unique across all files.",
+	"Config.checks_from":        "Shell command, run once when the config loads, that prints a JSON array of additional checks.",
+
+	"Prompt.id":          "Unique prompt identifier.",
+	"Prompt.description": "Human-readable description of the prompt.",
+	"Prompt.content":     "Prompt text.",
+	"Prompt.tags":        "Tags for grouping prompts.",
+
+	"Check.id":                "Unique check identifier.",
+	"Check.description":       "What the check verifies, shown in list and violation output.",
+	"Check.category":          "Kind of check: lint, format, typecheck, test, build or security. The run output and summary group checks by category.",
+	"Check.run":               "Shell command to execute, with {{.var}} interpolation. Required unless file is set.",
+	"Check.grok":              "Grok patterns that extract values from the command output.",
+	"Check.grok_required":     "Fail the check when a grok pattern matches nothing in the output, instead of leaving its captures unset.",
+	"Check.file":              "File to read output from instead of the command's stdout. Without run, the check only reads the file.",
+	"Check.capture":           "Command output that grok patterns consume: stdout, stderr or combined (the default).",
+	"Check.coverage":          "Read the coverage percentage from a go, jest or pytest report into the coverage variable, falling back to grok when the report can't be read.",
+	"Check.assert":            "Assertion over extracted values, for example \"coverage >= 80\". Values of the checks in requires are named check-id.capture, as in \"test.coverage >= 80\".",
+	"Check.severity":          "Severity of a failure: error blocks, warning and info are advisory.",
+	"Check.suggestion":        "Help text shown when the check fails.",
+	"Check.fix":               "Command that fixes the failure, run by check --fix.",
+	"Check.requires":          "IDs of checks, or glob patterns such as test-* over check IDs, that must pass before this one runs.",
+	"Check.optional_requires": "IDs of checks this one runs after when they run, without being skipped if they fail.",
+	"Check.requires_any":      "IDs of checks this one runs after, skipped only if none of them passed.",
+	"Check.tags":              "Tags for filtering checks.",
+	"Check.paths":             "Glob patterns of the files the check covers.",
+	"Check.scope":             "Set to changed to replace {{.packages}} in run with the Go packages (or, for jest commands, --findRelatedTests and the files) changed since --changed-from; the check is skipped if none changed.",
+	"Check.timeout":           "Maximum execution time, for example 30s or 5m.",
+	"Check.max_output_bytes":  "Bytes of stdout and of stderr to capture; further output is discarded. Defaults to 10MB.",
+	"Check.kill_grace":        "Time a timed out command has to exit after its kill_signal before it is killed, for example 5s.",
+	"Check.kill_signal":       "Signal sent to a timed out or cancelled command before kill_grace escalates to SIGKILL. Defaults to SIGTERM.",
+	"Check.shell":             "Shell the run and fix commands execute through.",
+	"Check.allow_failure":     "Report failures at the check's severity without affecting the exit code.",
+	"Check.redact":            "Regular expressions whose matches are replaced with *** in the check's captured output, in addition to the top-level redact list.",
+	"Check.redact_builtins":   "Also redact built-in secret patterns in the check's output: AWS keys, bearer tokens and GitHub tokens.",
+	"Check.on":                "Prompts to show when the check succeeds, fails or times out.",
+	"Check.matrix":            "Lists of values the check is expanded across, one check per combination, referenced as {{.matrix.key}}.",
+
+	"CheckDefaults.severity":         "Severity of checks that don't set one.",
+	"CheckDefaults.timeout":          "Timeout of checks that don't set one.",
+	"CheckDefaults.kill_grace":       "kill_grace of checks that don't set one.",
+	"CheckDefaults.kill_signal":      "kill_signal of checks that don't set one.",
+	"CheckDefaults.max_output_bytes": "max_output_bytes of checks that don't set one.",
+	"CheckDefaults.capture":          "Output grok patterns consume in checks that set neither capture nor file.",
+
+	"CoverageSpec.format": "Report format: go (coverage profile), jest (json-summary) or pytest (coverage.py JSON).",
+	"CoverageSpec.file":   "Report path relative to the config file. Defaults to cover.out, coverage/coverage-summary.json or coverage.json.",
+
+	"EventHandler.success": "Prompt IDs, or inline content, shown when the check passes.",
+	"EventHandler.failure": "Prompt IDs, or inline content, shown when the check fails.",
+	"EventHandler.timeout": "Prompt IDs, or inline content, shown when the check times out.",
+}
+
+// schemaRequired lists the required YAML keys of each type.
+var schemaRequired = map[string][]string{
+	"Config":       {"checks"},
+	"Prompt":       {"id", "content"},
+	"Check":        {"id"},
+	"CoverageSpec": {"format"},
+}
+
+// JSONSchema returns a JSON Schema describing the configuration file format.
+// It is generated from the configuration structs, so new fields appear in
+// the schema automatically.
+func JSONSchema() map[string]any {
+	schema := typeSchema(reflect.TypeOf(Config{}))
+	schema["$schema"] = JSONSchemaURL
+	schema["title"] = "VibeGuard configuration"
+	return schema
+}
+
+// typeSchema returns the schema of values of type t.
+func typeSchema(t reflect.Type) map[string]any {
+	switch t {
+	case reflect.TypeOf(Duration(0)):
+		return map[string]any{"type": "string", "pattern": durationPattern}
+	case reflect.TypeOf(Severity("")):
+		return map[string]any{"type": "string", "enum": Severities}
+	case reflect.TypeOf(CoverageSpec{}):
+		// A format name or a mapping with a format and a file
+		return map[string]any{"oneOf": []any{
+			map[string]any{"type": "string", "enum": CoverageFormats},
+			structSchema(t),
+		}}
+	case reflect.TypeOf(GrokSpec{}), reflect.TypeOf(EventValue{}):
+		// A single string or a list of strings
+		return map[string]any{"oneOf": []any{
+			map[string]any{"type": "string"},
+			map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+		}}
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		return structSchema(t)
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": typeSchema(t.Elem())}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int64:
+		return map[string]any{"type": "integer"}
+	default:
+		return map[string]any{"type": "string"}
+	}
+}
+
+// structSchema returns the schema of a struct, with one property per
+// exported field that has a YAML key.
+func structSchema(t reflect.Type) map[string]any {
+	properties := make(map[string]any)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key := yamlKey(field)
+		if !field.IsExported() || key == "" {
+			continue
+		}
+
+		prop := typeSchema(field.Type)
+		if desc := schemaDescriptions[t.Name()+"."+key]; desc != "" {
+			prop["description"] = desc
+		}
+		switch t.Name() + "." + key {
+		case "Config.version":
+			prop["enum"] = []string{"1"}
+		case "Config.parallel":
+			delete(prop, "type")
+			prop["oneOf"] = []any{
+				map[string]any{"type": "integer", "minimum": 1},
+				map[string]any{"type": "string", "enum": []string{ParallelAuto}},
+			}
+		case "Config.shell", "Check.shell":
+			prop["enum"] = Shells
+		case "Check.capture", "CheckDefaults.capture":
+			prop["enum"] = Captures
+		case "Check.category":
+			prop["enum"] = Categories
+		case "Check.scope":
+			prop["enum"] = Scopes
+		case "Check.kill_signal", "CheckDefaults.kill_signal":
+			prop["enum"] = KillSignals
+		case "CoverageSpec.format":
+			prop["enum"] = CoverageFormats
+		case "Check.max_output_bytes", "CheckDefaults.max_output_bytes":
+			prop["minimum"] = 0
+		case "Check.id":
+			prop["pattern"] = validCheckID.String()
+		case "Check.requires", "Check.optional_requires", "Check.requires_any":
+			prop["items"].(map[string]any)["pattern"] = validRequiresEntry.String()
+		case "Check.tags":
+			prop["items"].(map[string]any)["pattern"] = validTag.String()
+		}
+		properties[key] = prop
+	}
+
+	schema := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if required := schemaRequired[t.Name()]; len(required) > 0 {
+		schema["required"] = required
+	}
+	if t.Name() == "Check" {
+		// A check without a command analyzes its file with grok or assert
+		schema["anyOf"] = []any{
+			map[string]any{"required": []string{"run"}},
+			map[string]any{"required": []string{"file", "grok"}},
+			map[string]any{"required": []string{"file", "assert"}},
+		}
+	}
+	if t.Name() == "Config" {
+		// Extension keys hold YAML anchors for the checks to reuse
+		schema["patternProperties"] = map[string]any{"^x-": map[string]any{}}
+	}
+	return schema
+}
+
+// yamlKey returns the YAML key of field, or "" if it is not serialized.
+func yamlKey(field reflect.StructField) string {
+	tag := field.Tag.Get("yaml")
+	if tag == "-" {
+		return ""
+	}
+	key, _, _ := strings.Cut(tag, ",")
+	if key == "" {
+		key = strings.ToLower(field.Name)
+	}
+	return key
+}
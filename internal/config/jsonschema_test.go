@@ -0,0 +1,81 @@
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestJSONSchema_Structure(t *testing.T) {
+	schema := JSONSchema()
+	if schema["$schema"] != JSONSchemaURL {
+		t.Errorf("expected $schema %q, got %v", JSONSchemaURL, schema["$schema"])
+	}
+
+	// The schema must survive a JSON round trip, as the schema command prints it
+	data, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf("failed to marshal schema: %v", err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal schema: %v", err)
+	}
+
+	check := decoded["properties"].(map[string]any)["checks"].(map[string]any)["items"].(map[string]any)
+	if got := check["required"]; !reflect.DeepEqual(got, []any{"id"}) {
+		t.Errorf("expected checks to require id, got %v", got)
+	}
+	wantAnyOf := []any{
+		map[string]any{"required": []any{"run"}},
+		map[string]any{"required": []any{"file", "grok"}},
+		map[string]any{"required": []any{"file", "assert"}},
+	}
+	if got := check["anyOf"]; !reflect.DeepEqual(got, wantAnyOf) {
+		t.Errorf("expected checks to require run, or file with grok or assert, got %v", got)
+	}
+	props := check["properties"].(map[string]any)
+	for _, key := range []string{"id", "run", "grok", "severity", "timeout", "allow_failure", "on"} {
+		if _, ok := props[key]; !ok {
+			t.Errorf("expected check property %q", key)
+		}
+	}
+	if got := props["severity"].(map[string]any)["enum"]; !reflect.DeepEqual(got, []any{"error", "warning", "info"}) {
+		t.Errorf("expected severity enum, got %v", got)
+	}
+	if _, ok := props["grok"].(map[string]any)["oneOf"]; !ok {
+		t.Error("expected grok to accept a string or a list")
+	}
+}
+
+// TestJSONSchema_FieldsDocumented fails when a configuration field is added
+// without a schema description.
+func TestJSONSchema_FieldsDocumented(t *testing.T) {
+	for _, typ := range []reflect.Type{reflect.TypeOf(Config{}), reflect.TypeOf(Prompt{}), reflect.TypeOf(Check{}), reflect.TypeOf(CheckDefaults{}), reflect.TypeOf(EventHandler{})} {
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			key := yamlKey(field)
+			if !field.IsExported() || key == "" {
+				continue
+			}
+			if schemaDescriptions[typ.Name()+"."+key] == "" {
+				t.Errorf("no schema description for %s.%s", typ.Name(), key)
+			}
+		}
+	}
+}
+
+func TestJSONSchema_DurationPattern(t *testing.T) {
+	re := regexp.MustCompile(durationPattern)
+	for _, s := range []string{"30s", "5m", "1h30m", "1.5s", "250ms", "0"} {
+		if !re.MatchString(s) {
+			t.Errorf("expected %q to match the duration pattern", s)
+		}
+	}
+	for _, s := range []string{"", "30", "5 m", "soon"} {
+		if re.MatchString(s) {
+			t.Errorf("expected %q not to match the duration pattern", s)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// matrixReference matches a {{.matrix.key}} placeholder and captures the key.
+var matrixReference = regexp.MustCompile(`\{\{\.matrix\.([a-zA-Z_][a-zA-Z0-9_]*)\}\}`)
+
+// validMatrixKey matches the keys of a matrix.
+var validMatrixKey = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// invalidIDChars matches the characters of a matrix value that cannot
+// appear in a check ID, such as the dot in a version number.
+var invalidIDChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// expandMatrix replaces each check that has a matrix with one check per
+// combination of the matrix values. A generated check's ID is the template
+// ID followed by -key-value for each key in sorted order, with characters not
+// allowed in IDs replaced by underscores, and {{.matrix.key}}
+// placeholders in its fields are replaced by the combination's values.
+// Requires, optional_requires and requires_any entries that name a template are replaced
+// by all of the checks generated from it.
+func (c *Config) expandMatrix() error {
+	expanded := make([]Check, 0, len(c.Checks))
+	source := make([]int, 0, len(c.Checks))
+	generated := make(map[string][]string)
+
+	for i, check := range c.Checks {
+		if len(check.Matrix) == 0 {
+			if err := c.checkMatrixReferences(check, i, nil); err != nil {
+				return err
+			}
+			expanded = append(expanded, check)
+			source = append(source, i)
+			continue
+		}
+
+		keys, err := c.matrixKeys(check, i)
+		if err != nil {
+			return err
+		}
+		if err := c.checkMatrixReferences(check, i, check.Matrix); err != nil {
+			return err
+		}
+
+		for _, combination := range matrixCombinations(keys, check.Matrix) {
+			gen := check.withMatrix(keys, combination)
+			expanded = append(expanded, gen)
+			source = append(source, i)
+			generated[check.ID] = append(generated[check.ID], gen.ID)
+		}
+	}
+
+	if len(generated) == 0 {
+		return nil
+	}
+	for i := range expanded {
+		expanded[i].Requires = expandMatrixRefs(expanded[i].Requires, generated)
+		expanded[i].OptionalRequires = expandMatrixRefs(expanded[i].OptionalRequires, generated)
+		expanded[i].RequiresAny = expandMatrixRefs(expanded[i].RequiresAny, generated)
+	}
+	c.Checks = expanded
+	c.checkSource = source
+	return nil
+}
+
+// matrixKeys validates a check's matrix and returns its keys in sorted order.
+func (c *Config) matrixKeys(check Check, index int) ([]string, error) {
+	keys := make([]string, 0, len(check.Matrix))
+	for key, values := range check.Matrix {
+		if !validMatrixKey.MatchString(key) {
+			return nil, &ConfigError{
+				Message: fmt.Sprintf("check %q has invalid matrix key %q: must start with a letter or underscore and contain only letters, digits and underscores", check.ID, key),
+				LineNum: c.FindCheckNodeLine(check.ID, index),
+			}
+		}
+		if len(values) == 0 {
+			return nil, &ConfigError{
+				Message: fmt.Sprintf("check %q matrix key %q has no values", check.ID, key),
+				LineNum: c.FindCheckNodeLine(check.ID, index),
+			}
+		}
+		for _, value := range values {
+			if strings.TrimSpace(value) == "" {
+				return nil, &ConfigError{
+					Message: fmt.Sprintf("check %q matrix key %q has an empty value", check.ID, key),
+					LineNum: c.FindCheckNodeLine(check.ID, index),
+				}
+			}
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// checkMatrixReferences checks that every {{.matrix.key}} placeholder in a
+// check names a key of its matrix.
+func (c *Config) checkMatrixReferences(check Check, index int, matrix map[string][]string) error {
+	for _, field := range check.matrixFields() {
+		for _, match := range matrixReference.FindAllStringSubmatch(*field, -1) {
+			if _, ok := matrix[match[1]]; !ok {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q references undefined matrix key %q", check.ID, match[1]),
+					LineNum: c.FindCheckNodeLine(check.ID, index),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// matrixCombinations returns every combination of the matrix values, one
+// value per key in keys order, varying the last key fastest.
+func matrixCombinations(keys []string, matrix map[string][]string) [][]string {
+	combinations := [][]string{{}}
+	for _, key := range keys {
+		next := make([][]string, 0, len(combinations)*len(matrix[key]))
+		for _, combination := range combinations {
+			for _, value := range matrix[key] {
+				next = append(next, append(append([]string{}, combination...), value))
+			}
+		}
+		combinations = next
+	}
+	return combinations
+}
+
+// withMatrix returns a copy of the check for one combination of its matrix
+// values, with its ID suffixed and its placeholders replaced.
+func (check Check) withMatrix(keys, values []string) Check {
+	gen := check
+	gen.Matrix = nil
+	gen.Grok = append(GrokSpec(nil), check.Grok...)
+	gen.Requires = append([]string(nil), check.Requires...)
+	gen.OptionalRequires = append([]string(nil), check.OptionalRequires...)
+	gen.RequiresAny = append([]string(nil), check.RequiresAny...)
+
+	var id strings.Builder
+	id.WriteString(check.ID)
+	replacements := make([]string, 0, 2*len(keys))
+	for i, key := range keys {
+		fmt.Fprintf(&id, "-%s-%s", key, invalidIDChars.ReplaceAllString(values[i], "_"))
+		replacements = append(replacements, "{{.matrix."+key+"}}", values[i])
+	}
+	gen.ID = id.String()
+
+	replacer := strings.NewReplacer(replacements...)
+	for _, field := range gen.matrixFields() {
+		*field = replacer.Replace(*field)
+	}
+	return gen
+}
+
+// matrixFields returns pointers to the check fields that may contain
+// {{.matrix.key}} placeholders.
+func (check *Check) matrixFields() []*string {
+	fields := []*string{&check.Description, &check.Run, &check.File, &check.Assert, &check.Suggestion, &check.Fix}
+	for i := range check.Grok {
+		fields = append(fields, &check.Grok[i])
+	}
+	return fields
+}
+
+// expandMatrixRefs replaces references to matrix templates with the IDs of
+// the checks generated from them.
+func expandMatrixRefs(refs []string, generated map[string][]string) []string {
+	if len(refs) == 0 {
+		return refs
+	}
+	out := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if ids, ok := generated[ref]; ok {
+			out = append(out, ids...)
+			continue
+		}
+		out = append(out, ref)
+	}
+	return out
+}
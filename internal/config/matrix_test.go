@@ -0,0 +1,144 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeMatrixConfig(t *testing.T, content string) string {
+	t.Helper()
+	configPath := filepath.Join(t.TempDir(), "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return configPath
+}
+
+func TestLoad_Matrix(t *testing.T) {
+	configPath := writeMatrixConfig(t, `
+version: "1"
+vars:
+  pkg: ./...
+checks:
+  - id: test
+    run: GOTOOLCHAIN=go{{.matrix.go}} GOOS={{.matrix.os}} go test {{.pkg}}
+    suggestion: "Tests fail on go {{.matrix.go}} ({{.matrix.os}})"
+    matrix:
+      go: [1.21, 1.22]
+      os: [linux, darwin]
+  - id: report
+    run: ./report.sh
+    requires: [test]
+`)
+
+	cfg, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct{ id, run, suggestion string }{
+		{"test-go-1_21-os-linux", "GOTOOLCHAIN=go1.21 GOOS=linux go test ./...", "Tests fail on go 1.21 (linux)"},
+		{"test-go-1_21-os-darwin", "GOTOOLCHAIN=go1.21 GOOS=darwin go test ./...", "Tests fail on go 1.21 (darwin)"},
+		{"test-go-1_22-os-linux", "GOTOOLCHAIN=go1.22 GOOS=linux go test ./...", "Tests fail on go 1.22 (linux)"},
+		{"test-go-1_22-os-darwin", "GOTOOLCHAIN=go1.22 GOOS=darwin go test ./...", "Tests fail on go 1.22 (darwin)"},
+	}
+	if len(cfg.Checks) != len(want)+1 {
+		t.Fatalf("expected %d checks, got %d", len(want)+1, len(cfg.Checks))
+	}
+	var ids []string
+	for i, w := range want {
+		check := cfg.Checks[i]
+		if check.ID != w.id {
+			t.Errorf("check %d: expected id %q, got %q", i, w.id, check.ID)
+		}
+		if check.Run != w.run {
+			t.Errorf("check %s: expected run %q, got %q", w.id, w.run, check.Run)
+		}
+		if check.Suggestion != w.suggestion {
+			t.Errorf("check %s: expected suggestion %q, got %q", w.id, w.suggestion, check.Suggestion)
+		}
+		if check.Matrix != nil {
+			t.Errorf("check %s: expected matrix to be cleared, got %v", w.id, check.Matrix)
+		}
+		ids = append(ids, w.id)
+	}
+
+	// Requiring the template requires every generated check
+	if got := cfg.Checks[4].Requires; !reflect.DeepEqual(got, ids) {
+		t.Errorf("expected report to require %v, got %v", ids, got)
+	}
+}
+
+func TestLoad_MatrixErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		checks  string
+		wantErr string
+	}{
+		{
+			name:    "undefined key",
+			checks:  "  - id: test\n    run: go test -tags {{.matrix.tag}}\n    matrix:\n      go: [\"1.21\"]\n",
+			wantErr: `check "test" references undefined matrix key "tag"`,
+		},
+		{
+			name:    "reference without matrix",
+			checks:  "  - id: test\n    run: go test -tags {{.matrix.tag}}\n",
+			wantErr: `check "test" references undefined matrix key "tag"`,
+		},
+		{
+			name:    "no values",
+			checks:  "  - id: test\n    run: go test\n    matrix:\n      go: []\n",
+			wantErr: `check "test" matrix key "go" has no values`,
+		},
+		{
+			name:    "empty value",
+			checks:  "  - id: test\n    run: go test\n    matrix:\n      go: [\"1.21\", \"\"]\n",
+			wantErr: `check "test" matrix key "go" has an empty value`,
+		},
+		{
+			name:    "invalid key",
+			checks:  "  - id: test\n    run: go test\n    matrix:\n      go-version: [\"1.21\"]\n",
+			wantErr: `check "test" has invalid matrix key "go-version"`,
+		},
+		{
+			name:    "duplicate generated id",
+			checks:  "  - id: test\n    run: go test\n    matrix:\n      go: [\"1.21\", \"1_21\"]\n",
+			wantErr: "duplicate check id: test-go-1_21",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(writeMatrixConfig(t, "version: \"1\"\nchecks:\n"+tt.checks))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestLoad_MatrixErrorLine(t *testing.T) {
+	configPath := writeMatrixConfig(t, `version: "1"
+checks:
+  - id: test
+    run: go test
+    matrix:
+      go: ["1.21", "1.22"]
+  - id: lint
+    run: golangci-lint run
+    severity: fatal
+`)
+
+	_, err := Load(configPath)
+	cfgErr, ok := err.(*ConfigError)
+	if !ok {
+		t.Fatalf("expected ConfigError, got %T: %v", err, err)
+	}
+	// Expanded checks must not shift the line of the checks after them
+	if cfgErr.LineNum != 7 {
+		t.Errorf("expected error on line 7, got %d (%v)", cfgErr.LineNum, err)
+	}
+}
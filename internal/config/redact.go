@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// BuiltinRedactPatterns are the secret patterns redact_builtins adds: AWS
+// access key IDs, AWS secret access key assignments, HTTP bearer tokens and
+// GitHub tokens.
+var BuiltinRedactPatterns = []string{
+	`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`,
+	`(?i)aws_secret_access_key["']?\s*[:=]\s*["']?[A-Za-z0-9/+=]{40}`,
+	`(?i)\bbearer\s+[A-Za-z0-9._~+/-]+=*`,
+	`\bgh[opsur]_[A-Za-z0-9]{36,}\b`,
+}
+
+// RedactPatterns returns the patterns whose matches are masked in a check's
+// output: the top-level redact list, the check's own list and, if either
+// enables redact_builtins, BuiltinRedactPatterns. A nil check returns the
+// top-level patterns only.
+func (c *Config) RedactPatterns(check *Check) []string {
+	patterns := append([]string(nil), c.Redact...)
+	builtins := c.RedactBuiltins
+	if check != nil {
+		patterns = append(patterns, check.Redact...)
+		builtins = builtins || check.RedactBuiltins
+	}
+	if builtins {
+		patterns = append(patterns, BuiltinRedactPatterns...)
+	}
+	return patterns
+}
+
+// Redactions compiles RedactPatterns for check. The patterns are validated
+// when the config loads, so any that fail to compile are left out.
+func (c *Config) Redactions(check *Check) []*regexp.Regexp {
+	var res []*regexp.Regexp
+	for _, pattern := range c.RedactPatterns(check) {
+		if re, err := regexp.Compile(pattern); err == nil {
+			res = append(res, re)
+		}
+	}
+	return res
+}
+
+// validateRedactPatterns returns an error for the first pattern that is
+// empty or not a valid regular expression.
+func validateRedactPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if pattern == "" {
+			return fmt.Errorf("empty redact pattern")
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid redact pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// validRequiresEntry matches a requires entry: a check ID or a glob pattern
+// over check IDs.
+var validRequiresEntry = regexp.MustCompile(`^[a-zA-Z0-9_*?\[\]!^-]+$`)
+
+// isRequiresGlob reports whether a requires entry is a glob pattern. Check
+// IDs can't contain glob metacharacters, so any entry that does is one.
+func isRequiresGlob(ref string) bool {
+	return strings.ContainsAny(ref, "*?[")
+}
+
+// expandRequiresGlobs replaces glob patterns such as "test-*" in the
+// requires, optional_requires and requires_any of each check with the IDs of
+// the other checks they match, in config order. A pattern that matches no
+// check is an error.
+func (c *Config) expandRequiresGlobs() error {
+	for i := range c.Checks {
+		check := &c.Checks[i]
+		for _, field := range []struct {
+			key  string
+			refs *[]string
+		}{
+			{"requires", &check.Requires},
+			{"optional_requires", &check.OptionalRequires},
+			{"requires_any", &check.RequiresAny},
+		} {
+			expanded, err := c.expandRefs(check.ID, *field.refs)
+			if err != nil {
+				return &ConfigError{
+					Message: fmt.Sprintf("check %q %s: %v", check.ID, field.key, err),
+					LineNum: c.FindCheckNodeLine(check.ID, i),
+				}
+			}
+			*field.refs = expanded
+		}
+	}
+	return nil
+}
+
+// expandRefs expands the glob patterns in refs, the dependencies of the
+// check with the given ID, which never matches itself. Each ID appears once.
+func (c *Config) expandRefs(id string, refs []string) ([]string, error) {
+	hasGlob := false
+	for _, ref := range refs {
+		hasGlob = hasGlob || isRequiresGlob(ref)
+	}
+	if !hasGlob {
+		return refs, nil
+	}
+
+	out := make([]string, 0, len(refs))
+	seen := make(map[string]bool, len(refs))
+	add := func(ref string) {
+		if !seen[ref] {
+			seen[ref] = true
+			out = append(out, ref)
+		}
+	}
+	for _, ref := range refs {
+		if !isRequiresGlob(ref) {
+			add(ref)
+			continue
+		}
+		if _, err := path.Match(ref, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", ref, err)
+		}
+		matched := false
+		for _, other := range c.Checks {
+			if ok, _ := path.Match(ref, other.ID); ok && other.ID != id {
+				add(other.ID)
+				matched = true
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("pattern %q matches no check", ref)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoad_RequiresGlob(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "vibeguard.yaml")
+	content := `
+version: "1"
+checks:
+  - id: test-unit
+    run: go test ./...
+  - id: report
+    run: ./report.sh
+    requires: [build, "test-*"]
+    optional_requires: ["lint-?"]
+  - id: test-integration
+    run: go test -tags integration ./...
+  - id: build
+    run: go build ./...
+  - id: lint-a
+    run: golangci-lint run
+  - id: test-all
+    run: "true"
+    requires: ["test-*"]
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	report := cfg.Checks[1]
+	if want := []string{"build", "test-unit", "test-integration", "test-all"}; !reflect.DeepEqual(report.Requires, want) {
+		t.Errorf("expected requires %v, got %v", want, report.Requires)
+	}
+	if want := []string{"lint-a"}; !reflect.DeepEqual(report.OptionalRequires, want) {
+		t.Errorf("expected optional_requires %v, got %v", want, report.OptionalRequires)
+	}
+
+	// A pattern never matches the check it belongs to
+	if want := []string{"test-unit", "test-integration"}; !reflect.DeepEqual(cfg.Checks[5].Requires, want) {
+		t.Errorf("expected test-all to require %v, got %v", want, cfg.Checks[5].Requires)
+	}
+}
+
+func TestLoad_RequiresGlobErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		requires string
+		wantErr  string
+	}{
+		{name: "no match", requires: `["e2e-*"]`, wantErr: `check "report" requires: pattern "e2e-*" matches no check`},
+		{name: "only itself", requires: `["rep*"]`, wantErr: `check "report" requires: pattern "rep*" matches no check`},
+		{name: "invalid pattern", requires: `["test-[a"]`, wantErr: `check "report" requires: invalid pattern "test-[a"`},
+		{name: "cycle after expansion", requires: `["test-*"]`, wantErr: "cyclic dependency detected: report -> test-unit -> report"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "vibeguard.yaml")
+			content := `
+version: "1"
+checks:
+  - id: report
+    run: ./report.sh
+    requires: ` + tt.requires + `
+  - id: test-unit
+    run: go test ./...
+    optional_requires: [report]
+`
+			if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := Load(configPath)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
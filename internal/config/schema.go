@@ -5,10 +5,33 @@ import "time"
 
 // Config represents the complete VibeGuard configuration.
 type Config struct {
-	Version string            `yaml:"version"`
-	Vars    map[string]string `yaml:"vars"`
-	Prompts []Prompt          `yaml:"prompts,omitempty"`
-	Checks  []Check           `yaml:"checks"`
+	Version           string              `yaml:"version"`
+	Name              string              `yaml:"name,omitempty"`        // Label of the runs in reports; defaults to the config's directory name
+	Description       string              `yaml:"description,omitempty"` // What the config checks, shown in reports
+	Vars              map[string]string   `yaml:"vars"`
+	VarsFromCmd       map[string]string   `yaml:"vars_from_cmd,omitempty"` // Variables set to the output of a command, run once at load
+	GrokPatterns      map[string]string   `yaml:"grok_patterns,omitempty"` // Custom named grok patterns (name -> pattern)
+	Prompts           []Prompt            `yaml:"prompts,omitempty"`
+	Shell             string              `yaml:"shell,omitempty"`              // Default shell for checks that don't set one
+	Parallel          string              `yaml:"parallel,omitempty"`           // Default max parallel checks: a number or "auto"
+	Before            []string            `yaml:"before,omitempty"`             // Commands run once before the checks; a failure aborts the run
+	After             []string            `yaml:"after,omitempty"`              // Commands run once after the checks, however they ended
+	Redact            []string            `yaml:"redact,omitempty"`             // Regular expressions masked in every command's captured output
+	RedactBuiltins    bool                `yaml:"redact_builtins,omitempty"`    // Also mask BuiltinRedactPatterns
+	SeverityOverrides map[string]Severity `yaml:"severity_overrides,omitempty"` // Severities by check ID, replacing the checks' own
+	Defaults          CheckDefaults       `yaml:"defaults,omitempty"`           // Values for the fields checks leave unset
+	Checks            []Check             `yaml:"checks"`
+	ChecksFiles       []string            `yaml:"checks_files,omitempty"` // Glob patterns, relative to the config, of files whose checks are added
+	ChecksFrom        string              `yaml:"checks_from,omitempty"`  // Command whose JSON output adds checks, run once at load
+	// dir is the absolute directory of the config file (not exported)
+	dir string `yaml:"-"`
+	// path is the absolute path of the config file and hash the SHA-256 of
+	// its content, in hex (not exported)
+	path string `yaml:"-"`
+	hash string `yaml:"-"`
+	// checkSource maps each check to its index in the YAML checks sequence
+	// once matrix checks are expanded (not exported)
+	checkSource []int `yaml:"-"`
 	// yamlRoot stores the parsed YAML node tree for line number lookups (not exported)
 	yamlRoot interface{} `yaml:"-"`
 }
@@ -23,18 +46,47 @@ type Prompt struct {
 
 // Check represents a single check to execute.
 type Check struct {
-	ID         string       `yaml:"id"`
-	Run        string       `yaml:"run"`
-	Grok       GrokSpec     `yaml:"grok"`
-	File       string       `yaml:"file"`
-	Assert     string       `yaml:"assert"`
-	Severity   Severity     `yaml:"severity"`
-	Suggestion string       `yaml:"suggestion"`
-	Fix        string       `yaml:"fix,omitempty"`
-	Requires   []string     `yaml:"requires"`
-	Tags       []string     `yaml:"tags,omitempty"`
-	Timeout    Duration     `yaml:"timeout"`
-	On         EventHandler `yaml:"on,omitempty"`
+	ID               string              `yaml:"id"`
+	Description      string              `yaml:"description,omitempty"` // What the check verifies, shown in list and violation output
+	Category         string              `yaml:"category,omitempty"`    // Kind of check, one of Categories; groups the run output
+	Run              string              `yaml:"run"`
+	Grok             GrokSpec            `yaml:"grok"`
+	GrokRequired     bool                `yaml:"grok_required,omitempty"` // Fail the check when a grok pattern matches nothing
+	File             string              `yaml:"file"`
+	Capture          string              `yaml:"capture,omitempty"`  // Output grok and assert consume: stdout, stderr or combined (default)
+	Coverage         CoverageSpec        `yaml:"coverage,omitempty"` // Structured report the coverage variable is read from
+	Assert           string              `yaml:"assert"`
+	Severity         Severity            `yaml:"severity"`
+	Suggestion       string              `yaml:"suggestion"`
+	Fix              string              `yaml:"fix,omitempty"`
+	Requires         []string            `yaml:"requires"`
+	OptionalRequires []string            `yaml:"optional_requires,omitempty"` // Checks this one runs after if they run; ordering only, never skips
+	RequiresAny      []string            `yaml:"requires_any,omitempty"`      // Checks this one runs after; skipped only if none of them passed
+	Tags             []string            `yaml:"tags,omitempty"`
+	Paths            []string            `yaml:"paths,omitempty"` // Glob patterns of files the check covers
+	Scope            string              `yaml:"scope,omitempty"` // "changed" to fill {{.packages}} with what changed since --changed-from
+	Timeout          Duration            `yaml:"timeout"`
+	KillGrace        Duration            `yaml:"kill_grace,omitempty"`       // Time between kill_signal and SIGKILL on timeout (default: 5s)
+	KillSignal       string              `yaml:"kill_signal,omitempty"`      // Signal sent first on timeout or cancellation: SIGINT, SIGTERM (default) or SIGKILL
+	MaxOutputBytes   int                 `yaml:"max_output_bytes,omitempty"` // Captured size limit of each output stream (default: 10MB)
+	Shell            string              `yaml:"shell,omitempty"`            // Shell the run and fix commands execute through (default: sh, or cmd on Windows)
+	AllowFailure     bool                `yaml:"allow_failure,omitempty"`    // Report failures at the check's severity without affecting the exit code
+	Redact           []string            `yaml:"redact,omitempty"`           // Regular expressions masked in the check's captured output, besides the top-level ones
+	RedactBuiltins   bool                `yaml:"redact_builtins,omitempty"`  // Also mask BuiltinRedactPatterns
+	On               EventHandler        `yaml:"on,omitempty"`
+	Matrix           map[string][]string `yaml:"matrix,omitempty"` // Parameter sets the check is expanded across, referenced as {{.matrix.key}}
+}
+
+// CheckDefaults holds the values of the fields a check leaves unset. A
+// check's own value takes precedence, and the built-in default applies when
+// neither sets one.
+type CheckDefaults struct {
+	Severity       Severity `yaml:"severity,omitempty"`
+	Timeout        Duration `yaml:"timeout,omitempty"`
+	KillGrace      Duration `yaml:"kill_grace,omitempty"`
+	KillSignal     string   `yaml:"kill_signal,omitempty"`
+	MaxOutputBytes int      `yaml:"max_output_bytes,omitempty"`
+	Capture        string   `yaml:"capture,omitempty"` // Not applied to checks reading a file
 }
 
 // Severity represents the severity level of a check failure.
@@ -43,8 +95,65 @@ type Severity string
 const (
 	SeverityError   Severity = "error"
 	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info" // Reported, but never affects the exit code
+)
+
+// Severities lists the values accepted for the severity setting.
+var Severities = []Severity{SeverityError, SeverityWarning, SeverityInfo}
+
+// Shells lists the values accepted for the shell setting.
+var Shells = []string{"sh", "bash", "pwsh", "powershell", "cmd"}
+
+// Output streams a check can capture for grok patterns
+const (
+	CaptureStdout   = "stdout"
+	CaptureStderr   = "stderr"
+	CaptureCombined = "combined"
+)
+
+// Captures lists the values accepted for the capture setting.
+var Captures = []string{CaptureStdout, CaptureStderr, CaptureCombined}
+
+// Categories of checks, which group the run output and summary
+const (
+	CategoryLint      = "lint"
+	CategoryFormat    = "format"
+	CategoryTypecheck = "typecheck"
+	CategoryTest      = "test"
+	CategoryBuild     = "build"
+	CategorySecurity  = "security"
 )
 
+// Categories lists the values accepted for the category setting, in the
+// order the run output groups checks in.
+var Categories = []string{CategoryLint, CategoryFormat, CategoryTypecheck, CategoryTest, CategoryBuild, CategorySecurity}
+
+// Scopes a check's {{.packages}} variable can be computed for
+const (
+	ScopeChanged = "changed" // The Go packages, or files for jest, changed since --changed-from
+)
+
+// Scopes lists the values accepted for the scope setting.
+var Scopes = []string{ScopeChanged}
+
+// ScopeVar is the variable a scoped check's packages are substituted for.
+const ScopeVar = "packages"
+
+// KillSignals lists the values accepted for the kill_signal setting.
+var KillSignals = []string{"SIGINT", "SIGTERM", "SIGKILL"}
+
+// Variables the orchestrator adds to every assertion, alongside the grok
+// captures
+const (
+	AssertVarExitCode   = "exit_code"   // The command's exit code
+	AssertVarTimedout   = "timedout"    // 1 if the command timed out, else 0
+	AssertVarDurationMS = "duration_ms" // How long the command ran, in milliseconds
+)
+
+// ReservedAssertVars lists the built-in assertion variables, which grok
+// captures may not use as names.
+var ReservedAssertVars = []string{AssertVarExitCode, AssertVarTimedout, AssertVarDurationMS}
+
 // GrokSpec allows grok to be either a single string or a list of strings.
 type GrokSpec []string
 
@@ -57,6 +166,9 @@ const DefaultTimeout = 30 * time.Second
 // DefaultParallel is the default number of parallel checks.
 const DefaultParallel = 4
 
+// ParallelAuto is the parallel setting that runs one check per CPU.
+const ParallelAuto = "auto"
+
 // ConfigFileNames is the list of config file names to search for, in order.
 var ConfigFileNames = []string{
 	"vibeguard.yaml",
@@ -0,0 +1,205 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+// EnvFileName is the file next to the config that supplies variables.
+const EnvFileName = ".env"
+
+// VarCommandTimeout is how long each vars_from_cmd command may run.
+const VarCommandTimeout = 30 * time.Second
+
+// varReference matches a {{.name}} variable reference.
+var varReference = regexp.MustCompile(`\{\{\.([a-zA-Z_][a-zA-Z0-9_]*)\}\}`)
+
+// validVarName matches the names accepted in .env files and --var flags.
+var validVarName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// LoadWithVars is like Load, but overrides the variables used for
+// interpolation with vars. Variables are resolved in order of precedence:
+// vars, then the .env file next to the config, then the config's vars map.
+func LoadWithVars(path string, vars map[string]string) (*Config, error) {
+	return load(path, vars)
+}
+
+// ParseVar parses a NAME=VALUE variable assignment.
+func ParseVar(s string) (string, string, error) {
+	name, value, ok := strings.Cut(s, "=")
+	name = strings.TrimSpace(name)
+	if !ok || !validVarName.MatchString(name) {
+		return "", "", fmt.Errorf("invalid variable %q: expected NAME=VALUE", s)
+	}
+	return name, value, nil
+}
+
+// loadEnvFile reads the .env file in dir. A missing file yields no variables.
+func loadEnvFile(dir string) (map[string]string, error) {
+	path := filepath.Join(dir, EnvFileName)
+	data, err := os.ReadFile(path) // #nosec G304 - path is the .env file next to the config
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, &ConfigError{Message: "failed to read " + EnvFileName, Cause: err, FileName: path}
+	}
+
+	vars, err := parseEnv(data)
+	if err != nil {
+		return nil, &ConfigError{Message: "failed to parse " + EnvFileName, Cause: err, FileName: path}
+	}
+	return vars, nil
+}
+
+// parseEnv parses KEY=VALUE lines. Blank lines and lines starting with #
+// are skipped, an "export " prefix is allowed, and values may be wrapped in
+// single or double quotes.
+func parseEnv(data []byte) (map[string]string, error) {
+	vars := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		name, value, err := ParseVar(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		vars[name] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return vars, nil
+}
+
+// runVarCommands runs the vars_from_cmd commands and returns their output,
+// without trailing newlines, as variables. Commands whose variable is set by
+// one of overrides are not run. Commands run in name order, once per load.
+func (c *Config) runVarCommands(overrides ...map[string]string) (map[string]string, error) {
+	if len(c.VarsFromCmd) == 0 || (c.Shell != "" && !isValidShell(c.Shell)) {
+		// Validate reports an invalid shell
+		return nil, nil
+	}
+	line := c.findTopLevelKeyLine("vars_from_cmd")
+
+	names := make([]string, 0, len(c.VarsFromCmd))
+	for name, command := range c.VarsFromCmd {
+		if !validVarName.MatchString(name) {
+			return nil, &ConfigError{
+				Message: fmt.Sprintf("vars_from_cmd has invalid variable name %q: must start with a letter or underscore and contain only letters, digits and underscores", name),
+				LineNum: line,
+			}
+		}
+		if _, ok := c.Vars[name]; ok {
+			return nil, &ConfigError{
+				Message: fmt.Sprintf("variable %q is defined in both vars and vars_from_cmd", name),
+				LineNum: line,
+			}
+		}
+		if strings.TrimSpace(command) == "" {
+			return nil, &ConfigError{
+				Message: fmt.Sprintf("vars_from_cmd %q has an empty command", name),
+				LineNum: line,
+			}
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	exec := executor.New(c.dir)
+	vars := make(map[string]string, len(names))
+names:
+	for _, name := range names {
+		for _, layer := range overrides {
+			if _, ok := layer[name]; ok {
+				continue names
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), VarCommandTimeout)
+		result, err := exec.ExecuteWithOptions(ctx, name, c.VarsFromCmd[name], executor.Options{Shell: c.Shell})
+		cancel()
+		if err != nil {
+			return nil, &ConfigError{Message: fmt.Sprintf("vars_from_cmd %q failed", name), Cause: err, LineNum: line}
+		}
+		switch {
+		case result.Timedout:
+			return nil, &ConfigError{
+				Message: fmt.Sprintf("vars_from_cmd %q timed out after %s", name, VarCommandTimeout),
+				LineNum: line,
+			}
+		case !result.Success:
+			return nil, &ConfigError{
+				Message: fmt.Sprintf("vars_from_cmd %q failed with exit code %d: %s", name, result.ExitCode, strings.TrimSpace(result.Combined)),
+				LineNum: line,
+			}
+		}
+		vars[name] = strings.TrimRight(result.Stdout, "\r\n")
+	}
+	return vars, nil
+}
+
+// mergeVars overlays each of layers onto the config's vars, later layers
+// taking precedence.
+func (c *Config) mergeVars(layers ...map[string]string) {
+	for _, layer := range layers {
+		for name, value := range layer {
+			c.Vars[name] = value
+		}
+	}
+}
+
+// validateVarReferences checks that every {{.name}} reference in a hook
+// command and in a check's run command and file path names a defined
+// variable. Suggestions and fix commands are not checked, since they may also
+// reference grok captures, nor is {{.packages}} in a scoped check, which the
+// orchestrator substitutes when the check runs.
+func (c *Config) validateVarReferences() error {
+	for _, hook := range c.hooks() {
+		for _, command := range hook.commands {
+			for _, match := range varReference.FindAllStringSubmatch(command, -1) {
+				if _, ok := c.Vars[match[1]]; !ok {
+					return &ConfigError{
+						Message: fmt.Sprintf("%s hook references undefined variable %q (define it in vars, %s or with --var)", hook.key, match[1], EnvFileName),
+						LineNum: c.findTopLevelKeyLine(hook.key),
+					}
+				}
+			}
+		}
+	}
+	for i, check := range c.Checks {
+		for _, field := range []string{check.Run, check.File} {
+			for _, match := range varReference.FindAllStringSubmatch(field, -1) {
+				if check.Scope != "" && match[1] == ScopeVar {
+					continue
+				}
+				if _, ok := c.Vars[match[1]]; !ok {
+					return &ConfigError{
+						Message: fmt.Sprintf("check %q references undefined variable %q (define it in vars, %s or with --var)", check.ID, match[1], EnvFileName),
+						LineNum: c.FindCheckNodeLine(check.ID, i),
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadWithVars_Precedence(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "vibeguard.yaml")
+
+	content := `
+version: "1"
+vars:
+  a: config
+  b: config
+  c: config
+checks:
+  - id: echo
+    run: echo {{.a}} {{.b}} {{.c}}
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	env := "# comment\n\nb=env\nexport c=\"env\"\n"
+	if err := os.WriteFile(filepath.Join(dir, EnvFileName), []byte(env), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadWithVars(configPath, map[string]string{"c": "cli"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := cfg.Checks[0].Run, "echo config env cli"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	// Without --var values, .env still overrides the config
+	cfg, err = Load(configPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := cfg.Checks[0].Run, "echo config env env"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestLoad_UndefinedVariable(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "vibeguard.yaml")
+
+	content := `
+version: "1"
+vars:
+  packages: ./...
+checks:
+  - id: test
+    run: go test {{.packages}} -run {{.pattern}}
+    suggestion: "Coverage is {{.coverage}}%"
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Load(configPath)
+	if err == nil {
+		t.Fatal("expected error for undefined variable")
+	}
+	if !IsConfigError(err) {
+		t.Errorf("expected ConfigError, got %T", err)
+	}
+	if !strings.Contains(err.Error(), `check "test" references undefined variable "pattern"`) {
+		t.Errorf("expected error naming the check and variable, got: %v", err)
+	}
+
+	// Supplying the variable at runtime resolves it; the suggestion's grok
+	// reference is left for display-time interpolation
+	cfg, err := LoadWithVars(configPath, map[string]string{"pattern": "TestFoo"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := cfg.Checks[0].Run, "go test ./... -run TestFoo"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestLoad_InvalidEnvFile(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "vibeguard.yaml")
+	if err := os.WriteFile(configPath, []byte("version: \"1\"\nchecks:\n  - id: a\n    run: \"true\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, EnvFileName), []byte("OK=1\nnot a variable\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Load(configPath)
+	if err == nil {
+		t.Fatal("expected error for invalid .env file")
+	}
+	if !IsConfigError(err) || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("expected ConfigError naming line 2, got: %v", err)
+	}
+}
+
+func TestLoad_VarsFromCmd(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "vibeguard.yaml")
+
+	content := `
+version: "1"
+vars:
+  pkg: ./...
+vars_from_cmd:
+  branch: echo main
+  overridden: exit 1
+checks:
+  - id: echo
+    run: echo {{.branch}} {{.pkg}} {{.overridden}}
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// A --var value replaces the command, which is then never run
+	cfg, err := LoadWithVars(configPath, map[string]string{"overridden": "cli"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := cfg.Checks[0].Run, "echo main ./... cli"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestLoad_VarsFromCmdErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		vars    string
+		wantErr string
+	}{
+		{name: "command fails", vars: "vars_from_cmd:\n  branch: echo no repo >&2; exit 3\n", wantErr: `vars_from_cmd "branch" failed with exit code 3: no repo`},
+		{name: "empty command", vars: "vars_from_cmd:\n  branch: \"\"\n", wantErr: `vars_from_cmd "branch" has an empty command`},
+		{name: "invalid name", vars: "vars_from_cmd:\n  git-branch: echo main\n", wantErr: `vars_from_cmd has invalid variable name "git-branch"`},
+		{name: "also static", vars: "vars:\n  branch: main\nvars_from_cmd:\n  branch: echo main\n", wantErr: `variable "branch" is defined in both vars and vars_from_cmd`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "vibeguard.yaml")
+			content := "version: \"1\"\n" + tt.vars + "checks:\n  - id: echo\n    run: echo {{.branch}}\n"
+			if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := Load(configPath)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseVar(t *testing.T) {
+	tests := []struct {
+		input     string
+		wantName  string
+		wantValue string
+		wantErr   bool
+	}{
+		{input: "pkg=./...", wantName: "pkg", wantValue: "./..."},
+		{input: "flags=-race -count=1", wantName: "flags", wantValue: "-race -count=1"},
+		{input: "empty=", wantName: "empty", wantValue: ""},
+		{input: "novalue", wantErr: true},
+		{input: "=value", wantErr: true},
+		{input: "bad-name=x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			name, value, err := ParseVar(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %q=%q", name, value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if name != tt.wantName || value != tt.wantValue {
+				t.Errorf("expected %q=%q, got %q=%q", tt.wantName, tt.wantValue, name, value)
+			}
+		})
+	}
+}
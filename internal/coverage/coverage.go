@@ -0,0 +1,139 @@
+// Package coverage reads the total coverage percentage from the structured
+// reports test tools write, so coverage checks don't depend on the wording
+// of a tool's text summary.
+package coverage
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+// Variable is the name the coverage percentage is exposed under to
+// assertions and suggestions.
+const Variable = config.CoverageVar
+
+// Parse returns the total coverage percentage, from 0 to 100, in a report
+// of the given format, one of config.CoverageFormats.
+func Parse(format string, report []byte) (float64, error) {
+	switch format {
+	case config.CoverageGo:
+		return ParseGoProfile(report)
+	case config.CoverageJest:
+		return ParseJestSummary(report)
+	case config.CoveragePytest:
+		return ParsePytestJSON(report)
+	default:
+		return 0, fmt.Errorf("unknown coverage format %q", format)
+	}
+}
+
+// Format formats a percentage for the coverage variable, with at most two
+// decimals.
+func Format(percent float64) string {
+	return strconv.FormatFloat(math.Round(percent*100)/100, 'f', -1, 64)
+}
+
+// ParseGoProfile returns the statement coverage of a profile written by
+// go test -coverprofile, as go tool cover -func totals it: blocks listed
+// more than once, as with -coverpkg, count once and are covered if any
+// listing has a non-zero count.
+func ParseGoProfile(report []byte) (float64, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(report))
+	if !scanner.Scan() || !strings.HasPrefix(scanner.Text(), "mode: ") {
+		return 0, fmt.Errorf("not a Go coverage profile: missing mode line")
+	}
+
+	type block struct {
+		statements int
+		covered    bool
+	}
+	blocks := make(map[string]*block)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		// file.go:startLine.startCol,endLine.endCol statements count
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return 0, fmt.Errorf("invalid Go coverage profile line %q", line)
+		}
+		statements, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return 0, fmt.Errorf("invalid Go coverage profile line %q", line)
+		}
+		count, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid Go coverage profile line %q", line)
+		}
+		b, ok := blocks[fields[0]]
+		if !ok {
+			b = &block{statements: statements}
+			blocks[fields[0]] = b
+		}
+		b.covered = b.covered || count > 0
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	var total, covered int
+	for _, b := range blocks {
+		total += b.statements
+		if b.covered {
+			covered += b.statements
+		}
+	}
+	if total == 0 {
+		return 0, fmt.Errorf("go coverage profile has no statements")
+	}
+	return float64(covered) / float64(total) * 100, nil
+}
+
+// ParseJestSummary returns the line coverage of a coverage-summary.json
+// written by jest --coverageReporters=json-summary (or any istanbul
+// json-summary report).
+func ParseJestSummary(report []byte) (float64, error) {
+	var summary struct {
+		Total *struct {
+			Lines struct {
+				Pct any `json:"pct"` // "Unknown" when there are no lines
+			} `json:"lines"`
+		} `json:"total"`
+	}
+	if err := json.Unmarshal(report, &summary); err != nil {
+		return 0, fmt.Errorf("invalid jest coverage summary: %w", err)
+	}
+	if summary.Total == nil {
+		return 0, fmt.Errorf("jest coverage summary has no total")
+	}
+	pct, ok := summary.Total.Lines.Pct.(float64)
+	if !ok {
+		return 0, fmt.Errorf("jest coverage summary has no line percentage (got %v)", summary.Total.Lines.Pct)
+	}
+	return pct, nil
+}
+
+// ParsePytestJSON returns the total coverage of a coverage.json written by
+// pytest --cov-report=json (coverage.py's JSON report).
+func ParsePytestJSON(report []byte) (float64, error) {
+	var data struct {
+		Totals *struct {
+			PercentCovered *float64 `json:"percent_covered"`
+		} `json:"totals"`
+	}
+	if err := json.Unmarshal(report, &data); err != nil {
+		return 0, fmt.Errorf("invalid coverage.py JSON report: %w", err)
+	}
+	if data.Totals == nil || data.Totals.PercentCovered == nil {
+		return 0, fmt.Errorf("coverage.py JSON report has no totals.percent_covered")
+	}
+	return *data.Totals.PercentCovered, nil
+}
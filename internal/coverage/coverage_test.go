@@ -0,0 +1,107 @@
+package coverage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+// goProfile is a go test -coverprofile report; the repeated block is how
+// -coverpkg lists a block once per test binary.
+const goProfile = `mode: set
+github.com/example/app/calc.go:3.24,5.2 2 1
+github.com/example/app/calc.go:7.24,9.2 2 0
+github.com/example/app/calc.go:11.30,14.16 3 1
+github.com/example/app/calc.go:14.16,16.3 1 0
+github.com/example/app/calc.go:7.24,9.2 2 1
+`
+
+// jestSummary is a coverage/coverage-summary.json written by
+// jest --coverageReporters=json-summary.
+const jestSummary = `{"total": {"lines":{"total":120,"covered":99,"skipped":0,"pct":82.5},"statements":{"total":130,"covered":104,"skipped":0,"pct":80},"functions":{"total":20,"covered":15,"skipped":0,"pct":75},"branches":{"total":40,"covered":30,"skipped":0,"pct":75},"branchesTrue":{"total":0,"covered":0,"skipped":0,"pct":100}}
+,"/app/src/sum.js": {"lines":{"total":4,"covered":4,"skipped":0,"pct":100},"functions":{"total":1,"covered":1,"skipped":0,"pct":100},"statements":{"total":4,"covered":4,"skipped":0,"pct":100},"branches":{"total":0,"covered":0,"skipped":0,"pct":100}}
+}
+`
+
+// pytestJSON is a coverage.json written by pytest --cov-report=json.
+const pytestJSON = `{
+  "meta": {"format": 3, "version": "7.6.1", "timestamp": "2026-10-17T09:12:44.118302", "branch_coverage": false, "show_contexts": false},
+  "files": {
+    "app/calc.py": {
+      "executed_lines": [1, 2, 4, 5],
+      "summary": {"covered_lines": 4, "num_statements": 6, "percent_covered": 66.66666666666667, "percent_covered_display": "67", "missing_lines": 2, "excluded_lines": 0},
+      "missing_lines": [7, 8],
+      "excluded_lines": []
+    }
+  },
+  "totals": {"covered_lines": 61, "num_statements": 67, "percent_covered": 91.04477611940298, "percent_covered_display": "91", "missing_lines": 6, "excluded_lines": 0}
+}
+`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		format string
+		report string
+		want   string
+	}{
+		{config.CoverageGo, goProfile, "87.5"}, // 7 of 8 statements, the repeated block counting once
+		{config.CoverageJest, jestSummary, "82.5"},
+		{config.CoveragePytest, pytestJSON, "91.04"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			percent, err := Parse(tt.format, []byte(tt.report))
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			if got := Format(percent); got != tt.want {
+				t.Errorf("expected coverage %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		report  string
+		wantErr string
+	}{
+		{"go without mode line", config.CoverageGo, "app/calc.go:3.24,5.2 2 1\n", "missing mode line"},
+		{"go malformed block", config.CoverageGo, "mode: set\napp/calc.go:3.24,5.2 two 1\n", "invalid Go coverage profile line"},
+		{"go without statements", config.CoverageGo, "mode: atomic\n", "no statements"},
+		{"go text output", config.CoverageGo, "ok  \tapp\t0.01s\tcoverage: 75.0% of statements\n", "missing mode line"},
+		{"jest without lines", config.CoverageJest, `{"total":{"lines":{"total":0,"covered":0,"skipped":0,"pct":"Unknown"}}}`, "no line percentage"},
+		{"jest without total", config.CoverageJest, `{"/app/src/sum.js":{}}`, "no total"},
+		{"jest text output", config.CoverageJest, "Lines        : 82.5% ( 99/120 )", "invalid jest coverage summary"},
+		{"pytest without totals", config.CoveragePytest, `{"meta":{"format":3},"files":{}}`, "no totals.percent_covered"},
+		{"unknown format", "cobertura", "<coverage/>", `unknown coverage format "cobertura"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.format, []byte(tt.report))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		percent float64
+		want    string
+	}{
+		{100, "100"},
+		{0, "0"},
+		{66.66666666666667, "66.67"},
+		{82.5, "82.5"},
+	}
+	for _, tt := range tests {
+		if got := Format(tt.percent); got != tt.want {
+			t.Errorf("Format(%v) = %q, want %q", tt.percent, got, tt.want)
+		}
+	}
+}
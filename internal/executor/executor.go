@@ -2,18 +2,29 @@
 package executor
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"regexp"
+	"runtime"
 	"time"
 )
 
 // Exit codes for vibeguard.
 // These are designed for Claude Code hook compatibility where exit codes ≥2 are blocking.
+//
+// Precedence, from highest to lowest:
+//   - ExitCodeConfigError: the config or the command line is invalid; no check runs
+//   - the error exit code (--error-exit-code, default ExitCodeFailure): an
+//     error-severity check failed, any check timed out, or a warning-severity
+//     check failed under --warnings-as-errors
+//   - ExitCodeSuccess: every check passed, or only warning-severity checks failed
 const (
 	ExitCodeSuccess     = 0 // All checks passed
+	ExitCodeFailure     = 1 // Default error exit code for failures and timeouts
 	ExitCodeConfigError = 2 // Configuration error (config-time errors)
 
 	// Deprecated: ExitCodeViolation is no longer the default exit code for violations.
@@ -27,6 +38,19 @@ const (
 	ExitCodeTimeout = 4
 )
 
+// ExitCodeCancelled is recorded on a Result whose command was killed because
+// its context was cancelled, e.g. by --fail-fast=cancel. It is distinct from
+// the -1 recorded for checks skipped without running.
+const ExitCodeCancelled = -2
+
+// waitDelay bounds how long a finished or killed command waits for processes
+// it started in the background to release its output pipes.
+const waitDelay = 2 * time.Second
+
+// DefaultKillGrace is how long a timed out or cancelled command has to exit
+// after SIGTERM before its process group is killed.
+const DefaultKillGrace = 5 * time.Second
+
 // Result contains the execution result of a check command.
 type Result struct {
 	CheckID   string
@@ -34,10 +58,13 @@ type Result struct {
 	Stdout    string
 	Stderr    string
 	Combined  string
-	Duration  time.Duration
+	StartTime time.Time     // When the command started; zero if it never ran
+	EndTime   time.Time     // StartTime plus Duration
+	Duration  time.Duration // How long the command ran; for a timed out command, its timeout
 	Success   bool
 	Timedout  bool
 	Cancelled bool // True if the check was cancelled (e.g., by fail-fast)
+	Cached    bool // True if the result was replayed from the cache instead of running the command
 	Error     error
 }
 
@@ -59,22 +86,123 @@ func New(workDir string) *Executor {
 	}
 }
 
+// Options controls optional execution behavior.
+type Options struct {
+	// Stream, if set, receives stdout and stderr lines in real time while the
+	// command runs. Output is still captured in full for the Result.
+	Stream *LineStreamer
+
+	// Shell is the shell the command runs through: sh, bash, pwsh,
+	// powershell or cmd. Empty means DefaultShell().
+	Shell string
+
+	// KillGrace is how long the command's process group has to exit after
+	// SIGTERM on timeout or cancellation before it gets SIGKILL. Zero means
+	// DefaultKillGrace.
+	KillGrace time.Duration
+
+	// KillSignal is the signal sent to the process group first on timeout
+	// or cancellation: SIGINT, SIGTERM or SIGKILL. SIGKILL skips the grace
+	// period. Empty means SIGTERM.
+	KillSignal string
+
+	// MaxOutputBytes limits how much of each of stdout and stderr is
+	// captured; the rest is discarded and replaced by a truncation marker.
+	// Zero means DefaultMaxOutputBytes.
+	MaxOutputBytes int
+
+	// Env holds NAME=VALUE variables added to the command's environment.
+	Env []string
+
+	// Redact lists patterns whose matches are replaced with RedactMask in
+	// the captured output and, line by line, in the streamed output.
+	Redact []*regexp.Regexp
+}
+
+// DefaultShell returns the shell commands run through when none is
+// configured: cmd on Windows and sh everywhere else.
+func DefaultShell() string {
+	if runtime.GOOS == "windows" {
+		return "cmd"
+	}
+	return "sh"
+}
+
+// shellArgs returns the arguments that make shell run command.
+func shellArgs(shell, command string) ([]string, error) {
+	switch shell {
+	case "sh", "bash":
+		return []string{"-c", command}, nil
+	case "pwsh", "powershell":
+		return []string{"-NoProfile", "-NonInteractive", "-Command", command}, nil
+	case "cmd":
+		return []string{"/C", command}, nil
+	default:
+		return nil, fmt.Errorf("unsupported shell %q (supported: sh, bash, pwsh, powershell, cmd)", shell)
+	}
+}
+
 // Execute runs a command and captures its output.
 func (e *Executor) Execute(ctx context.Context, checkID, command string) (*Result, error) {
+	return e.ExecuteWithOptions(ctx, checkID, command, Options{})
+}
+
+// ExecuteWithOptions runs a command with the given options and captures its output.
+func (e *Executor) ExecuteWithOptions(ctx context.Context, checkID, command string, opts Options) (*Result, error) {
 	// Create command with shell
-	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	shell := opts.Shell
+	if shell == "" {
+		shell = DefaultShell()
+	}
+	args, err := shellArgs(shell, command)
+	if err != nil {
+		return nil, fmt.Errorf("check %q: %w", checkID, err)
+	}
+	shellPath, err := exec.LookPath(shell)
+	if err != nil {
+		return nil, fmt.Errorf("check %q: shell %q not found on PATH", checkID, shell)
+	}
+	cmd := exec.CommandContext(ctx, shellPath, args...)
 	cmd.Dir = e.workDir
-	cmd.Env = e.env
+	cmd.Env = append(e.env[:len(e.env):len(e.env)], opts.Env...)
+	grace := opts.KillGrace
+	if grace <= 0 {
+		grace = DefaultKillGrace
+	}
+	cmd.WaitDelay = grace + waitDelay
+	setProcessGroup(cmd, grace, opts.KillSignal)
+
+	// Capture stdout and stderr separately, tee-ing to the stream if requested
+	maxOutput := opts.MaxOutputBytes
+	if maxOutput <= 0 {
+		maxOutput = DefaultMaxOutputBytes
+	}
+	stdout, stderr := newLimitedBuffer(maxOutput), newLimitedBuffer(maxOutput)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
 
-	// Capture stdout and stderr separately
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
+	var streamOut, streamErr *PrefixWriter
+	if opts.Stream != nil {
+		streamOut = opts.Stream.Writer(checkID)
+		streamErr = opts.Stream.Writer(checkID)
+		streamOut.redact, streamErr.redact = opts.Redact, opts.Redact
+		cmd.Stdout = io.MultiWriter(stdout, streamOut)
+		cmd.Stderr = io.MultiWriter(stderr, streamErr)
+	}
 
 	// Execute with timing
 	start := time.Now()
-	err := cmd.Run()
+	err = cmd.Run()
 	duration := time.Since(start)
+	if deadline, ok := ctx.Deadline(); ok && ctx.Err() == context.DeadlineExceeded {
+		// Don't count the time the command took to stop after its timeout
+		duration = min(duration, deadline.Sub(start))
+	}
+
+	if opts.Stream != nil {
+		streamOut.Flush()
+		streamErr.Flush()
+	}
 
 	// Determine exit code, timeout, and cancellation status
 	exitCode := 0
@@ -91,23 +219,29 @@ func (e *Executor) Execute(ctx context.Context, checkID, command string) (*Resul
 			// Context was cancelled (e.g., by fail-fast)
 			// Treat as a cancelled check, not a timeout
 			cancelled = true
-			exitCode = -1
+			exitCode = ExitCodeCancelled
 			err = nil // Cancellation is a recognized condition, not an error
 		} else if exitErr, ok := err.(*exec.ExitError); ok {
 			exitCode = exitErr.ExitCode()
 			err = nil // Non-zero exit is not an error for us
+		} else if errors.Is(err, exec.ErrWaitDelay) {
+			err = nil // The command exited; a background process kept its output open
 		}
 	}
 
-	// Build combined output (stdout + stderr)
-	combined := stdout.String() + stderr.String()
+	// Mask secrets before the output is stored anywhere, and build combined
+	// output (stdout + stderr)
+	stdoutText := Redact(stdout.String(), opts.Redact)
+	stderrText := Redact(stderr.String(), opts.Redact)
 
 	return &Result{
 		CheckID:   checkID,
 		ExitCode:  exitCode,
-		Stdout:    stdout.String(),
-		Stderr:    stderr.String(),
-		Combined:  combined,
+		Stdout:    stdoutText,
+		Stderr:    stderrText,
+		Combined:  stdoutText + stderrText,
+		StartTime: start,
+		EndTime:   start.Add(duration),
 		Duration:  duration,
 		Success:   exitCode == 0,
 		Timedout:  timedout,
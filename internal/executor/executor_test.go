@@ -1,8 +1,13 @@
+//go:build !windows
+
 // Package executor provides command execution capabilities for checks.
 package executor
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 )
@@ -116,6 +121,29 @@ func TestExecute_CapturesStderr(t *testing.T) {
 	}
 }
 
+func TestExecute_TruncatesOutput(t *testing.T) {
+	exec := New("")
+
+	// 100000 bytes on stdout, well past the limit, then a non-zero exit
+	cmd := `head -c 100000 /dev/zero | tr '\0' x; echo done >&2; exit 3`
+	result, err := exec.ExecuteWithOptions(context.Background(), "chatty", cmd, Options{MaxOutputBytes: 1000})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := strings.Repeat("x", 1000) + "\n...[truncated 99000 bytes]\n"
+	if result.Stdout != want {
+		t.Errorf("expected 1000 bytes and a truncation marker, got %d bytes ending in %q",
+			len(result.Stdout), result.Stdout[len(result.Stdout)-30:])
+	}
+	if result.Stderr != "done\n" {
+		t.Errorf("expected stderr under the limit to be kept, got %q", result.Stderr)
+	}
+	if result.ExitCode != 3 {
+		t.Errorf("expected exit code 3 after truncating output, got %d", result.ExitCode)
+	}
+}
+
 func TestExecute_CombinedOutput(t *testing.T) {
 	exec := New("")
 
@@ -146,6 +174,29 @@ func TestExecute_TracksDuration(t *testing.T) {
 	if result.Duration < 100*time.Millisecond {
 		t.Errorf("expected duration >= 100ms, got %v", result.Duration)
 	}
+	if result.StartTime.IsZero() || !result.EndTime.Equal(result.StartTime.Add(result.Duration)) {
+		t.Errorf("expected end time to be start time %v plus duration %v, got %v", result.StartTime, result.Duration, result.EndTime)
+	}
+}
+
+func TestExecute_Timeout_DurationIsTimeout(t *testing.T) {
+	exec := New("")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// The shell ignores SIGTERM, so stopping it takes the kill grace period
+	result, err := exec.ExecuteWithOptions(ctx, "test-timeout", "trap '' TERM; sleep 5", Options{KillGrace: 200 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !result.Timedout {
+		t.Fatal("expected Timedout to be true for timed-out command")
+	}
+	if result.Duration <= 0 || result.Duration > 50*time.Millisecond {
+		t.Errorf("expected duration up to the 50ms timeout, got %v", result.Duration)
+	}
 }
 
 func TestExecute_ContextCancellation(t *testing.T) {
@@ -391,9 +442,30 @@ func TestExecute_ContextCancelled_SetsCancelledFlag(t *testing.T) {
 		t.Error("expected Timedout to be false for cancellation (not timeout)")
 	}
 
-	// Exit code should be -1 for cancelled
-	if result.ExitCode != -1 {
-		t.Errorf("expected exit code -1 for cancellation, got %d", result.ExitCode)
+	// Exit code should be ExitCodeCancelled, distinct from skipped checks
+	if result.ExitCode != ExitCodeCancelled {
+		t.Errorf("expected exit code %d for cancellation, got %d", ExitCodeCancelled, result.ExitCode)
+	}
+}
+
+func TestExecute_ContextCancelled_KillsChildProcesses(t *testing.T) {
+	exec := New("")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// The shell waits on sleep, which holds the output pipe open; cancelling
+	// must kill the whole process group, not just the shell
+	start := time.Now()
+	result, err := exec.Execute(ctx, "test-children", "sleep 10; echo done")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected child processes to be killed, took %v", elapsed)
+	}
+	if strings.Contains(result.Stdout, "done") {
+		t.Error("expected command to be interrupted")
 	}
 }
 
@@ -425,3 +497,61 @@ func TestResult_String_Cancelled(t *testing.T) {
 		t.Errorf("expected %q, got %q", expected, got)
 	}
 }
+
+// installFakeShell puts an executable named name first on PATH. It prints its
+// own name followed by its arguments, one per line.
+func installFakeShell(t *testing.T, name string) {
+	t.Helper()
+	dir := t.TempDir()
+	script := "#!/bin/sh\necho " + name + "\nfor arg in \"$@\"; do echo \"$arg\"; done\n"
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(script), 0755); err != nil {
+		t.Fatalf("failed to write fake shell: %v", err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestExecute_Shell(t *testing.T) {
+	tests := []struct {
+		shell string
+		want  []string
+	}{
+		{"bash", []string{"bash", "-c", "echo hi"}},
+		{"pwsh", []string{"pwsh", "-NoProfile", "-NonInteractive", "-Command", "echo hi"}},
+		{"cmd", []string{"cmd", "/C", "echo hi"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.shell, func(t *testing.T) {
+			installFakeShell(t, tt.shell)
+
+			result, err := New("").ExecuteWithOptions(context.Background(), "shell", "echo hi", Options{Shell: tt.shell})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := strings.Split(strings.TrimSpace(result.Stdout), "\n")
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("expected shell invocation %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestExecute_ShellNotFound(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	_, err := New("").ExecuteWithOptions(context.Background(), "missing", "echo hi", Options{Shell: "pwsh"})
+	if err == nil {
+		t.Fatal("expected error for shell missing from PATH")
+	}
+	if !strings.Contains(err.Error(), `shell "pwsh" not found on PATH`) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestExecute_UnsupportedShell(t *testing.T) {
+	_, err := New("").ExecuteWithOptions(context.Background(), "fish", "echo hi", Options{Shell: "fish"})
+	if err == nil || !strings.Contains(err.Error(), `unsupported shell "fish"`) {
+		t.Errorf("expected unsupported shell error, got: %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+//go:build !windows
+
+package executor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// processGone reports whether the process with the given PID has exited.
+// A zombie counts as exited: it no longer runs, it just awaits reaping.
+func processGone(pid int) bool {
+	if err := syscall.Kill(pid, 0); err == syscall.ESRCH {
+		return true
+	}
+	stat, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "stat"))
+	if err != nil {
+		return false
+	}
+	// The state follows the parenthesized command name
+	_, after, ok := strings.Cut(string(stat), ") ")
+	return ok && strings.HasPrefix(after, "Z")
+}
+
+func TestExecute_Timeout_KillsProcessGroup(t *testing.T) {
+	exec := New("")
+	pidFile := filepath.Join(t.TempDir(), "child.pid")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	// The child runs in the background, so killing only the shell would orphan it
+	result, err := exec.Execute(ctx, "forks", "sleep 30 & echo $! > "+pidFile+"; wait")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Timedout {
+		t.Fatal("expected the command to time out")
+	}
+
+	data, err := os.ReadFile(pidFile)
+	if err != nil {
+		t.Fatalf("failed to read child PID: %v", err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		t.Fatalf("invalid child PID %q: %v", data, err)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !processGone(pid) {
+		if time.Now().After(deadline) {
+			_ = syscall.Kill(pid, syscall.SIGKILL)
+			t.Fatalf("child process %d outlived the timed out check", pid)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestExecute_Timeout_KillsAfterGrace(t *testing.T) {
+	exec := New("")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// SIGTERM is ignored, so only the SIGKILL after the grace period stops it
+	start := time.Now()
+	result, err := exec.ExecuteWithOptions(ctx, "ignores-term", "trap '' TERM; sleep 30", Options{KillGrace: 300 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	elapsed := time.Since(start)
+
+	if !result.Timedout {
+		t.Error("expected the command to time out")
+	}
+	if elapsed < 400*time.Millisecond {
+		t.Errorf("expected the command to get a grace period after SIGTERM, took %v", elapsed)
+	}
+	if elapsed > 2*time.Second {
+		t.Errorf("expected SIGKILL after the grace period, took %v", elapsed)
+	}
+}
+
+func TestExecute_Timeout_SendsKillSignal(t *testing.T) {
+	exec := New("")
+	marker := filepath.Join(t.TempDir(), "interrupted")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	// The trap runs once the shell gets SIGINT, like a server flushing
+	// coverage before it exits
+	command := "trap 'echo flushed > " + marker + "; exit 130' INT; sleep 30 & wait"
+	result, err := exec.ExecuteWithOptions(ctx, "server", command, Options{KillSignal: "SIGINT", KillGrace: 300 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Timedout {
+		t.Fatal("expected the command to time out")
+	}
+
+	data, err := os.ReadFile(marker)
+	if err != nil {
+		t.Fatalf("expected the SIGINT trap to write %s: %v", marker, err)
+	}
+	if strings.TrimSpace(string(data)) != "flushed" {
+		t.Errorf("unexpected marker content %q", data)
+	}
+}
+
+func TestExecute_Timeout_SIGKILLSkipsGrace(t *testing.T) {
+	exec := New("")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	result, err := exec.ExecuteWithOptions(ctx, "ignores-term", "trap '' TERM INT; sleep 30", Options{KillSignal: "SIGKILL", KillGrace: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Timedout {
+		t.Error("expected the command to time out")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected SIGKILL without waiting for the grace period, took %v", elapsed)
+	}
+}
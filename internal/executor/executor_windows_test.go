@@ -0,0 +1,61 @@
+//go:build windows
+
+package executor
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The other tests in this package run POSIX shell commands, so they are
+// built everywhere but Windows. These cover the same basics through cmd.
+
+func TestExecute_WindowsDefaultShell(t *testing.T) {
+	if DefaultShell() != "cmd" {
+		t.Fatalf("expected cmd as the default shell, got %q", DefaultShell())
+	}
+
+	result, err := New("").Execute(context.Background(), "echo", "echo hello& echo oops 1>&2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Success || result.ExitCode != 0 {
+		t.Errorf("expected success, got exit code %d", result.ExitCode)
+	}
+	if !strings.Contains(result.Stdout, "hello") {
+		t.Errorf("expected stdout to contain hello, got %q", result.Stdout)
+	}
+	if !strings.Contains(result.Stderr, "oops") {
+		t.Errorf("expected stderr to contain oops, got %q", result.Stderr)
+	}
+}
+
+func TestExecute_WindowsExitCode(t *testing.T) {
+	result, err := New("").Execute(context.Background(), "fail", "exit /b 3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Success || result.ExitCode != 3 {
+		t.Errorf("expected exit code 3, got %d (success: %v)", result.ExitCode, result.Success)
+	}
+}
+
+func TestExecute_WindowsTimeoutKillsProcessTree(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	// ping runs as a child of cmd, so the timeout has to end the whole tree
+	result, err := New("").ExecuteWithOptions(ctx, "slow", "ping -n 30 127.0.0.1 >NUL", Options{KillGrace: 200 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Timedout {
+		t.Errorf("expected the command to time out, got exit code %d", result.ExitCode)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected the process tree to be killed promptly, took %v", elapsed)
+	}
+}
@@ -0,0 +1,45 @@
+package executor
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// DefaultMaxOutputBytes is the default limit on the captured size of each of
+// a command's output streams.
+const DefaultMaxOutputBytes = 10 * 1024 * 1024
+
+// limitedBuffer captures up to limit bytes and discards the rest, counting
+// the discarded bytes. Writes never fail, so the command runs to completion
+// however much it prints.
+type limitedBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated int64
+}
+
+func newLimitedBuffer(limit int) *limitedBuffer {
+	return &limitedBuffer{limit: limit}
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if room := b.limit - b.buf.Len(); room < len(p) {
+		if room < 0 {
+			room = 0
+		}
+		b.truncated += int64(len(p) - room)
+		p = p[:room]
+	}
+	b.buf.Write(p)
+	return n, nil
+}
+
+// String returns the captured output, followed by a marker if any output was
+// discarded.
+func (b *limitedBuffer) String() string {
+	if b.truncated == 0 {
+		return b.buf.String()
+	}
+	return fmt.Sprintf("%s\n...[truncated %d bytes]\n", b.buf.String(), b.truncated)
+}
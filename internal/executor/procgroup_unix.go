@@ -0,0 +1,41 @@
+//go:build !windows
+
+package executor
+
+import (
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+// killSignals maps the accepted kill signal names to signals.
+var killSignals = map[string]syscall.Signal{
+	"SIGINT":  syscall.SIGINT,
+	"SIGTERM": syscall.SIGTERM,
+	"SIGKILL": syscall.SIGKILL,
+}
+
+// setProcessGroup runs cmd in its own process group so processes started by
+// the shell do not outlive a timed out or cancelled check. Cancelling the
+// context sends signal (SIGTERM if empty) to the whole group, then SIGKILL
+// after grace.
+func setProcessGroup(cmd *exec.Cmd, grace time.Duration, signal string) {
+	sig, ok := killSignals[signal]
+	if !ok {
+		sig = syscall.SIGTERM
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		pgid := -cmd.Process.Pid
+		if err := syscall.Kill(pgid, sig); err != nil {
+			return err
+		}
+		if sig == syscall.SIGKILL {
+			return nil
+		}
+		// Processes that ignore SIGTERM may outlive the shell, so the group
+		// is killed even after Wait returns
+		time.AfterFunc(grace, func() { _ = syscall.Kill(pgid, syscall.SIGKILL) })
+		return nil
+	}
+}
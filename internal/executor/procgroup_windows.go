@@ -0,0 +1,54 @@
+//go:build windows
+
+package executor
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// generateConsoleCtrlEvent sends a console control event to a process group.
+var generateConsoleCtrlEvent = syscall.NewLazyDLL("kernel32.dll").NewProc("GenerateConsoleCtrlEvent")
+
+// setProcessGroup runs cmd in its own console process group so processes
+// started by the shell do not outlive a timed out or cancelled check.
+// Cancelling the context sends CTRL_BREAK to the group, the closest Windows
+// has to SIGTERM, then kills the shell's process tree with taskkill after
+// grace. A signal of SIGKILL, or failing to send the event, kills the tree at
+// once. SIGINT and SIGTERM both send CTRL_BREAK: a process group of its own
+// cannot receive CTRL_C.
+func setProcessGroup(cmd *exec.Cmd, grace time.Duration, signal string) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+	cmd.Cancel = func() error {
+		if signal == "SIGKILL" {
+			return killProcessTree(cmd)
+		}
+		pid := cmd.Process.Pid
+		if r, _, _ := generateConsoleCtrlEvent.Call(syscall.CTRL_BREAK_EVENT, uintptr(pid)); r == 0 {
+			return killProcessTree(cmd)
+		}
+		time.AfterFunc(grace, func() {
+			// Once the shell has been waited for its PID may be reused
+			if err := cmd.Process.Signal(syscall.Signal(0)); errors.Is(err, os.ErrProcessDone) {
+				return
+			}
+			_ = killProcessTree(cmd)
+		})
+		return nil
+	}
+}
+
+// killProcessTree forcibly ends cmd's process and every process it started.
+// taskkill can only find the children while the shell still runs, so if it
+// fails the shell alone is killed.
+func killProcessTree(cmd *exec.Cmd) error {
+	taskkill := exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid)) // #nosec G204 - PID of a process we started
+	if err := taskkill.Run(); err != nil {
+		return cmd.Process.Kill()
+	}
+	return nil
+}
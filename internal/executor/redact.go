@@ -0,0 +1,15 @@
+package executor
+
+import "regexp"
+
+// RedactMask replaces each match of a redaction pattern.
+const RedactMask = "***"
+
+// Redact replaces every match of patterns in s with RedactMask, applying the
+// patterns in order.
+func Redact(s string, patterns []*regexp.Regexp) string {
+	for _, re := range patterns {
+		s = re.ReplaceAllLiteralString(s, RedactMask)
+	}
+	return s
+}
@@ -0,0 +1,78 @@
+package executor
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"sync"
+)
+
+// LineStreamer forwards command output from concurrently running checks to a
+// shared writer in real time. Output is written one complete line at a time,
+// each prefixed with the check ID, so lines from parallel checks never
+// interleave mid-line.
+type LineStreamer struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// NewLineStreamer creates a LineStreamer writing to out.
+func NewLineStreamer(out io.Writer) *LineStreamer {
+	return &LineStreamer{out: out}
+}
+
+// Writer returns a writer that streams lines prefixed with "[checkID] ".
+// Call Flush on the returned writer once the command has finished to emit
+// any trailing partial line.
+func (s *LineStreamer) Writer(checkID string) *PrefixWriter {
+	return &PrefixWriter{streamer: s, prefix: "[" + checkID + "] "}
+}
+
+// writeLine writes a single prefixed line while holding the shared lock.
+func (s *LineStreamer) writeLine(prefix string, line []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, _ = io.WriteString(s.out, prefix)
+	_, _ = s.out.Write(line)
+	_, _ = io.WriteString(s.out, "\n")
+}
+
+// PrefixWriter buffers partial lines and forwards complete lines to its
+// LineStreamer. It never returns an error, so a failing terminal cannot
+// interrupt output capture.
+type PrefixWriter struct {
+	streamer *LineStreamer
+	prefix   string
+	buf      []byte
+	redact   []*regexp.Regexp // Patterns masked in each line before it is written
+}
+
+// Write implements io.Writer.
+func (w *PrefixWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		w.streamer.writeLine(w.prefix, w.redactLine(bytes.TrimSuffix(w.buf[:i], []byte("\r"))))
+		w.buf = w.buf[i+1:]
+	}
+	return len(p), nil
+}
+
+// Flush emits any buffered partial line.
+func (w *PrefixWriter) Flush() {
+	if len(w.buf) > 0 {
+		w.streamer.writeLine(w.prefix, w.redactLine(w.buf))
+		w.buf = nil
+	}
+}
+
+// redactLine masks the writer's redaction patterns in line.
+func (w *PrefixWriter) redactLine(line []byte) []byte {
+	if len(w.redact) == 0 {
+		return line
+	}
+	return []byte(Redact(string(line), w.redact))
+}
@@ -0,0 +1,132 @@
+//go:build !windows
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestPrefixWriter_BuffersPartialLines(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewLineStreamer(&buf).Writer("fmt")
+
+	_, _ = w.Write([]byte("hel"))
+	if buf.Len() != 0 {
+		t.Errorf("expected partial line to be buffered, got %q", buf.String())
+	}
+	_, _ = w.Write([]byte("lo\nwor"))
+	_, _ = w.Write([]byte("ld\r\ntrailing"))
+	w.Flush()
+
+	want := "[fmt] hello\n[fmt] world\n[fmt] trailing\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+
+	// Flushing again is a no-op
+	w.Flush()
+	if buf.String() != want {
+		t.Errorf("expected second flush to be a no-op, got %q", buf.String())
+	}
+}
+
+func TestExecuteWithOptions_StreamsAndCaptures(t *testing.T) {
+	var buf bytes.Buffer
+	exec := New("")
+
+	result, err := exec.ExecuteWithOptions(context.Background(), "test", "echo one; echo two >&2; printf three", Options{
+		Stream: NewLineStreamer(&buf),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Captured output is unchanged by streaming
+	if result.Stdout != "one\nthree" {
+		t.Errorf("unexpected captured stdout %q", result.Stdout)
+	}
+	if result.Stderr != "two\n" {
+		t.Errorf("unexpected captured stderr %q", result.Stderr)
+	}
+
+	streamed := buf.String()
+	for _, line := range []string{"[test] one\n", "[test] two\n", "[test] three\n"} {
+		if !strings.Contains(streamed, line) {
+			t.Errorf("expected streamed output to contain %q, got %q", line, streamed)
+		}
+	}
+}
+
+func TestExecuteWithOptions_ParallelStreamsDoNotInterleaveLines(t *testing.T) {
+	var buf bytes.Buffer
+	streamer := NewLineStreamer(&buf)
+	exec := New("")
+
+	const lines = 200
+	ids := []string{"alpha", "beta", "gamma"}
+
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			cmd := fmt.Sprintf("i=0; while [ $i -lt %d ]; do echo \"line $i of %s\"; i=$((i+1)); done", lines, id)
+			if _, err := exec.ExecuteWithOptions(context.Background(), id, cmd, Options{Stream: streamer}); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	pattern := regexp.MustCompile(`^\[(alpha|beta|gamma)\] line \d+ of (alpha|beta|gamma)$`)
+	counts := make(map[string]int)
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		m := pattern.FindStringSubmatch(line)
+		if m == nil {
+			t.Fatalf("malformed streamed line %q", line)
+		}
+		if m[1] != m[2] {
+			t.Fatalf("line attributed to wrong check: %q", line)
+		}
+		counts[m[1]]++
+	}
+	for _, id := range ids {
+		if counts[id] != lines {
+			t.Errorf("expected %d lines for %s, got %d", lines, id, counts[id])
+		}
+	}
+}
+
+func TestExecuteWithOptions_Redact(t *testing.T) {
+	var buf bytes.Buffer
+	exec := New("")
+
+	result, err := exec.ExecuteWithOptions(context.Background(), "test", "echo token=abc123; echo token=def456 >&2; printf tail=abc123", Options{
+		Stream: NewLineStreamer(&buf),
+		Redact: []*regexp.Regexp{regexp.MustCompile(`(abc|def)[0-9]+`)},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Stdout != "token=***\ntail=***" {
+		t.Errorf("unexpected captured stdout %q", result.Stdout)
+	}
+	if result.Stderr != "token=***\n" {
+		t.Errorf("unexpected captured stderr %q", result.Stderr)
+	}
+	if result.Combined != result.Stdout+result.Stderr {
+		t.Errorf("unexpected combined output %q", result.Combined)
+	}
+	for _, secret := range []string{"abc123", "def456"} {
+		if strings.Contains(buf.String(), secret) {
+			t.Errorf("expected %q to be redacted from streamed output, got %q", secret, buf.String())
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Package git runs the small set of git queries vibeguard needs, such as
+// listing the files changed since a base revision.
+package git
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ChangedFiles returns the files in dir that changed since ref, as sorted,
+// slash-separated paths relative to dir.
+//
+// Changes are measured from the merge base of ref and HEAD, so on a feature
+// branch only the branch's own changes are reported, not commits that landed
+// on ref afterwards. Committed, staged and unstaged changes are included, as
+// are untracked files that are not ignored. Deleted files are included too,
+// since removing a file can break the checks that cover it.
+//
+// An empty dir uses the current working directory.
+func ChangedFiles(ctx context.Context, dir, ref string) ([]string, error) {
+	if ref == "" || strings.HasPrefix(ref, "-") {
+		return nil, fmt.Errorf("invalid git ref %q", ref)
+	}
+
+	base, err := run(ctx, dir, "merge-base", ref, "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find merge base with %q: %w", ref, err)
+	}
+	base = strings.TrimSpace(base)
+
+	diff, err := run(ctx, dir, "diff", "--name-only", "--relative", "-z", base, "--")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files changed since %q: %w", ref, err)
+	}
+	untracked, err := run(ctx, dir, "ls-files", "--others", "--exclude-standard", "-z")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list untracked files: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var files []string
+	for _, name := range append(strings.Split(diff, "\x00"), strings.Split(untracked, "\x00")...) {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		files = append(files, filepath.ToSlash(name))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// ListFiles returns the tracked files in dir and the untracked files that are
+// not ignored, as sorted, slash-separated paths relative to dir. Tracked files
+// deleted from the working tree are included.
+//
+// An empty dir uses the current working directory.
+func ListFiles(ctx context.Context, dir string) ([]string, error) {
+	out, err := run(ctx, dir, "ls-files", "--cached", "--others", "--exclude-standard", "-z")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var files []string
+	for _, name := range strings.Split(out, "\x00") {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		files = append(files, filepath.ToSlash(name))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// Head returns the commit checked out in dir and the name of its branch.
+// The branch is empty when HEAD is detached.
+//
+// An empty dir uses the current working directory.
+func Head(ctx context.Context, dir string) (commit, branch string, err error) {
+	out, err := run(ctx, dir, "rev-parse", "HEAD")
+	if err != nil {
+		return "", "", fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+	commit = strings.TrimSpace(out)
+
+	// Exits with status 1 and no output when HEAD is detached
+	if out, err := run(ctx, dir, "symbolic-ref", "--quiet", "--short", "HEAD"); err == nil {
+		branch = strings.TrimSpace(out)
+	}
+	return commit, branch, nil
+}
+
+// run executes git with args in dir and returns its stdout. On failure the
+// error includes git's stderr.
+func run(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%s", msg)
+		}
+		return "", err
+	}
+	return stdout.String(), nil
+}
@@ -0,0 +1,171 @@
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// initRepo creates a git repository with an initial commit on main.
+func initRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	gitCmd(t, dir, "init", "-q", "-b", "main")
+	writeFile(t, dir, "go.mod", "module example\n")
+	writeFile(t, dir, "docs/guide.md", "# Guide\n")
+	writeFile(t, dir, ".gitignore", "*.log\n")
+	gitCmd(t, dir, "add", ".")
+	gitCmd(t, dir, "commit", "-q", "-m", "initial")
+	return dir
+}
+
+func gitCmd(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	args = append([]string{"-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %s failed: %v\n%s", strings.Join(args, " "), err, out)
+	}
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChangedFiles(t *testing.T) {
+	dir := initRepo(t)
+
+	// Branch off, commit one change, then leave others uncommitted
+	gitCmd(t, dir, "checkout", "-q", "-b", "feature")
+	writeFile(t, dir, "internal/app/app.go", "package app\n")
+	gitCmd(t, dir, "add", ".")
+	gitCmd(t, dir, "commit", "-q", "-m", "add app")
+
+	writeFile(t, dir, "docs/guide.md", "# Guide\n\nUpdated.\n") // unstaged
+	writeFile(t, dir, "main.go", "package main\n")              // untracked
+	writeFile(t, dir, "debug.log", "ignored\n")                 // ignored
+
+	// A commit landing on main after the branch point must not be reported
+	gitCmd(t, dir, "checkout", "-q", "main")
+	writeFile(t, dir, "CHANGELOG.md", "changes\n")
+	gitCmd(t, dir, "add", "CHANGELOG.md")
+	gitCmd(t, dir, "commit", "-q", "-m", "changelog")
+	gitCmd(t, dir, "checkout", "-q", "feature")
+
+	got, err := ChangedFiles(context.Background(), dir, "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"docs/guide.md", "internal/app/app.go", "main.go"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangedFiles() = %v, want %v", got, want)
+	}
+}
+
+func TestChangedFiles_RelativeToSubdirectory(t *testing.T) {
+	dir := initRepo(t)
+	writeFile(t, dir, "docs/guide.md", "# Guide\n\nUpdated.\n")
+	writeFile(t, dir, "go.mod", "module example\n\ngo 1.24\n")
+
+	got, err := ChangedFiles(context.Background(), filepath.Join(dir, "docs"), "HEAD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"guide.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangedFiles() = %v, want %v", got, want)
+	}
+}
+
+func TestChangedFiles_NoChanges(t *testing.T) {
+	dir := initRepo(t)
+
+	got, err := ChangedFiles(context.Background(), dir, "HEAD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("expected no changed files, got %v", got)
+	}
+}
+
+func TestChangedFiles_InvalidRef(t *testing.T) {
+	dir := initRepo(t)
+
+	for _, ref := range []string{"", "--output=x", "no-such-branch"} {
+		_, err := ChangedFiles(context.Background(), dir, ref)
+		if err == nil {
+			t.Errorf("expected error for ref %q", ref)
+		}
+	}
+}
+
+func TestListFiles(t *testing.T) {
+	dir := initRepo(t)
+
+	writeFile(t, dir, "internal/app/app.go", "package app\n") // untracked
+	writeFile(t, dir, "debug.log", "ignored\n")               // ignored
+	if err := os.Remove(filepath.Join(dir, "docs/guide.md")); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := ListFiles(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("ListFiles failed: %v", err)
+	}
+
+	want := []string{".gitignore", "docs/guide.md", "go.mod", "internal/app/app.go"}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("ListFiles() = %v, want %v", files, want)
+	}
+}
+
+func TestHead(t *testing.T) {
+	dir := initRepo(t)
+
+	commit, branch, err := Head(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("Head failed: %v", err)
+	}
+	if len(commit) != 40 {
+		t.Errorf("expected a full commit hash, got %q", commit)
+	}
+	if branch != "main" {
+		t.Errorf("expected branch main, got %q", branch)
+	}
+
+	// A detached HEAD has no branch
+	gitCmd(t, dir, "checkout", "-q", "--detach")
+	detached, branch, err := Head(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("Head failed: %v", err)
+	}
+	if detached != commit || branch != "" {
+		t.Errorf("expected commit %s and no branch, got %q and %q", commit, detached, branch)
+	}
+}
+
+func TestHead_NotARepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	if _, _, err := Head(context.Background(), t.TempDir()); err == nil {
+		t.Error("expected an error outside a repository")
+	}
+}
@@ -0,0 +1,131 @@
+// Package glob provides slash-separated path glob matching with support for
+// recursive "**" segments, used by the `paths` field of checks.
+package glob
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Validate reports an error if the pattern is not a well-formed glob.
+// Each segment must be a valid path.Match pattern or the literal "**".
+func Validate(pattern string) error {
+	if strings.TrimSpace(pattern) == "" {
+		return fmt.Errorf("empty glob pattern")
+	}
+	for _, seg := range split(pattern) {
+		if seg == "**" {
+			continue
+		}
+		if _, err := path.Match(seg, ""); err != nil {
+			return fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// Match reports whether name matches the pattern.
+//
+// Matching Behavior:
+//   - Patterns and names are compared segment by segment (split on "/")
+//   - "*", "?" and "[...]" behave as in path.Match and never cross a "/"
+//   - A "**" segment matches zero or more whole segments
+//   - Invalid patterns never match (use Validate to surface the error)
+//
+// Examples:
+//   - "**/*.go" matches "main.go" and "internal/config/config.go"
+//   - "internal/**" matches every file below internal/
+//   - "*.go" matches only Go files in the root directory
+func Match(pattern, name string) bool {
+	return matchSegments(split(pattern), split(Normalize(name)))
+}
+
+// MatchAny reports whether name matches at least one of the patterns.
+func MatchAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if Match(p, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// IntersectsPrefix reports whether the pattern could match any path located at
+// or below the given path prefix. Unlike Match, the prefix may name a directory.
+//
+// Examples:
+//   - "internal/**/*.go" intersects "internal/config"
+//   - "**/*.go" intersects any prefix
+//   - "cmd/**" does not intersect "internal/config"
+func IntersectsPrefix(pattern, prefix string) bool {
+	pat := split(pattern)
+	pre := split(Normalize(prefix))
+
+	for i := 0; i < len(pre); i++ {
+		if i >= len(pat) {
+			// Pattern is shallower than the prefix; it can only name the
+			// prefix's ancestors, never files beneath the prefix.
+			return false
+		}
+		if pat[i] == "**" {
+			return true
+		}
+		if ok, err := path.Match(pat[i], pre[i]); err != nil || !ok {
+			return false
+		}
+	}
+	// Prefix exhausted: the remaining pattern segments may match below it.
+	return true
+}
+
+// Normalize converts a path to the slash-separated, cleaned form used for
+// matching. The current directory normalizes to the empty string.
+func Normalize(p string) string {
+	p = filepath.ToSlash(strings.TrimSpace(p))
+	if p == "" {
+		return ""
+	}
+	p = path.Clean(p)
+	p = strings.TrimPrefix(p, "./")
+	if p == "." || p == "/" {
+		return ""
+	}
+	return p
+}
+
+// split splits a slash-separated path into non-empty segments.
+func split(p string) []string {
+	var segs []string
+	for _, s := range strings.Split(p, "/") {
+		if s != "" && s != "." {
+			segs = append(segs, s)
+		}
+	}
+	return segs
+}
+
+// matchSegments matches pattern segments against name segments.
+func matchSegments(pat, segs []string) bool {
+	for len(pat) > 0 {
+		if pat[0] == "**" {
+			rest := pat[1:]
+			for i := 0; i <= len(segs); i++ {
+				if matchSegments(rest, segs[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segs) == 0 {
+			return false
+		}
+		ok, err := path.Match(pat[0], segs[0])
+		if err != nil || !ok {
+			return false
+		}
+		pat, segs = pat[1:], segs[1:]
+	}
+	return len(segs) == 0
+}
@@ -0,0 +1,106 @@
+package glob
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"**/*.go", "main.go", true},
+		{"**/*.go", "internal/config/config.go", true},
+		{"**/*.go", "README.md", false},
+		{"*.go", "main.go", true},
+		{"*.go", "internal/main.go", false},
+		{"internal/**", "internal/config/config.go", true},
+		{"internal/**", "cmd/vibeguard/main.go", false},
+		{"internal/**/*_test.go", "internal/config/config_test.go", true},
+		{"internal/**/*_test.go", "internal/config_test.go", true},
+		{"docs/*.md", "docs/adr/ADR-001.md", false},
+		{"go.mod", "go.mod", true},
+		{"go.mod", "./go.mod", true},
+		{"[invalid", "x", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+"|"+tt.name, func(t *testing.T) {
+			if got := Match(tt.pattern, tt.name); got != tt.want {
+				t.Errorf("Match(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchAny(t *testing.T) {
+	patterns := []string{"**/*.go", "go.mod"}
+	if !MatchAny(patterns, "go.mod") {
+		t.Error("expected go.mod to match")
+	}
+	if MatchAny(patterns, "package.json") {
+		t.Error("expected package.json not to match")
+	}
+	if MatchAny(nil, "go.mod") {
+		t.Error("expected no match for empty pattern list")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := []string{"**/*.go", "internal/**", "docs/[a-z]*.md", "go.mod"}
+	for _, p := range valid {
+		if err := Validate(p); err != nil {
+			t.Errorf("Validate(%q) returned unexpected error: %v", p, err)
+		}
+	}
+
+	invalid := []string{"", "  ", "src/[abc", "a/\\"}
+	for _, p := range invalid {
+		if err := Validate(p); err == nil {
+			t.Errorf("Validate(%q) expected error, got nil", p)
+		}
+	}
+}
+
+func TestIntersectsPrefix(t *testing.T) {
+	tests := []struct {
+		pattern string
+		prefix  string
+		want    bool
+	}{
+		{"internal/config/**", "internal/config", true},
+		{"internal/**/*.go", "internal/config", true},
+		{"internal/**", "internal/config/config.go", true},
+		{"**/*.go", "cmd", true},
+		{"cmd/**", "internal/config", false},
+		{"*.go", "internal/config", false},
+		{"*.go", "main.go", true},
+		{"internal/*/testdata/*.yaml", "internal/config", true},
+		{"internal", "internal/config", false},
+		{"docs/**", ".", true},
+		{"docs/**", "./docs/", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+"|"+tt.prefix, func(t *testing.T) {
+			if got := IntersectsPrefix(tt.pattern, tt.prefix); got != tt.want {
+				t.Errorf("IntersectsPrefix(%q, %q) = %v, want %v", tt.pattern, tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]string{
+		"":                 "",
+		".":                "",
+		"./":               "",
+		"./internal/":      "internal",
+		"internal//config": "internal/config",
+		"a/b/../c":         "a/c",
+	}
+	for in, want := range tests {
+		if got := Normalize(in); got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -5,14 +5,38 @@ package grok
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/elastic/go-grok"
+	"github.com/elastic/go-grok/patterns"
 )
 
+// validPatternName matches names usable for custom pattern definitions.
+var validPatternName = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// referencePattern matches a pattern reference such as %{NUMBER} or
+// %{NUMBER:name}, capturing the referenced pattern name and the capture name.
+var referencePattern = regexp.MustCompile(`%{(\w+)(?::([\w+.]+)(?::\w+)?)?}`)
+
+// CountSuffix is appended to a capture name to form the key holding the
+// number of times the capture matched, e.g. "issue" -> "issue_count".
+const CountSuffix = "_count"
+
+// maxExpansionDepth bounds nested pattern references during expansion.
+const maxExpansionDepth = 100
+
+// dotSeparator replaces dots in capture names, which regexp group names do
+// not allow. It matches the encoding used by go-grok.
+const dotSeparator = "___"
+
 // Matcher extracts values from text using grok patterns.
 type Matcher struct {
 	patterns []string
 	compiled []*grok.Grok
+	counters []*regexp.Regexp // Expanded patterns used to count every match
 }
 
 // New creates a new Matcher with the given patterns.
@@ -38,6 +62,14 @@ type Matcher struct {
 //   - Valid: "(?P<status>\w+) test"
 //   - Invalid: "%{NONEXISTENT_PATTERN:val}" (unknown pattern name)
 func New(patterns []string) (*Matcher, error) {
+	return NewWithDefinitions(patterns, nil)
+}
+
+// NewWithDefinitions creates a new Matcher whose patterns may also reference
+// the given custom pattern definitions (name -> pattern) in addition to the
+// built-in library. Definitions may reference built-ins and each other; call
+// ValidateDefinitions first to get descriptive errors for bad definitions.
+func NewWithDefinitions(patterns []string, definitions map[string]string) (*Matcher, error) {
 	if len(patterns) == 0 {
 		return &Matcher{
 			patterns: patterns,
@@ -46,17 +78,32 @@ func New(patterns []string) (*Matcher, error) {
 	}
 
 	compiled := make([]*grok.Grok, 0, len(patterns))
+	counters := make([]*regexp.Regexp, 0, len(patterns))
 	for _, pattern := range patterns {
-		g := grok.New()
+		g, err := grok.NewWithPatterns(definitions)
+		if err != nil {
+			return nil, fmt.Errorf("invalid grok pattern definitions: %w", err)
+		}
 		if err := g.Compile(pattern, true); err != nil {
 			return nil, fmt.Errorf("failed to compile grok pattern %q: %w", pattern, err)
 		}
 		compiled = append(compiled, g)
+
+		expanded, err := expand(pattern, definitions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile grok pattern %q: %w", pattern, err)
+		}
+		counter, err := regexp.Compile(expanded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile grok pattern %q: %w", pattern, err)
+		}
+		counters = append(counters, counter)
 	}
 
 	return &Matcher{
 		patterns: patterns,
 		compiled: compiled,
+		counters: counters,
 	}, nil
 }
 
@@ -64,7 +111,14 @@ func New(patterns []string) (*Matcher, error) {
 //
 // Pattern Matching Behavior:
 //   - All patterns are applied sequentially and independently to the input.
+//   - A capture's value is taken from the pattern's first match in the input;
+//     later matches (e.g. on subsequent lines) do not replace it.
+//   - Every capture also yields "<name>_count": the number of non-overlapping
+//     matches of the pattern in which the capture was non-empty. It is "0"
+//     when the pattern never matches, so assertions like "issue_count < 5"
+//     work on clean output.
 //   - If multiple patterns capture the same field name, the later pattern's value overrides earlier ones.
+//   - An explicit capture named "<name>_count" takes precedence over the generated count.
 //   - If a pattern doesn't match, its fields are simply not included in the result (no error).
 //   - Unmatched patterns do not generate errors; only pattern compilation errors do.
 //
@@ -86,7 +140,12 @@ func (m *Matcher) Match(input string) (map[string]string, error) {
 		return result, nil
 	}
 
-	for _, g := range m.compiled {
+	for i, g := range m.compiled {
+		// Counts first, so explicit captures with the same key win
+		for name, count := range countMatches(m.counters[i], input) {
+			result[name+CountSuffix] = strconv.Itoa(count)
+		}
+
 		values, _ := g.ParseString(input)
 		// Merge extracted values into result
 		// Later patterns can override values from earlier patterns
@@ -98,6 +157,160 @@ func (m *Matcher) Match(input string) (map[string]string, error) {
 	return result, nil
 }
 
+// countMatches returns, for each named capture in re, the number of
+// non-overlapping matches in input where the capture is non-empty.
+func countMatches(re *regexp.Regexp, input string) map[string]int {
+	names := re.SubexpNames()
+	counts := make(map[string]int)
+	for _, name := range names {
+		if name != "" {
+			counts[strings.ReplaceAll(name, dotSeparator, ".")] = 0
+		}
+	}
+
+	for _, match := range re.FindAllStringSubmatchIndex(input, -1) {
+		for i, name := range names {
+			if name == "" {
+				continue
+			}
+			start, end := match[2*i], match[2*i+1]
+			if start >= 0 && end > start {
+				counts[strings.ReplaceAll(name, dotSeparator, ".")]++
+			}
+		}
+	}
+	return counts
+}
+
+// expand replaces pattern references with their definitions, producing a
+// plain regular expression. References with a capture name become named
+// groups; bare references become unnamed groups. Custom definitions take
+// precedence over built-in patterns.
+func expand(pattern string, definitions map[string]string) (string, error) {
+	expanded := pattern
+	for depth := 0; depth < maxExpansionDepth; depth++ {
+		refs := referencePattern.FindAllStringSubmatch(expanded, -1)
+		if len(refs) == 0 {
+			return expanded, nil
+		}
+		for _, ref := range refs {
+			definition, ok := definitions[ref[1]]
+			if !ok {
+				definition, ok = patterns.Default[ref[1]]
+			}
+			if !ok {
+				return "", fmt.Errorf("pattern definition %q unknown", ref[1])
+			}
+
+			replacement := "(" + definition + ")"
+			if ref[2] != "" {
+				replacement = "(?P<" + strings.ReplaceAll(ref[2], ".", dotSeparator) + ">" + definition + ")"
+			}
+			expanded = strings.ReplaceAll(expanded, ref[0], replacement)
+		}
+	}
+	return "", fmt.Errorf("pattern references nest more than %d levels deep", maxExpansionDepth)
+}
+
+// ValidateDefinitions checks custom pattern definitions, returning an error
+// that names the offending pattern when:
+//   - a name contains characters other than letters, digits and underscores
+//   - a definition references a pattern that is neither built in nor defined
+//   - definitions reference each other in a cycle
+//   - the expanded definition is not a valid regular expression
+//
+// Definitions are checked in name order so errors are deterministic.
+func ValidateDefinitions(definitions map[string]string) error {
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !validPatternName.MatchString(name) {
+			return fmt.Errorf("grok pattern name %q is invalid: use letters, digits and underscores", name)
+		}
+	}
+
+	// Resolve references depth-first to catch undefined names and cycles
+	// before compiling, since an unresolved cycle would otherwise be left in
+	// the expanded regex as literal text
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := make(map[string]int, len(definitions))
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("grok pattern %q references itself (%s)", path[0], strings.Join(append(path, name), " -> "))
+		case done:
+			return nil
+		}
+		state[name] = visiting
+		for _, match := range referencePattern.FindAllStringSubmatch(definitions[name], -1) {
+			ref := match[1]
+			if _, custom := definitions[ref]; custom {
+				if err := visit(ref, append(path, name)); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, builtin := patterns.Default[ref]; !builtin {
+				return fmt.Errorf("grok pattern %q references undefined pattern %q", name, ref)
+			}
+		}
+		state[name] = done
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return err
+		}
+		g, err := grok.NewWithPatterns(definitions)
+		if err != nil {
+			return fmt.Errorf("grok pattern %q is invalid: %w", name, err)
+		}
+		if err := g.Compile("%{"+name+"}", true); err != nil {
+			return fmt.Errorf("grok pattern %q does not compile: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Unmatched returns the patterns that match nothing in input, in the order
+// they were configured.
+func (m *Matcher) Unmatched(input string) []string {
+	var unmatched []string
+	for i, counter := range m.counters {
+		if !counter.MatchString(input) {
+			unmatched = append(unmatched, m.patterns[i])
+		}
+	}
+	return unmatched
+}
+
+// Captures returns the names of the values the matcher's patterns capture,
+// sorted, without the generated "<name>_count" keys.
+func (m *Matcher) Captures() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, counter := range m.counters {
+		for _, name := range counter.SubexpNames() {
+			name = strings.ReplaceAll(name, dotSeparator, ".")
+			if name != "" && !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 // Patterns returns the patterns configured for this matcher.
 func (m *Matcher) Patterns() []string {
 	return m.patterns
@@ -1,6 +1,8 @@
 package grok
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -80,9 +82,9 @@ func TestMatch_EmptyInput(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Match() returned error: %v", err)
 	}
-	// Empty input won't match the IP pattern, so result should be empty
-	if len(result) != 0 {
-		t.Errorf("expected empty result for empty input, got %v", result)
+	// Empty input won't match the IP pattern, so only the zero count is present
+	if want := map[string]string{"ip_addr_count": "0"}; !reflect.DeepEqual(result, want) {
+		t.Errorf("expected %v for empty input, got %v", want, result)
 	}
 }
 
@@ -247,6 +249,21 @@ func TestPatterns_ReturnsConfiguredPatterns(t *testing.T) {
 	}
 }
 
+func TestUnmatched(t *testing.T) {
+	m, err := New([]string{"coverage: %{NUMBER:coverage}%", "%{INT:passed} passed", "%{INT:failed} failed"})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	got := m.Unmatched("coverage: 81.5%\n12 passed\n")
+	if want := []string{"%{INT:failed} failed"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unmatched() = %v, want %v", got, want)
+	}
+	if got := m.Unmatched("coverage: 81.5%\n12 passed, 0 failed\n"); got != nil {
+		t.Errorf("expected every pattern to match, got %v unmatched", got)
+	}
+}
+
 func TestMatch_CommonLogPatterns(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -323,8 +340,8 @@ func TestMatch_LongOutputTruncation(t *testing.T) {
 		}
 	} else {
 		// No error is expected for non-matching patterns
-		if len(result) != 0 {
-			t.Errorf("expected empty result for non-matching pattern, got %v", result)
+		if _, ok := result["ip_addr"]; ok || result["ip_addr_count"] != "0" {
+			t.Errorf("expected no value and a zero count for non-matching pattern, got %v", result)
 		}
 	}
 }
@@ -341,9 +358,9 @@ func TestMatch_VeryLongInput(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Match() should not error on long input without match: %v", err)
 	}
-	// Should return empty map since pattern doesn't match
-	if len(result) != 0 {
-		t.Errorf("expected empty result, got %v", result)
+	// Should return only the zero count since pattern doesn't match
+	if want := map[string]string{"ip_addr_count": "0"}; !reflect.DeepEqual(result, want) {
+		t.Errorf("expected %v, got %v", want, result)
 	}
 }
 
@@ -575,3 +592,211 @@ func TestMatch_ComplexWorkflow_OverridingCaptures(t *testing.T) {
 		t.Errorf("expected version=2.0.0 (from latest pattern), got %q", result["version"])
 	}
 }
+
+func TestMatch_StandardLibraryPatterns(t *testing.T) {
+	m, err := New([]string{
+		`%{TIMESTAMP_ISO8601:ts} %{LOGLEVEL:level} \[%{WORD:component}\] %{IP:client} %{DATA:action} took %{NUMBER:ms}ms: %{GREEDYDATA:detail}`,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	result, err := m.Match("2026-01-15T10:04:05Z WARN [api] 10.0.0.7 GET /users took 812ms: slow query on users table")
+	if err != nil {
+		t.Fatalf("Match() returned error: %v", err)
+	}
+
+	want := map[string]string{
+		"ts":        "2026-01-15T10:04:05Z",
+		"level":     "WARN",
+		"component": "api",
+		"client":    "10.0.0.7",
+		"action":    "GET /users",
+		"ms":        "812",
+		"detail":    "slow query on users table",
+	}
+	for k, v := range want {
+		if result[k] != v {
+			t.Errorf("expected %s=%q, got %q", k, v, result[k])
+		}
+	}
+}
+
+func TestNewWithDefinitions_CustomPatternsCompose(t *testing.T) {
+	definitions := map[string]string{
+		"PERCENT":       `%{NUMBER}%`,
+		"COVERAGE_LINE": `coverage: %{PERCENT:coverage}`,
+	}
+	if err := ValidateDefinitions(definitions); err != nil {
+		t.Fatalf("ValidateDefinitions() returned error: %v", err)
+	}
+
+	m, err := NewWithDefinitions([]string{"%{COVERAGE_LINE} of %{WORD:pkg}"}, definitions)
+	if err != nil {
+		t.Fatalf("NewWithDefinitions() returned error: %v", err)
+	}
+	result, err := m.Match("coverage: 81.5% of statements")
+	if err != nil {
+		t.Fatalf("Match() returned error: %v", err)
+	}
+	if result["coverage"] != "81.5%" {
+		t.Errorf("expected coverage=81.5%%, got %q", result["coverage"])
+	}
+	if result["pkg"] != "statements" {
+		t.Errorf("expected pkg=statements, got %q", result["pkg"])
+	}
+}
+
+func TestNewWithDefinitions_UndefinedPattern(t *testing.T) {
+	_, err := NewWithDefinitions([]string{"%{MISSING:x}"}, map[string]string{"OTHER": `\d+`})
+	if err == nil {
+		t.Fatal("expected error for undefined pattern")
+	}
+}
+
+func TestValidateDefinitions_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		definitions map[string]string
+		wantErr     string
+	}{
+		{
+			name:        "undefined reference",
+			definitions: map[string]string{"VERSION": `v%{SEMVER}`},
+			wantErr:     `grok pattern "VERSION" references undefined pattern "SEMVER"`,
+		},
+		{
+			name:        "invalid regex",
+			definitions: map[string]string{"BROKEN": `(unclosed`},
+			wantErr:     `grok pattern "BROKEN" does not compile`,
+		},
+		{
+			name:        "invalid name",
+			definitions: map[string]string{"BAD-NAME": `\d+`},
+			wantErr:     `grok pattern name "BAD-NAME" is invalid`,
+		},
+		{
+			name:        "self reference",
+			definitions: map[string]string{"LOOP": `a%{LOOP}`},
+			wantErr:     `grok pattern "LOOP" references itself (LOOP -> LOOP)`,
+		},
+		{
+			name:        "indirect cycle",
+			definitions: map[string]string{"A": `%{B}`, "B": `%{A}`},
+			wantErr:     `grok pattern "A" references itself (A -> B -> A)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDefinitions(tt.definitions)
+			if err == nil {
+				t.Fatalf("expected error containing %q, got nil", tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateDefinitions_OverridesBuiltin(t *testing.T) {
+	// A custom definition may replace a built-in of the same name
+	definitions := map[string]string{"WORD": `[a-z]+`}
+	if err := ValidateDefinitions(definitions); err != nil {
+		t.Fatalf("ValidateDefinitions() returned error: %v", err)
+	}
+	m, err := NewWithDefinitions([]string{"%{WORD:w}"}, definitions)
+	if err != nil {
+		t.Fatalf("NewWithDefinitions() returned error: %v", err)
+	}
+	result, _ := m.Match("ABC def")
+	if result["w"] != "def" {
+		t.Errorf("expected custom WORD to match lowercase only, got %q", result["w"])
+	}
+}
+
+func TestMatch_MultipleMatchesCountedPerCapture(t *testing.T) {
+	output := `internal/a.go:10: unused variable x
+internal/a.go:22: shadowed err
+internal/b.go:3: unused import fmt
+ok  	example/pkg
+`
+	m, err := New([]string{`%{DATA:file}:%{INT:line}: %{GREEDYDATA:issue}`})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	result, err := m.Match(output)
+	if err != nil {
+		t.Fatalf("Match() returned error: %v", err)
+	}
+
+	want := map[string]string{
+		// Values come from the first match
+		"file":  "internal/a.go",
+		"line":  "10",
+		"issue": "unused variable x",
+		// Counts cover every match
+		"file_count":  "3",
+		"line_count":  "3",
+		"issue_count": "3",
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("expected %v, got %v", want, result)
+	}
+}
+
+func TestMatch_CountOptionalCapture(t *testing.T) {
+	// A capture inside an optional group only counts when it matched text
+	m, err := New([]string{`(?m)^(?P<status>FAIL|ok)(?:\s+(?P<pkg>\S+))?$`})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	result, _ := m.Match("FAIL pkg/a\nok\nok pkg/c\n")
+	if result["status_count"] != "3" || result["pkg_count"] != "2" {
+		t.Errorf("expected status_count=3 and pkg_count=2, got %v", result)
+	}
+}
+
+func TestMatch_ExplicitCountCaptureWins(t *testing.T) {
+	m, err := New([]string{
+		`%{WORD:issue}: `,
+		`found %{INT:issue_count} issues`,
+	})
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	result, _ := m.Match("lint: a\nlint: b\nfound 7 issues\n")
+	if result["issue_count"] != "7" {
+		t.Errorf("expected explicit issue_count=7 to win, got %q", result["issue_count"])
+	}
+}
+
+func TestMatch_CountWithCustomDefinitions(t *testing.T) {
+	definitions := map[string]string{"ISSUE": `%{DATA:file}:%{INT:line}:`}
+	m, err := NewWithDefinitions([]string{"%{ISSUE}"}, definitions)
+	if err != nil {
+		t.Fatalf("NewWithDefinitions() returned error: %v", err)
+	}
+	result, _ := m.Match("a.go:1: x\nb.go:2: y\n")
+	if result["file_count"] != "2" {
+		t.Errorf("expected file_count=2, got %v", result)
+	}
+}
+
+func TestMatcher_Captures(t *testing.T) {
+	m, err := New([]string{
+		"%{NUMBER:coverage}% of %{WORD:pkg.name}",
+		"(?P<status>\\w+) %{NUMBER:coverage}",
+		"%{INT}",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := m.Captures()
+	want := []string{"coverage", "pkg.name", "status"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Captures() = %v, want %v", got, want)
+	}
+}
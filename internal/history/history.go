@@ -0,0 +1,219 @@
+// Package history persists check results to a SQLite database so that trends
+// such as coverage over time or flakiness rates can be queried across runs.
+//
+// The SQLite driver is written in pure Go, so history works in binaries built
+// with CGO_ENABLED=0.
+package history
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	// Registers the "sqlite" database/sql driver.
+	_ "modernc.org/sqlite"
+
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+// schema creates the tables used to store run history.
+const schema = `
+CREATE TABLE IF NOT EXISTS runs (
+	id          INTEGER PRIMARY KEY AUTOINCREMENT,
+	started_at  TEXT    NOT NULL,
+	duration_ms INTEGER NOT NULL,
+	exit_code   INTEGER NOT NULL,
+	config_path TEXT    NOT NULL DEFAULT ''
+);
+CREATE TABLE IF NOT EXISTS check_results (
+	id          INTEGER PRIMARY KEY AUTOINCREMENT,
+	run_id      INTEGER NOT NULL REFERENCES runs(id),
+	check_id    TEXT    NOT NULL,
+	passed      INTEGER NOT NULL,
+	status      TEXT    NOT NULL,
+	exit_code   INTEGER NOT NULL,
+	duration_ms INTEGER NOT NULL,
+	extracted   TEXT    NOT NULL DEFAULT '{}'
+);
+CREATE INDEX IF NOT EXISTS idx_check_results_check_id ON check_results(check_id, run_id);
+`
+
+// migrations add columns introduced after a database was created. Each is
+// applied when the column it adds is missing from the table.
+var migrations = []struct {
+	table, column, stmt string
+}{
+	{"runs", "config_path", `ALTER TABLE runs ADD COLUMN config_path TEXT NOT NULL DEFAULT ''`},
+}
+
+// Entry is a single historical result for one check.
+type Entry struct {
+	RunID      int64             `json:"run_id"`
+	ConfigPath string            `json:"config_path,omitempty"`
+	CheckID    string            `json:"check_id"`
+	Timestamp  time.Time         `json:"timestamp"`
+	Passed     bool              `json:"passed"`
+	Status     string            `json:"status"`
+	ExitCode   int               `json:"exit_code"`
+	DurationMS int64             `json:"duration_ms"`
+	Extracted  map[string]string `json:"extracted,omitempty"`
+}
+
+// Store reads and writes run history in a SQLite database.
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens (creating if necessary) the history database at path.
+func Open(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history database %q: %w", path, err)
+	}
+	if _, err := db.Exec(schema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize history database %q: %w", path, err)
+	}
+	if err := migrate(db); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to migrate history database %q: %w", path, err)
+	}
+	return &Store{db: db}, nil
+}
+
+// migrate applies the migrations a database created by an older version
+// still needs.
+func migrate(db *sql.DB) error {
+	for _, m := range migrations {
+		var count int
+		err := db.QueryRow(`SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?`, m.table, m.column).Scan(&count)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			continue
+		}
+		if _, err := db.Exec(m.stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Record appends a run and its per-check results to the database.
+// startedAt is the time the run began. The run is labelled with the config
+// file it was made from, so runs of several configs sharing check IDs, as
+// with --recursive, can be told apart.
+func (s *Store) Record(result *orchestrator.RunResult, startedAt time.Time) (int64, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin history transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.Exec(
+		`INSERT INTO runs (started_at, duration_ms, exit_code, config_path) VALUES (?, ?, ?, ?)`,
+		startedAt.UTC().Format(time.RFC3339Nano), result.Duration.Milliseconds(), result.ExitCode,
+		result.Provenance.ConfigPath,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record run: %w", err)
+	}
+	runID, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("failed to record run: %w", err)
+	}
+
+	for _, r := range result.Results {
+		if r.PathSkipped {
+			// The check was not affected by the change set, so there is no
+			// result worth trending
+			continue
+		}
+		extracted, err := json.Marshal(r.Extracted)
+		if err != nil {
+			return 0, fmt.Errorf("failed to encode extracted values for %q: %w", r.Check.ID, err)
+		}
+		if _, err := tx.Exec(
+			`INSERT INTO check_results (run_id, check_id, passed, status, exit_code, duration_ms, extracted)
+			 VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			runID, r.Check.ID, r.Passed, resultStatus(r), r.Execution.ExitCode,
+			r.Execution.Duration.Milliseconds(), string(extracted),
+		); err != nil {
+			return 0, fmt.Errorf("failed to record result for %q: %w", r.Check.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit history: %w", err)
+	}
+	return runID, nil
+}
+
+// Query returns up to limit of the most recent results for a check,
+// newest first. A non-empty configPath restricts the results to runs of that
+// config file. A limit of 0 or less returns all results.
+func (s *Store) Query(checkID, configPath string, limit int) ([]Entry, error) {
+	query := `SELECT r.id, r.config_path, r.started_at, c.check_id, c.passed, c.status, c.exit_code, c.duration_ms, c.extracted
+		FROM check_results c JOIN runs r ON r.id = c.run_id
+		WHERE c.check_id = ?`
+	args := []interface{}{checkID}
+	if configPath != "" {
+		query += " AND r.config_path = ?"
+		args = append(args, configPath)
+	}
+	query += " ORDER BY r.id DESC"
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query history for %q: %w", checkID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var entries []Entry
+	for rows.Next() {
+		var e Entry
+		var startedAt, extracted string
+		if err := rows.Scan(&e.RunID, &e.ConfigPath, &startedAt, &e.CheckID, &e.Passed, &e.Status, &e.ExitCode, &e.DurationMS, &extracted); err != nil {
+			return nil, fmt.Errorf("failed to read history row: %w", err)
+		}
+		e.Timestamp, err = time.Parse(time.RFC3339Nano, startedAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp %q in history: %w", startedAt, err)
+		}
+		if err := json.Unmarshal([]byte(extracted), &e.Extracted); err != nil {
+			return nil, fmt.Errorf("invalid extracted values in history: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read history rows: %w", err)
+	}
+	return entries, nil
+}
+
+// resultStatus returns the status label stored for a check result.
+func resultStatus(r *orchestrator.CheckResult) string {
+	switch {
+	case r.Skipped:
+		return "skipped"
+	case r.Execution.Cancelled:
+		return "cancelled"
+	case r.Execution.Timedout:
+		return "timeout"
+	case r.Passed:
+		return "passed"
+	default:
+		return "failed"
+	}
+}
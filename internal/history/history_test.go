@@ -0,0 +1,247 @@
+package history
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+func newRunResult(coverage string, passed bool, exitCode int) *orchestrator.RunResult {
+	return &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:  &config.Check{ID: "coverage"},
+				Passed: passed,
+				Execution: &executor.Result{
+					CheckID:  "coverage",
+					ExitCode: exitCode,
+					Duration: 1500 * time.Millisecond,
+					Success:  exitCode == 0,
+				},
+				Extracted: map[string]string{"coverage": coverage},
+			},
+			{
+				Check:     &config.Check{ID: "fmt"},
+				Passed:    true,
+				Execution: &executor.Result{CheckID: "fmt", Duration: 10 * time.Millisecond, Success: true},
+				Extracted: map[string]string{},
+			},
+		},
+		Duration: 2 * time.Second,
+		ExitCode: exitCode,
+	}
+}
+
+func TestStore_RecordAndQuery(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "history.db")
+
+	store, err := Open(dbPath)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer func() { _ = store.Close() }()
+
+	first := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+
+	if _, err := store.Record(newRunResult("72.5", true, 0), first); err != nil {
+		t.Fatalf("first Record failed: %v", err)
+	}
+	if _, err := store.Record(newRunResult("65.0", false, 1), second); err != nil {
+		t.Fatalf("second Record failed: %v", err)
+	}
+
+	entries, err := store.Query("coverage", "", 10)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+
+	// Newest first
+	latest, earliest := entries[0], entries[1]
+	if !latest.Timestamp.Equal(second) || !earliest.Timestamp.Equal(first) {
+		t.Errorf("expected entries newest first, got %v then %v", latest.Timestamp, earliest.Timestamp)
+	}
+	if latest.RunID <= earliest.RunID {
+		t.Errorf("expected increasing run IDs, got %d then %d", earliest.RunID, latest.RunID)
+	}
+	if latest.Passed || latest.Status != "failed" || latest.ExitCode != 1 {
+		t.Errorf("unexpected latest entry: %+v", latest)
+	}
+	if !earliest.Passed || earliest.Status != "passed" || earliest.ExitCode != 0 {
+		t.Errorf("unexpected earliest entry: %+v", earliest)
+	}
+	if latest.Extracted["coverage"] != "65.0" || earliest.Extracted["coverage"] != "72.5" {
+		t.Errorf("unexpected extracted values: %v, %v", latest.Extracted, earliest.Extracted)
+	}
+	if earliest.DurationMS != 1500 {
+		t.Errorf("expected duration 1500ms, got %d", earliest.DurationMS)
+	}
+}
+
+func TestStore_QueryLimitAndUnknownCheck(t *testing.T) {
+	store, err := Open(filepath.Join(t.TempDir(), "history.db"))
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer func() { _ = store.Close() }()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := store.Record(newRunResult("80", true, 0), start.Add(time.Duration(i)*time.Minute)); err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+	}
+
+	entries, err := store.Query("fmt", "", 2)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("expected limit of 2 entries, got %d", len(entries))
+	}
+
+	entries, err = store.Query("missing", "", 0)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("expected no entries for unknown check, got %d", len(entries))
+	}
+}
+
+func TestStore_PersistsAcrossOpens(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "history.db")
+
+	store, err := Open(dbPath)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	if _, err := store.Record(newRunResult("90", true, 0), time.Now()); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+	_ = store.Close()
+
+	reopened, err := Open(dbPath)
+	if err != nil {
+		t.Fatalf("reopen failed: %v", err)
+	}
+	defer func() { _ = reopened.Close() }()
+
+	entries, err := reopened.Query("coverage", "", 0)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected 1 persisted entry, got %d", len(entries))
+	}
+}
+
+func TestStore_SkippedStatus(t *testing.T) {
+	store, err := Open(filepath.Join(t.TempDir(), "history.db"))
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer func() { _ = store.Close() }()
+
+	// A check skipped because its dependency failed did not run, so it must
+	// not count as a failure in its trend
+	result := newRunResult("50", false, 1)
+	result.Results = append(result.Results, &orchestrator.CheckResult{
+		Check:      &config.Check{ID: "deploy"},
+		Execution:  &executor.Result{CheckID: "deploy", ExitCode: -1},
+		Skipped:    true,
+		SkipReason: "dependency failed: coverage",
+	})
+	if _, err := store.Record(result, time.Now()); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+
+	entries, err := store.Query("deploy", "", 0)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Status != "skipped" || entries[0].Passed {
+		t.Errorf("expected one skipped entry, got %+v", entries)
+	}
+}
+
+func TestStore_QueryByConfigPath(t *testing.T) {
+	store, err := Open(filepath.Join(t.TempDir(), "history.db"))
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer func() { _ = store.Close() }()
+
+	// Two configs with the same check IDs, as recorded by check --recursive
+	start := time.Now()
+	for i, path := range []string{"/repo/api/vibeguard.yaml", "/repo/web/vibeguard.yaml"} {
+		result := newRunResult("70", true, 0)
+		result.Provenance.ConfigPath = path
+		if _, err := store.Record(result, start.Add(time.Duration(i)*time.Minute)); err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+	}
+
+	entries, err := store.Query("coverage", "/repo/api/vibeguard.yaml", 0)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if len(entries) != 1 || entries[0].ConfigPath != "/repo/api/vibeguard.yaml" {
+		t.Errorf("expected only the api config's run, got %+v", entries)
+	}
+
+	entries, err = store.Query("coverage", "", 0)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if len(entries) != 2 || entries[0].ConfigPath != "/repo/web/vibeguard.yaml" {
+		t.Errorf("expected both configs' runs, newest first, got %+v", entries)
+	}
+}
+
+func TestOpen_MigratesRunsWithoutConfigPath(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "history.db")
+
+	// A database created before runs recorded their config file
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatalf("sql.Open failed: %v", err)
+	}
+	if _, err := db.Exec(`CREATE TABLE runs (
+		id          INTEGER PRIMARY KEY AUTOINCREMENT,
+		started_at  TEXT    NOT NULL,
+		duration_ms INTEGER NOT NULL,
+		exit_code   INTEGER NOT NULL
+	);
+	INSERT INTO runs (started_at, duration_ms, exit_code) VALUES ('2026-01-01T10:00:00Z', 100, 0);`); err != nil {
+		t.Fatalf("failed to create old schema: %v", err)
+	}
+	_ = db.Close()
+
+	store, err := Open(dbPath)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer func() { _ = store.Close() }()
+
+	result := newRunResult("70", true, 0)
+	result.Provenance.ConfigPath = "/repo/vibeguard.yaml"
+	if _, err := store.Record(result, time.Now()); err != nil {
+		t.Fatalf("Record after migration failed: %v", err)
+	}
+	entries, err := store.Query("coverage", "/repo/vibeguard.yaml", 0)
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected 1 entry for the migrated database, got %d", len(entries))
+	}
+}
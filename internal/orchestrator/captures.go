@@ -0,0 +1,76 @@
+package orchestrator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vibeguard/vibeguard/internal/assert"
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+// resetCaptures forgets the captures and grok misses of the checks of a
+// previous run.
+func (o *Orchestrator) resetCaptures() {
+	o.capturesMu.Lock()
+	defer o.capturesMu.Unlock()
+	o.captures = make(map[string]map[string]string)
+	o.grokMisses = make(map[string][]string)
+}
+
+// recordCaptures stores the values a check extracted, for the assertions of
+// the checks that require it.
+func (o *Orchestrator) recordCaptures(checkID string, extracted map[string]string) {
+	o.capturesMu.Lock()
+	defer o.capturesMu.Unlock()
+	if o.captures == nil {
+		o.captures = make(map[string]map[string]string)
+	}
+	o.captures[checkID] = extracted
+}
+
+// requiredCaptures returns the values extracted by the checks check
+// requires, named check.capture. Checks it doesn't require are not visible.
+func (o *Orchestrator) requiredCaptures(check *config.Check) map[string]string {
+	o.capturesMu.Lock()
+	defer o.capturesMu.Unlock()
+	vars := make(map[string]string)
+	for _, dep := range check.Requires {
+		for name, value := range o.captures[dep] {
+			vars[dep+"."+name] = value
+		}
+	}
+	return vars
+}
+
+// gateReason evaluates, before check runs, an assertion that references only
+// variables of required checks, such as test.coverage >= 80: the check's
+// command can't change its outcome, so a check gated on it isn't run when it
+// fails. It returns the reason to skip check with in that case, and ""
+// otherwise.
+func (o *Orchestrator) gateReason(check *config.Check, checkIndex int) (string, error) {
+	names, err := assert.Variables(check.Assert)
+	if err != nil || len(names) == 0 {
+		// A parse error is reported when the assertion is evaluated
+		return "", nil
+	}
+	for _, name := range names {
+		if !strings.Contains(name, ".") {
+			return "", nil
+		}
+	}
+
+	passed, err := assert.New().Eval(check.Assert, o.requiredCaptures(check))
+	if err != nil {
+		return "", &config.ExecutionError{
+			Message:   "failed to evaluate assertion",
+			Cause:     err,
+			CheckID:   check.ID,
+			LineNum:   o.config.FindCheckNodeLine(check.ID, checkIndex),
+			ErrorType: "assert",
+		}
+	}
+	if passed {
+		return "", nil
+	}
+	return fmt.Sprintf("Skipped: assertion on required checks failed: %s", check.Assert), nil
+}
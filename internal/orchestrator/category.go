@@ -0,0 +1,50 @@
+package orchestrator
+
+import (
+	"slices"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+// Uncategorized names the group of checks that have no category.
+const Uncategorized = "uncategorized"
+
+// CategoryGroup holds the results of the checks of one category.
+type CategoryGroup struct {
+	Category string
+	Results  []*CheckResult
+}
+
+// Summary computes the aggregate statistics of the group's results. Its
+// Duration is zero, as the checks of a group may overlap with others.
+func (g CategoryGroup) Summary() Summary {
+	return (&RunResult{Results: g.Results}).Summary()
+}
+
+// GroupByCategory groups results by their check's category, in the order of
+// config.Categories with Uncategorized last. Results keep their order within
+// a group. It returns nil if no check has a category.
+func GroupByCategory(results []*CheckResult) []CategoryGroup {
+	byCategory := make(map[string][]*CheckResult)
+	categorized := false
+	for _, r := range results {
+		category := r.Check.Category
+		if category == "" {
+			category = Uncategorized
+		} else {
+			categorized = true
+		}
+		byCategory[category] = append(byCategory[category], r)
+	}
+	if !categorized {
+		return nil
+	}
+
+	var groups []CategoryGroup
+	for _, category := range slices.Concat(config.Categories, []string{Uncategorized}) {
+		if len(byCategory[category]) > 0 {
+			groups = append(groups, CategoryGroup{Category: category, Results: byCategory[category]})
+		}
+	}
+	return groups
+}
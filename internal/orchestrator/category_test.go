@@ -0,0 +1,43 @@
+package orchestrator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+func TestGroupByCategory(t *testing.T) {
+	result := func(id, category string) *CheckResult {
+		return &CheckResult{Check: &config.Check{ID: id, Category: category}}
+	}
+
+	if groups := GroupByCategory([]*CheckResult{result("fmt", ""), result("vet", "")}); groups != nil {
+		t.Errorf("expected no groups without categories, got %+v", groups)
+	}
+
+	results := []*CheckResult{
+		result("gosec", config.CategorySecurity),
+		result("test", config.CategoryTest),
+		result("build", ""),
+		result("golangci", config.CategoryLint),
+		result("vet", config.CategoryLint),
+	}
+	var got [][]string
+	for _, g := range GroupByCategory(results) {
+		ids := []string{g.Category}
+		for _, r := range g.Results {
+			ids = append(ids, r.Check.ID)
+		}
+		got = append(got, ids)
+	}
+	want := [][]string{
+		{"lint", "golangci", "vet"},
+		{"test", "test"},
+		{"security", "gosec"},
+		{Uncategorized, "build"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupByCategory() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+package orchestrator
+
+import (
+	"maps"
+	"strings"
+
+	"github.com/vibeguard/vibeguard/internal/assert"
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+// ExplainTailLines is how many trailing lines of each output stream a
+// FailureExplanation keeps.
+const ExplainTailLines = 20
+
+// FailureExplanation details why a check failed, for debugging assertion
+// thresholds and patterns.
+type FailureExplanation struct {
+	Assert          string            // The assertion expression, empty if the check has none
+	AssertEvaluated bool              // False if the command's failure decided the outcome before the assertion ran
+	AssertPassed    bool              // The assertion's result, if it was evaluated
+	AssertValues    map[string]string // Values of the variables the assertion references
+	ExitCode        int
+	Stdout          string // Last ExplainTailLines lines of stdout
+	Stderr          string // Last ExplainTailLines lines of stderr
+}
+
+// SetExplainFailures attaches a FailureExplanation to the violation of every
+// check that ran and failed.
+func (o *Orchestrator) SetExplainFailures(enabled bool) {
+	o.explainFailures = enabled
+}
+
+// explainFailure returns the explanation of a failed check's violation, or
+// nil if explanations are disabled. The assertion is evaluated again, with
+// the same values, since evaluateCheck only reports the overall outcome.
+func (o *Orchestrator) explainFailure(check *config.Check, execResult *executor.Result, extracted map[string]string) *FailureExplanation {
+	if !o.explainFailures {
+		return nil
+	}
+	explanation := &FailureExplanation{
+		Assert:   check.Assert,
+		ExitCode: execResult.ExitCode,
+		Stdout:   tailLines(execResult.Stdout, ExplainTailLines),
+		Stderr:   tailLines(execResult.Stderr, ExplainTailLines),
+	}
+	if check.Assert == "" {
+		return explanation
+	}
+
+	vars := assertVars(extracted, execResult)
+	maps.Copy(vars, o.requiredCaptures(check))
+	if names, err := assert.Variables(check.Assert); err == nil {
+		explanation.AssertValues = make(map[string]string, len(names))
+		for _, name := range names {
+			explanation.AssertValues[name] = vars[name]
+		}
+	}
+	if execResult.Success || (!execResult.Cancelled && assertDecidesOutcome(check.Assert)) {
+		passed, err := assert.New().Eval(check.Assert, vars)
+		explanation.AssertEvaluated = err == nil
+		explanation.AssertPassed = passed
+	}
+	return explanation
+}
+
+// tailLines returns the last n lines of s, without a trailing newline.
+func tailLines(s string, n int) string {
+	s = strings.TrimRight(s, "\n")
+	if s == "" {
+		return ""
+	}
+	lines := strings.Split(s, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,136 @@
+//go:build !windows
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+func TestRun_ExplainFailures(t *testing.T) {
+	tests := []struct {
+		name          string
+		run           string
+		explain       bool
+		wantEvaluated bool
+		wantExitCode  int
+		wantStdout    string
+	}{
+		{
+			name:          "failing coverage assertion",
+			run:           "echo 'ok  ./internal/config'; echo 'coverage: 72.5% of statements'",
+			explain:       true,
+			wantEvaluated: true,
+			wantStdout:    "ok  ./internal/config\ncoverage: 72.5% of statements",
+		},
+		{
+			name:         "failing command decides before the assertion",
+			run:          "echo 'coverage: 72.5% of statements'; echo 'FAIL' >&2; exit 1",
+			explain:      true,
+			wantExitCode: 1,
+			wantStdout:   "coverage: 72.5% of statements",
+		},
+		{
+			name: "disabled",
+			run:  "echo 'coverage: 72.5% of statements'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Version: "1",
+				Checks: []config.Check{
+					{
+						ID:       "coverage",
+						Run:      tt.run,
+						Grok:     []string{`coverage: %{NUMBER:coverage}% of statements`},
+						Assert:   "coverage >= 80",
+						Severity: config.SeverityError,
+					},
+				},
+			}
+			orch := New(cfg, executor.New(""), 1, false, false, t.TempDir(), 1)
+			orch.SetExplainFailures(tt.explain)
+			result, err := orch.Run(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(result.Violations) != 1 {
+				t.Fatalf("expected 1 violation, got %d", len(result.Violations))
+			}
+
+			e := result.Violations[0].Explanation
+			if !tt.explain {
+				if e != nil {
+					t.Errorf("expected no explanation, got %+v", e)
+				}
+				return
+			}
+			if e == nil {
+				t.Fatal("expected an explanation")
+			}
+			if e.Assert != "coverage >= 80" {
+				t.Errorf("expected the assertion expression, got %q", e.Assert)
+			}
+			if got := e.AssertValues["coverage"]; got != "72.5" {
+				t.Errorf("expected coverage=72.5 among the assertion values, got %q", got)
+			}
+			if e.AssertEvaluated != tt.wantEvaluated || e.AssertPassed {
+				t.Errorf("expected evaluated=%v passed=false, got evaluated=%v passed=%v", tt.wantEvaluated, e.AssertEvaluated, e.AssertPassed)
+			}
+			if e.ExitCode != tt.wantExitCode {
+				t.Errorf("expected exit code %d, got %d", tt.wantExitCode, e.ExitCode)
+			}
+			if e.Stdout != tt.wantStdout {
+				t.Errorf("expected stdout tail %q, got %q", tt.wantStdout, e.Stdout)
+			}
+		})
+	}
+}
+
+func TestRunCheck_ExplainFailures(t *testing.T) {
+	cfg := &config.Config{
+		Version: "1",
+		Checks: []config.Check{
+			{
+				ID:       "coverage",
+				Run:      "echo 'coverage: 72.5% of statements'",
+				Grok:     []string{`coverage: %{NUMBER:coverage}% of statements`},
+				Assert:   "coverage >= 80",
+				Severity: config.SeverityError,
+			},
+		},
+	}
+	orch := New(cfg, executor.New(""), 1, false, false, t.TempDir(), 1)
+	orch.SetExplainFailures(true)
+	result, err := orch.RunCheck(context.Background(), "coverage")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Violations) != 1 || result.Violations[0].Explanation == nil {
+		t.Fatalf("expected 1 violation with an explanation, got %+v", result.Violations)
+	}
+	if got := result.Violations[0].Explanation.AssertValues["coverage"]; got != "72.5" {
+		t.Errorf("expected coverage=72.5 among the assertion values, got %q", got)
+	}
+}
+
+func TestTailLines(t *testing.T) {
+	tests := []struct {
+		s    string
+		n    int
+		want string
+	}{
+		{"", 2, ""},
+		{"a\nb\nc\n", 2, "b\nc"},
+		{"a\nb", 5, "a\nb"},
+	}
+	for _, tt := range tests {
+		if got := tailLines(tt.s, tt.n); got != tt.want {
+			t.Errorf("tailLines(%q, %d) = %q, want %q", tt.s, tt.n, got, tt.want)
+		}
+	}
+}
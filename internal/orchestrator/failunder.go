@@ -0,0 +1,69 @@
+package orchestrator
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/coverage"
+)
+
+// SetFailUnder fails every check that ran and captured a coverage value, by
+// grok or from its coverage report, below threshold, on top of its own assertion, with an error-severity
+// violation naming the value and the threshold. Checks that capture no
+// numeric coverage are unaffected. A threshold of 0 disables the gate.
+func (o *Orchestrator) SetFailUnder(threshold float64) {
+	o.failUnder = threshold
+}
+
+// applyFailUnder fails the results whose captured coverage is below the
+// SetFailUnder threshold and returns violations with theirs added. A check
+// that already failed keeps its violation, which is raised to error
+// severity and leads with the gate's reason.
+func (o *Orchestrator) applyFailUnder(results []*CheckResult, violations []*Violation) []*Violation {
+	if o.failUnder <= 0 {
+		return violations
+	}
+	byID := make(map[string]*Violation, len(violations))
+	for _, v := range violations {
+		byID[v.CheckID] = v
+	}
+
+	for _, r := range results {
+		if r.Skipped || r.Execution == nil || r.Execution.Cancelled {
+			continue
+		}
+		value, err := strconv.ParseFloat(r.Extracted[coverage.Variable], 64)
+		if err != nil || value >= o.failUnder {
+			continue
+		}
+		reason := fmt.Sprintf("Coverage %s%% is below --fail-under %s%%", r.Extracted[coverage.Variable], strconv.FormatFloat(o.failUnder, 'f', -1, 64))
+		r.Passed = false
+		r.Fixed = false
+
+		if v, ok := byID[r.Check.ID]; ok {
+			if v.Suggestion != "" {
+				reason += ". " + v.Suggestion
+			}
+			v.Suggestion = reason
+			v.Severity = config.SeverityError
+			v.AllowFailure = false
+			continue
+		}
+		violations = append(violations, &Violation{
+			CheckID:          r.Check.ID,
+			Description:      r.Check.Description,
+			Severity:         config.SeverityError,
+			Command:          r.Check.Run,
+			Suggestion:       reason,
+			Fix:              o.renderTemplate(r.Check.Fix, r.Extracted),
+			Extracted:        r.Extracted,
+			LogFile:          filepath.Join(o.logDir, r.Check.ID+".log"),
+			TriggeredPrompts: r.TriggeredPrompts,
+			FixAttempted:     r.FixAttempted,
+			Explanation:      o.explainFailure(r.Check, r.Execution, r.Extracted),
+		})
+	}
+	return violations
+}
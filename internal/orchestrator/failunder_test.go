@@ -0,0 +1,123 @@
+//go:build !windows
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+func TestRun_FailUnder(t *testing.T) {
+	coverageCheck := func(id, pct string) config.Check {
+		return config.Check{
+			ID:       id,
+			Run:      "echo 'coverage: " + pct + "% of statements'",
+			Grok:     []string{`coverage: %{NUMBER:coverage}% of statements`},
+			Severity: config.SeverityError,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		checks         []config.Check
+		failUnder      float64
+		wantFailed     map[string]string // Suggestion by failed check ID
+		wantExitCode   int
+		wantViolations int
+	}{
+		{
+			name:           "one check under the threshold",
+			checks:         []config.Check{coverageCheck("api", "85.0"), coverageCheck("web", "72.5")},
+			failUnder:      80,
+			wantFailed:     map[string]string{"web": "Coverage 72.5% is below --fail-under 80%"},
+			wantExitCode:   1,
+			wantViolations: 1,
+		},
+		{
+			name:      "disabled",
+			checks:    []config.Check{coverageCheck("api", "85.0"), coverageCheck("web", "72.5")},
+			failUnder: 0,
+		},
+		{
+			name: "checks without coverage are unaffected",
+			checks: []config.Check{
+				{ID: "lint", Run: "echo ok", Severity: config.SeverityError},
+				{ID: "unmatched", Run: "echo no coverage", Grok: []string{`coverage: %{NUMBER:coverage}%`}, Severity: config.SeverityError},
+			},
+			failUnder: 80,
+		},
+		{
+			name: "composes with a failed assertion",
+			checks: []config.Check{func() config.Check {
+				c := coverageCheck("web", "72.5")
+				c.Assert = "coverage >= 75"
+				c.Severity = config.SeverityWarning
+				c.Suggestion = "Add tests"
+				return c
+			}()},
+			failUnder:      80,
+			wantFailed:     map[string]string{"web": "Coverage 72.5% is below --fail-under 80%. Add tests"},
+			wantExitCode:   1,
+			wantViolations: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{Version: "1", Checks: tt.checks}
+			orch := New(cfg, executor.New(""), 2, false, false, t.TempDir(), 1)
+			orch.SetFailUnder(tt.failUnder)
+			result, err := orch.Run(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, r := range result.Results {
+				_, wantFailed := tt.wantFailed[r.Check.ID]
+				if r.Passed == wantFailed {
+					t.Errorf("expected %s passed=%v, got %v", r.Check.ID, !wantFailed, r.Passed)
+				}
+			}
+			if len(result.Violations) != tt.wantViolations {
+				t.Fatalf("expected %d violations, got %d", tt.wantViolations, len(result.Violations))
+			}
+			for _, v := range result.Violations {
+				if v.Suggestion != tt.wantFailed[v.CheckID] {
+					t.Errorf("expected %s suggestion %q, got %q", v.CheckID, tt.wantFailed[v.CheckID], v.Suggestion)
+				}
+				if v.Severity != config.SeverityError {
+					t.Errorf("expected %s to fail with error severity, got %s", v.CheckID, v.Severity)
+				}
+			}
+			if result.ExitCode != tt.wantExitCode {
+				t.Errorf("expected exit code %d, got %d", tt.wantExitCode, result.ExitCode)
+			}
+		})
+	}
+}
+
+func TestRunCheck_FailUnder(t *testing.T) {
+	cfg := &config.Config{
+		Version: "1",
+		Checks: []config.Check{{
+			ID:       "coverage",
+			Run:      "echo 'coverage: 72.5% of statements'",
+			Grok:     []string{`coverage: %{NUMBER:coverage}% of statements`},
+			Severity: config.SeverityError,
+		}},
+	}
+	orch := New(cfg, executor.New(""), 1, false, false, t.TempDir(), 1)
+	orch.SetFailUnder(80)
+	result, err := orch.RunCheck(context.Background(), "coverage")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Violations) != 1 || result.Results[0].Passed {
+		t.Fatalf("expected the check to fail with 1 violation, got passed=%v and %d violations", result.Results[0].Passed, len(result.Violations))
+	}
+	if result.ExitCode != 1 {
+		t.Errorf("expected exit code 1, got %d", result.ExitCode)
+	}
+}
@@ -2,6 +2,7 @@ package orchestrator
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/vibeguard/vibeguard/internal/config"
 )
@@ -29,6 +30,11 @@ func BuildGraph(checks []config.Check) (*DependencyGraph, error) {
 		}
 	}
 
+	// Checks built without config.Load have not been checked for cycles
+	if cycle := config.FindCycle(checks); cycle != nil {
+		return nil, fmt.Errorf("cyclic dependency detected: %s", config.FormatCycle(cycle))
+	}
+
 	// Topological sort using Kahn's algorithm
 	// Initialize in-degree for each node
 	inDegree := make(map[string]int)
@@ -38,13 +44,20 @@ func BuildGraph(checks []config.Check) (*DependencyGraph, error) {
 		}
 	}
 
-	// Build adjacency list (dependency -> dependents)
+	// Build adjacency list (dependency -> dependents). Optional requires and
+	// requires_any only order checks that are present.
 	dependents := make(map[string][]string)
 	for _, check := range checks {
 		for _, dep := range check.Requires {
 			dependents[dep] = append(dependents[dep], check.ID)
 			inDegree[check.ID]++
 		}
+		for _, dep := range slices.Concat(check.OptionalRequires, check.RequiresAny) {
+			if _, ok := checkByID[dep]; ok {
+				dependents[dep] = append(dependents[dep], check.ID)
+				inDegree[check.ID]++
+			}
+		}
 	}
 
 	// Process levels
@@ -75,6 +75,36 @@ func TestBuildGraph_LinearDependencyChain(t *testing.T) {
 	}
 }
 
+func TestBuildGraph_OptionalRequires(t *testing.T) {
+	checks := []config.Check{
+		{ID: "deploy", Run: "echo deploy", OptionalRequires: []string{"test", "absent"}},
+		{ID: "test", Run: "echo test"},
+	}
+
+	graph, err := BuildGraph(checks)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Present optional requires order the check; absent ones are ignored
+	want := [][]string{{"test"}, {"deploy"}}
+	if levels := graph.Levels(); !reflect.DeepEqual(levels, want) {
+		t.Errorf("expected levels %v, got %v", want, levels)
+	}
+}
+
+func TestBuildGraph_OptionalRequiresCycle(t *testing.T) {
+	checks := []config.Check{
+		{ID: "a", Run: "echo a", Requires: []string{"b"}},
+		{ID: "b", Run: "echo b", OptionalRequires: []string{"a"}},
+	}
+
+	_, err := BuildGraph(checks)
+	if err == nil || err.Error() != "cyclic dependency detected: a -> b -> a" {
+		t.Errorf("expected cycle through optional_requires, got: %v", err)
+	}
+}
+
 func TestBuildGraph_DiamondDependency(t *testing.T) {
 	// Diamond: d depends on b and c, both b and c depend on a
 	//     a
@@ -241,7 +271,7 @@ func TestBuildGraph_UnknownDependency_ReturnsError(t *testing.T) {
 
 func TestBuildGraph_CyclicDependency_TwoNodes(t *testing.T) {
 	// Note: In practice, config validation catches cycles first.
-	// This tests BuildGraph's own cycle detection as a fallback.
+	// This tests BuildGraph's own cycle detection for checks built in code.
 	checks := []config.Check{
 		{ID: "a", Run: "echo a", Requires: []string{"b"}},
 		{ID: "b", Run: "echo b", Requires: []string{"a"}},
@@ -252,9 +282,9 @@ func TestBuildGraph_CyclicDependency_TwoNodes(t *testing.T) {
 		t.Fatal("expected error for cyclic dependency, got nil")
 	}
 
-	// Error should mention circular/cyclic dependency
-	if err.Error() == "" {
-		t.Error("expected non-empty error message")
+	// Error should name the full cycle
+	if want := "cyclic dependency detected: a -> b -> a"; err.Error() != want {
+		t.Errorf("expected error %q, got %q", want, err.Error())
 	}
 }
 
@@ -270,6 +300,9 @@ func TestBuildGraph_CyclicDependency_ThreeNodes(t *testing.T) {
 	if err == nil {
 		t.Fatal("expected error for cyclic dependency, got nil")
 	}
+	if want := "cyclic dependency detected: a -> c -> b -> a"; err.Error() != want {
+		t.Errorf("expected error %q, got %q", want, err.Error())
+	}
 }
 
 func TestBuildGraph_ComplexGraph(t *testing.T) {
@@ -0,0 +1,44 @@
+package orchestrator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// recordGrokMisses stores the grok patterns of a grok_required check that
+// matched nothing in its output, for its violation. Nil forgets the misses of
+// an earlier evaluation, such as the one before a fix.
+func (o *Orchestrator) recordGrokMisses(checkID string, unmatched []string) {
+	o.capturesMu.Lock()
+	defer o.capturesMu.Unlock()
+	if o.grokMisses == nil {
+		o.grokMisses = make(map[string][]string)
+	}
+	if len(unmatched) == 0 {
+		delete(o.grokMisses, checkID)
+		return
+	}
+	o.grokMisses[checkID] = unmatched
+}
+
+// grokMissesFor returns the grok patterns that failed check by matching
+// nothing, or nil if it didn't fail for that.
+func (o *Orchestrator) grokMissesFor(checkID string) []string {
+	o.capturesMu.Lock()
+	defer o.capturesMu.Unlock()
+	return o.grokMisses[checkID]
+}
+
+// grokMissReason returns the suggestion of a check whose required grok
+// patterns matched nothing.
+func grokMissReason(unmatched []string) string {
+	quoted := make([]string, len(unmatched))
+	for i, pattern := range unmatched {
+		quoted[i] = fmt.Sprintf("%q", pattern)
+	}
+	noun := "pattern"
+	if len(unmatched) > 1 {
+		noun = "patterns"
+	}
+	return fmt.Sprintf("Required grok %s %s matched nothing in the output; the tool's output format may have changed", noun, strings.Join(quoted, ", "))
+}
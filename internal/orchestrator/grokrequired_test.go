@@ -0,0 +1,131 @@
+//go:build !windows
+
+package orchestrator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+func TestRun_GrokRequired(t *testing.T) {
+	const pattern = `coverage: %{NUMBER:coverage}% of statements`
+	tests := []struct {
+		name           string
+		run            string
+		required       bool
+		assert         string
+		wantPassed     bool
+		wantUnmatched  []string
+		wantSuggestion string
+	}{
+		{
+			name:           "required pattern matches nothing",
+			run:            "echo 'total: (statements) 91.2%'",
+			required:       true,
+			assert:         "coverage >= 80",
+			wantUnmatched:  []string{pattern},
+			wantSuggestion: `Required grok pattern "coverage: %{NUMBER:coverage}% of statements" matched nothing in the output; the tool's output format may have changed. Raise coverage to 80%`,
+		},
+		{
+			name:       "required pattern matches",
+			run:        "echo 'coverage: 91.2% of statements'",
+			required:   true,
+			assert:     "coverage >= 80",
+			wantPassed: true,
+		},
+		{
+			name:           "failing command decides before the patterns",
+			run:            "echo 'build failed'; exit 1",
+			required:       true,
+			wantSuggestion: "Raise coverage to 80%",
+		},
+		{
+			name:           "lenient pattern matches nothing before an assertion",
+			run:            "echo 'total: (statements) 91.2%'",
+			assert:         "coverage >= 80",
+			wantSuggestion: "Raise coverage to 80%",
+		},
+		{
+			name:       "lenient pattern matches nothing",
+			run:        "echo 'total: (statements) 91.2%'",
+			wantPassed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Version: "1",
+				Checks: []config.Check{
+					{
+						ID:           "coverage",
+						Run:          tt.run,
+						Grok:         []string{pattern},
+						GrokRequired: tt.required,
+						Assert:       tt.assert,
+						Severity:     config.SeverityError,
+						Suggestion:   "Raise coverage to 80%",
+					},
+				},
+			}
+			orch := New(cfg, executor.New(""), 1, false, false, t.TempDir(), 1)
+			result, err := orch.Run(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Results[0].Passed != tt.wantPassed {
+				t.Fatalf("expected passed=%v, got %v", tt.wantPassed, result.Results[0].Passed)
+			}
+			if tt.wantPassed {
+				if len(result.Violations) != 0 {
+					t.Errorf("expected no violations, got %+v", result.Violations)
+				}
+				return
+			}
+			if len(result.Violations) != 1 {
+				t.Fatalf("expected 1 violation, got %d", len(result.Violations))
+			}
+			v := result.Violations[0]
+			if !reflect.DeepEqual(v.GrokUnmatched, tt.wantUnmatched) {
+				t.Errorf("expected unmatched patterns %v, got %v", tt.wantUnmatched, v.GrokUnmatched)
+			}
+			if v.Suggestion != tt.wantSuggestion {
+				t.Errorf("expected suggestion %q, got %q", tt.wantSuggestion, v.Suggestion)
+			}
+		})
+	}
+}
+
+func TestRunCheck_GrokRequired(t *testing.T) {
+	cfg := &config.Config{
+		Version: "1",
+		Checks: []config.Check{
+			{
+				ID:           "tests",
+				Run:          "echo 'ok  ./internal/config'",
+				Grok:         []string{`%{INT:passed} passed`, `%{INT:failed} failed`},
+				GrokRequired: true,
+				Assert:       "failed == 0",
+				Severity:     config.SeverityError,
+			},
+		},
+	}
+	orch := New(cfg, executor.New(""), 1, false, false, t.TempDir(), 1)
+	result, err := orch.RunCheck(context.Background(), "tests")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d", len(result.Violations))
+	}
+	v := result.Violations[0]
+	if want := []string{`%{INT:passed} passed`, `%{INT:failed} failed`}; !reflect.DeepEqual(v.GrokUnmatched, want) {
+		t.Errorf("expected unmatched patterns %v, got %v", want, v.GrokUnmatched)
+	}
+	if want := `Required grok patterns "%{INT:passed} passed", "%{INT:failed} failed" matched nothing in the output; the tool's output format may have changed`; v.Suggestion != want {
+		t.Errorf("expected suggestion %q, got %q", want, v.Suggestion)
+	}
+}
@@ -0,0 +1,94 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+// HookFailure describes an after hook command that failed.
+type HookFailure struct {
+	Command  string
+	ExitCode int
+	Output   string
+	Err      error // Set if the command could not be run at all
+}
+
+// String describes the failure in one line.
+func (f *HookFailure) String() string {
+	if f.Err != nil {
+		return fmt.Sprintf("after hook %q failed: %v", f.Command, f.Err)
+	}
+	return fmt.Sprintf("after hook %q failed with exit code %d", f.Command, f.ExitCode)
+}
+
+// runHook runs a before or after hook command through the config's shell,
+// masking the top-level redact patterns in its output.
+func (o *Orchestrator) runHook(ctx context.Context, name, command string) (*executor.Result, error) {
+	return o.executor.ExecuteWithOptions(ctx, name, command, executor.Options{Shell: o.config.Shell, Redact: o.config.Redactions(nil)})
+}
+
+// runBeforeHooks runs the config's before commands in order, stopping at the
+// first one that fails.
+func (o *Orchestrator) runBeforeHooks(ctx context.Context) error {
+	for _, command := range o.config.Before {
+		res, err := o.runHook(ctx, "before", command)
+		if err != nil {
+			return fmt.Errorf("before hook %q failed: %w", command, err)
+		}
+		if !res.Success {
+			msg := fmt.Sprintf("before hook %q failed with exit code %d; no checks were run", command, res.ExitCode)
+			if out := strings.TrimSpace(res.Combined); out != "" {
+				msg += "\n" + out
+			}
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+// runAfterHooks runs every one of the config's after commands in order, even
+// if an earlier one fails or ctx is cancelled, and returns their failures.
+func (o *Orchestrator) runAfterHooks(ctx context.Context) []*HookFailure {
+	ctx = context.WithoutCancel(ctx)
+	var failures []*HookFailure
+	for _, command := range o.config.After {
+		res, err := o.runHook(ctx, "after", command)
+		if err != nil {
+			failures = append(failures, &HookFailure{Command: command, Err: err})
+			continue
+		}
+		if !res.Success {
+			failures = append(failures, &HookFailure{
+				Command:  command,
+				ExitCode: res.ExitCode,
+				Output:   res.Combined,
+			})
+		}
+	}
+	return failures
+}
+
+// finishAfterHooks runs the after hooks at the end of a run, however it
+// ended. Failures are recorded on *result without changing its exit code, or
+// appended to *err when the run failed without a result.
+func (o *Orchestrator) finishAfterHooks(ctx context.Context, result **RunResult, err *error) {
+	failures := o.runAfterHooks(ctx)
+	if len(failures) == 0 {
+		return
+	}
+	if *result != nil {
+		(*result).HookFailures = failures
+		return
+	}
+	if *err != nil {
+		msgs := make([]string, len(failures))
+		for i, f := range failures {
+			msgs[i] = f.String()
+		}
+		*err = fmt.Errorf("%w\n%s", *err, strings.Join(msgs, "\n"))
+	}
+}
@@ -0,0 +1,130 @@
+//go:build !windows
+
+package orchestrator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+// hookConfig returns a config whose hooks and checks append their names to
+// the returned log file, in the order they run.
+func hookConfig(t *testing.T, checkRun string) (*config.Config, string) {
+	t.Helper()
+	log := filepath.Join(t.TempDir(), "order")
+	return &config.Config{
+		Version: "1",
+		Before:  []string{"echo before-1 >> " + log, "echo before-2 >> " + log},
+		After:   []string{"echo after-1 >> " + log, "echo after-2 >> " + log},
+		Checks: []config.Check{
+			{ID: "a", Run: "echo a >> " + log + "; " + checkRun, Severity: config.SeverityError},
+			{ID: "b", Run: "echo b >> " + log, Severity: config.SeverityError, Requires: []string{"a"}},
+		},
+	}, log
+}
+
+func readLog(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read log: %v", err)
+	}
+	return string(data)
+}
+
+func TestRun_Hooks_Ordering(t *testing.T) {
+	cfg, log := hookConfig(t, "true")
+	orch := New(cfg, executor.New(""), 4, false, false, t.TempDir(), 1)
+
+	result, err := orch.Run(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := readLog(t, log), "before-1\nbefore-2\na\nb\nafter-1\nafter-2\n"; got != want {
+		t.Errorf("expected order %q, got %q", want, got)
+	}
+	if len(result.HookFailures) != 0 {
+		t.Errorf("expected no hook failures, got %v", result.HookFailures)
+	}
+}
+
+func TestRun_Hooks_AfterRunsWhenChecksFail(t *testing.T) {
+	cfg, log := hookConfig(t, "exit 1")
+	orch := New(cfg, executor.New(""), 4, true, false, t.TempDir(), 1) // fail-fast
+
+	result, err := orch.Run(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ExitCode != 1 {
+		t.Errorf("expected exit code 1, got %d", result.ExitCode)
+	}
+	if got, want := readLog(t, log), "before-1\nbefore-2\na\nafter-1\nafter-2\n"; got != want {
+		t.Errorf("expected order %q, got %q", want, got)
+	}
+}
+
+func TestRun_Hooks_BeforeFailureAbortsRun(t *testing.T) {
+	cfg, log := hookConfig(t, "true")
+	cfg.Before[0] = "echo database unavailable; exit 3"
+	orch := New(cfg, executor.New(""), 4, false, false, t.TempDir(), 1)
+
+	result, err := orch.Run(context.Background())
+	if err == nil {
+		t.Fatalf("expected error, got result %+v", result)
+	}
+	for _, want := range []string{`before hook "echo database unavailable; exit 3" failed with exit code 3`, "database unavailable"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to contain %q, got: %v", want, err)
+		}
+	}
+
+	// No checks or later before hooks ran, but the after hooks did
+	if got, want := readLog(t, log), "after-1\nafter-2\n"; got != want {
+		t.Errorf("expected order %q, got %q", want, got)
+	}
+}
+
+func TestRun_Hooks_AfterFailureReported(t *testing.T) {
+	cfg, log := hookConfig(t, "true")
+	cfg.After[0] = "echo teardown failed; exit 2"
+	orch := New(cfg, executor.New(""), 4, false, false, t.TempDir(), 1)
+
+	result, err := orch.Run(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The failure is reported, later after hooks still run, and the checks'
+	// result is unchanged
+	if result.ExitCode != 0 {
+		t.Errorf("expected exit code 0, got %d", result.ExitCode)
+	}
+	if len(result.HookFailures) != 1 {
+		t.Fatalf("expected 1 hook failure, got %d", len(result.HookFailures))
+	}
+	if h := result.HookFailures[0]; h.ExitCode != 2 || !strings.Contains(h.Output, "teardown failed") {
+		t.Errorf("unexpected hook failure: %+v", h)
+	}
+	if got := readLog(t, log); !strings.HasSuffix(got, "b\nafter-2\n") {
+		t.Errorf("expected the second after hook to run, got %q", got)
+	}
+}
+
+func TestRunCheck_Hooks(t *testing.T) {
+	cfg, log := hookConfig(t, "true")
+	orch := New(cfg, executor.New(""), 4, false, false, t.TempDir(), 1)
+
+	if _, err := orch.RunCheck(context.Background(), "b"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := readLog(t, log), "before-1\nbefore-2\nb\nafter-1\nafter-2\n"; got != want {
+		t.Errorf("expected order %q, got %q", want, got)
+	}
+}
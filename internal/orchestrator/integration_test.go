@@ -1,3 +1,5 @@
+//go:build !windows
+
 // Package orchestrator provides integration tests for real tool execution
 package orchestrator
 
@@ -5,17 +5,22 @@ package orchestrator
 import (
 	"context"
 	"fmt"
+	"io"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
-	"golang.org/x/sync/errgroup"
-
 	"github.com/vibeguard/vibeguard/internal/assert"
+	"github.com/vibeguard/vibeguard/internal/cache"
 	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/coverage"
 	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/glob"
 	"github.com/vibeguard/vibeguard/internal/grok"
 )
 
@@ -26,15 +31,29 @@ type CheckResult struct {
 	Passed           bool
 	Extracted        map[string]string // Values extracted via grok patterns
 	TriggeredPrompts []*TriggeredPrompt
+	Skipped          bool          // True if the check did not run
+	PathSkipped      bool          // True if skipped because no changed file matched its paths (not a violation)
+	SkipReason       string        // Why the check was skipped
+	FixAttempted     bool          // True if the check failed and its fix command was run
+	Fixed            bool          // True if the check passed when re-run after its fix command
+	Level            int           // Dependency level the check ran in, from 0; checks in one level run in parallel
+	Timeout          time.Duration // Effective timeout the check ran under, after --timeout overrides; 0 if none
 }
 
+// pathSkipReason is the SkipReason for checks skipped by SetChangedFiles.
+const pathSkipReason = "Skipped: no changed files match paths"
+
 // RunResult contains the complete results of running all checks.
 type RunResult struct {
+	Name              string // The config's DisplayName, labelling the run in reports
+	Description       string // The config's description
+	Provenance        Provenance
 	Results           []*CheckResult
 	Violations        []*Violation
 	Duration          time.Duration
 	ExitCode          int
-	FailFastTriggered bool // True if execution was stopped early due to fail-fast
+	FailFastTriggered bool           // True if execution was stopped early due to fail-fast
+	HookFailures      []*HookFailure // After hooks that failed; they don't affect ExitCode
 }
 
 // TriggeredPrompt represents a prompt that was triggered by a check result.
@@ -47,6 +66,7 @@ type TriggeredPrompt struct {
 // Violation represents a check failure.
 type Violation struct {
 	CheckID          string
+	Description      string // The check's description, if it has one
 	Severity         config.Severity
 	Command          string
 	Suggestion       string
@@ -55,24 +75,82 @@ type Violation struct {
 	Timedout         bool
 	LogFile          string // Path to log file containing check output
 	TriggeredPrompts []*TriggeredPrompt
+	FixAttempted     bool                // True if the fix command ran but the check still fails
+	AllowFailure     bool                // True if the check's allow_failure excludes it from the exit code
+	Known            bool                // True if the violation is in the baseline, which excludes it from the exit code
+	Explanation      *FailureExplanation // Why the check failed, with SetExplainFailures (nil otherwise)
+	GrokUnmatched    []string            // Patterns of a grok_required check that matched nothing, which failed it
+	ConfigLine       int                 // Line of the check in the config file, 0 if unknown
 }
 
 // TagFilter specifies which checks to include/exclude based on tags.
 type TagFilter struct {
-	Include []string // Run checks matching ANY of these tags (OR logic)
-	Exclude []string // Exclude checks matching ANY of these tags (OR logic)
+	Include      []string       // Run checks matching ANY of these tags (OR logic)
+	Exclude      []string       // Exclude checks matching ANY of these tags (OR logic)
+	Dependencies DependencyMode // How to handle required checks the tags filter out
+}
+
+// DependencyMode controls what happens when a check selected by the tag
+// filter requires a check the filter removed.
+type DependencyMode int
+
+const (
+	// DependenciesSkip skips the dependent check with a warning.
+	DependenciesSkip DependencyMode = iota
+	// DependenciesInclude runs the required checks even though their tags
+	// do not match.
+	DependenciesInclude
+	// DependenciesStrict fails the run before any check executes.
+	DependenciesStrict
+)
+
+// TimeoutOverride replaces the configured timeouts of checks.
+type TimeoutOverride struct {
+	Default time.Duration            // Timeout for every check without a per-check override (0 = keep config)
+	Checks  map[string]time.Duration // Timeouts by check ID; take precedence over Default
 }
 
+// FailFastMode controls what --fail-fast does with the checks still running
+// when an error-severity check fails.
+type FailFastMode string
+
+// Fail-fast modes
+const (
+	// FailFastLevel lets the running checks of the level finish, then stops.
+	FailFastLevel FailFastMode = "level"
+	// FailFastCancel cancels the running checks of the level immediately.
+	FailFastCancel FailFastMode = "cancel"
+)
+
 // Orchestrator coordinates check execution.
 type Orchestrator struct {
-	executor      *executor.Executor
-	config        *config.Config
-	maxParallel   int
-	failFast      bool
-	verbose       bool
-	logDir        string // Directory for check output logs
-	errorExitCode int    // Configurable exit code for failures (default: 1)
-	tagFilter     *TagFilter
+	executor         *executor.Executor
+	config           *config.Config
+	maxParallel      int
+	failFast         bool
+	failFastMode     FailFastMode
+	verbose          bool
+	logDir           string // Directory for check output logs
+	errorExitCode    int    // Configurable exit code for failures (default: 1)
+	tagFilter        *TagFilter
+	onlyTouching     string   // Path prefix restricting checks by their paths globs
+	changedFiles     []string // Changed files restricting checks by their paths globs (nil = no filter)
+	selectedChecks   []string // Check IDs to run with their transitive requires (nil = all)
+	timeouts         TimeoutOverride
+	severities       map[string]config.Severity // Severities by check ID, replacing the configured ones
+	warningsAsErrors bool                       // Warning-severity violations fail the run
+	failUnder        float64                    // Minimum captured coverage of every check (0 = disabled)
+	cache            *cache.Cache               // Replays passing results of unchanged checks (nil = disabled)
+	streamer         *executor.LineStreamer
+	progress         Progress   // Receives check start and finish events (nil = disabled)
+	autoFix          bool       // Run fix commands for failing checks and re-run them
+	explainFailures  bool       // Attach a FailureExplanation to violations of checks that ran
+	pipeline         bool       // Start checks as their dependencies finish instead of level by level
+	provenance       Provenance // Reported with every RunResult
+	fixMu            sync.Mutex // Serializes fix commands
+	capturesMu       sync.Mutex
+	captures         map[string]map[string]string // Values extracted by the checks run so far, by check ID
+	grokMisses       map[string][]string          // Required grok patterns that matched nothing, by check ID
 }
 
 // DefaultLogDir is the default directory for check output logs.
@@ -88,11 +166,130 @@ func WithErrorExitCode(code int) Option {
 	}
 }
 
+// SetFailFastMode sets what fail-fast does with running checks when it
+// triggers. It has no effect unless fail-fast is enabled; the default is
+// FailFastLevel.
+func (o *Orchestrator) SetFailFastMode(mode FailFastMode) {
+	o.failFastMode = mode
+}
+
 // SetTagFilter sets the tag filter for selective check execution.
 func (o *Orchestrator) SetTagFilter(filter TagFilter) {
 	o.tagFilter = &filter
 }
 
+// SetOnlyTouching restricts execution to checks whose paths globs intersect the
+// given path prefix, plus the checks they require. Checks without paths are not
+// selected unless another selected check requires them.
+func (o *Orchestrator) SetOnlyTouching(prefix string) {
+	o.onlyTouching = prefix
+}
+
+// SetChangedFiles restricts execution to checks affected by the given files,
+// plus the checks they require. A check is affected when any file matches one
+// of its paths globs; checks without paths are always affected. A check with
+// scope changed must also have changed packages, which replace its
+// {{.packages}}. Passing nil removes the restriction.
+func (o *Orchestrator) SetChangedFiles(files []string) {
+	o.changedFiles = files
+}
+
+// SetSelectedChecks restricts execution to the checks with the given IDs,
+// plus the checks they transitively require. Unknown IDs make Run and Plan
+// fail. Passing nil removes the restriction.
+func (o *Orchestrator) SetSelectedChecks(ids []string) {
+	o.selectedChecks = ids
+}
+
+// SetTimeoutOverride overrides the configured check timeouts. A per-check
+// override wins over the default override, which wins over the config.
+func (o *Orchestrator) SetTimeoutOverride(override TimeoutOverride) {
+	o.timeouts = override
+}
+
+// SetSeverityOverride replaces the configured severities of the checks in
+// override, keyed by check ID. It takes precedence over the config's
+// severity_overrides.
+func (o *Orchestrator) SetSeverityOverride(override map[string]config.Severity) {
+	o.severities = override
+}
+
+// severityFor returns the effective severity of check: the --severity
+// override, then the config's severity_overrides, then its own severity.
+func (o *Orchestrator) severityFor(check *config.Check) config.Severity {
+	if s, ok := o.severities[check.ID]; ok {
+		return s
+	}
+	return o.config.SeverityOf(check)
+}
+
+// checksWithSeverities returns the configured checks with their effective
+// severities. Overridden checks are copies, so the config is left unchanged.
+func (o *Orchestrator) checksWithSeverities() []config.Check {
+	if len(o.severities) == 0 && len(o.config.SeverityOverrides) == 0 {
+		return o.config.Checks
+	}
+	checks := make([]config.Check, len(o.config.Checks))
+	for i := range o.config.Checks {
+		checks[i] = o.config.Checks[i]
+		checks[i].Severity = o.severityFor(&checks[i])
+	}
+	return checks
+}
+
+// timeoutFor returns the effective timeout of check.
+func (o *Orchestrator) timeoutFor(check *config.Check) time.Duration {
+	if d, ok := o.timeouts.Checks[check.ID]; ok && d > 0 {
+		return d
+	}
+	if o.timeouts.Default > 0 {
+		return o.timeouts.Default
+	}
+	return check.Timeout.AsDuration()
+}
+
+// SetWarningsAsErrors makes warning-severity violations fail the run with the
+// error exit code, like error-severity violations.
+func (o *Orchestrator) SetWarningsAsErrors(enabled bool) {
+	o.warningsAsErrors = enabled
+}
+
+// SetCache enables result caching: a check whose command and covered files
+// are unchanged since it last passed is not run again, and its cached result
+// is reported instead. Passing nil disables caching.
+func (o *Orchestrator) SetCache(c *cache.Cache) {
+	o.cache = c
+}
+
+// SetAutoFix enables running a failed check's fix command and re-running the
+// check once. Checks without a fix command are unaffected.
+func (o *Orchestrator) SetAutoFix(enabled bool) {
+	o.autoFix = enabled
+}
+
+// SetStreamOutput streams each check's stdout and stderr to w while it runs,
+// one line at a time prefixed with the check ID. Output is still captured for
+// grok extraction, assertions and logs.
+func (o *Orchestrator) SetStreamOutput(w io.Writer) {
+	if w == nil {
+		o.streamer = nil
+		return
+	}
+	o.streamer = executor.NewLineStreamer(w)
+}
+
+// execOptions returns the executor options for running a check.
+func (o *Orchestrator) execOptions(check *config.Check) executor.Options {
+	return executor.Options{
+		Stream:         o.streamer,
+		Shell:          check.Shell,
+		KillGrace:      check.KillGrace.AsDuration(),
+		KillSignal:     check.KillSignal,
+		MaxOutputBytes: check.MaxOutputBytes,
+		Redact:         o.config.Redactions(check),
+	}
+}
+
 // New creates a new Orchestrator.
 func New(cfg *config.Config, exec *executor.Executor, maxParallel int, failFast, verbose bool, logDir string, errorExitCode int) *Orchestrator {
 	if maxParallel <= 0 {
@@ -116,41 +313,144 @@ func New(cfg *config.Config, exec *executor.Executor, maxParallel int, failFast,
 }
 
 // getAnalysisOutput returns the content to analyze for grok patterns and assertions.
-// If the check specifies a file field, it reads from that file.
-// Otherwise, it returns the command output.
+// If the check specifies a file field, it reads from that file, which is read
+// after the command, if any, has run so the command can produce it (e.g. a
+// coverage report). Otherwise, it returns the command output selected by
+// capture.
 func (o *Orchestrator) getAnalysisOutput(check *config.Check, execResult *executor.Result) (string, error) {
 	if check.File != "" {
 		// Interpolate variables in the file path
 		filePath := o.interpolatePath(check.File)
-
-		// Validate that the file path doesn't escape the current directory (prevent directory traversal)
-		absPath, err := filepath.Abs(filePath)
+		absPath, err := o.resolveWorkPath(filePath)
 		if err != nil {
-			return "", fmt.Errorf("failed to resolve file path %q: %w", filePath, err)
+			return "", err
 		}
 
-		wd, err := os.Getwd()
-		if err != nil {
-			return "", fmt.Errorf("failed to get working directory: %w", err)
+		content, err := os.ReadFile(absPath) // #nosec G304 - path is validated to be within working directory
+		if os.IsNotExist(err) && check.Run == "" {
+			return "", fmt.Errorf("file %q does not exist: %w", filePath, err)
+		}
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("file %q does not exist after running %q: %w", filePath, check.Run, err)
 		}
-		absWd, err := filepath.Abs(wd)
 		if err != nil {
-			return "", fmt.Errorf("failed to resolve working directory: %w", err)
+			return "", fmt.Errorf("failed to read file %q: %w", filePath, err)
 		}
+		return string(content), nil
+	}
+	switch check.Capture {
+	case config.CaptureStdout:
+		return execResult.Stdout, nil
+	case config.CaptureStderr:
+		return execResult.Stderr, nil
+	default:
+		return execResult.Combined, nil
+	}
+}
 
-		// Ensure the resolved path is within the working directory
-		relPath, err := filepath.Rel(absWd, absPath)
-		if err != nil || strings.HasPrefix(relPath, "..") {
-			return "", fmt.Errorf("file path %q is outside the working directory", filePath)
+// resolveWorkPath returns the absolute path of a file a check reads.
+// Relative paths resolve against the config's directory, where the command
+// ran, and paths outside it are rejected.
+func (o *Orchestrator) resolveWorkPath(filePath string) (string, error) {
+	wd := o.config.Dir()
+	if wd == "" {
+		var err error
+		if wd, err = os.Getwd(); err != nil {
+			return "", fmt.Errorf("failed to get working directory: %w", err)
 		}
+	}
+	absWd, err := filepath.Abs(wd)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve working directory: %w", err)
+	}
 
-		content, err := os.ReadFile(absPath) // #nosec G304 - path is validated to be within working directory
-		if err != nil {
-			return "", fmt.Errorf("failed to read file %q: %w", filePath, err)
+	// Validate that the file path doesn't escape the working directory (prevent directory traversal)
+	absPath := filepath.Clean(filePath)
+	if !filepath.IsAbs(absPath) {
+		absPath = filepath.Join(absWd, absPath)
+	}
+
+	// Ensure the resolved path is within the working directory
+	relPath, err := filepath.Rel(absWd, absPath)
+	if err != nil || strings.HasPrefix(relPath, "..") {
+		return "", fmt.Errorf("file path %q is outside the working directory", filePath)
+	}
+	return absPath, nil
+}
+
+// readCoverage returns the coverage percentage in the check's coverage
+// report.
+func (o *Orchestrator) readCoverage(check *config.Check) (float64, error) {
+	path, err := o.resolveWorkPath(o.interpolatePath(check.Coverage.ReportFile()))
+	if err != nil {
+		return 0, err
+	}
+	report, err := os.ReadFile(path) // #nosec G304 - path is validated to be within working directory
+	if err != nil {
+		return 0, err
+	}
+	return coverage.Parse(check.Coverage.Format, report)
+}
+
+// assertVars returns the variables available to a check's assertion: the
+// values extracted by grok, the command output as stdout, stderr and output
+// (combined), and the command's exit code, timeout flag and duration.
+// Extracted values shadow the output variables; config validation keeps them
+// from using the names of the others.
+func assertVars(extracted map[string]string, execResult *executor.Result) map[string]string {
+	vars := map[string]string{
+		"stdout": execResult.Stdout,
+		"stderr": execResult.Stderr,
+		"output": execResult.Combined,
+	}
+	for k, v := range extracted {
+		vars[k] = v
+	}
+	timedout := "0"
+	if execResult.Timedout {
+		timedout = "1"
+	}
+	vars[config.AssertVarExitCode] = strconv.Itoa(execResult.ExitCode)
+	vars[config.AssertVarTimedout] = timedout
+	vars[config.AssertVarDurationMS] = strconv.FormatInt(execResult.Duration.Milliseconds(), 10)
+	return vars
+}
+
+// violationSuggestion returns the suggestion of a failed check's violation: a
+// timeout notice if it timed out, and otherwise its suggestion rendered with
+// the values it extracted.
+func (o *Orchestrator) violationSuggestion(check *config.Check, execResult *executor.Result, extracted map[string]string) string {
+	if execResult.Timedout {
+		return "Check timed out. Consider increasing the timeout value or optimizing the command."
+	}
+	if unmatched := o.grokMissesFor(check.ID); len(unmatched) > 0 {
+		reason := grokMissReason(unmatched)
+		if suggestion := o.renderTemplate(check.Suggestion, extracted); suggestion != "" {
+			reason += ". " + suggestion
 		}
-		return string(content), nil
+		return reason
 	}
-	return execResult.Combined, nil
+	return o.renderTemplate(check.Suggestion, extracted)
+}
+
+// renderTemplate renders a check's suggestion or fix as a template over the
+// config vars and the values the check extracted, so violations carry the
+// text every output format shows. Vars take precedence over extracted values
+// of the same name.
+func (o *Orchestrator) renderTemplate(s string, extracted map[string]string) string {
+	return config.InterpolateWithExtracted(s, o.config.Vars, extracted)
+}
+
+// assertDecidesOutcome reports whether an assertion references exit_code or
+// timedout. Such an assertion is evaluated even when the command fails or
+// times out, and its result alone decides whether the check passes.
+func assertDecidesOutcome(expr string) bool {
+	names, err := assert.Variables(expr)
+	if err != nil {
+		// The parse error is reported when the assertion is evaluated
+		return false
+	}
+	return slices.Contains(names, config.AssertVarExitCode) || slices.Contains(names, config.AssertVarTimedout)
 }
 
 // interpolatePath performs variable substitution on a file path.
@@ -222,12 +522,207 @@ func (o *Orchestrator) filterChecksByTags(checks []config.Check) ([]config.Check
 	return filtered, excluded
 }
 
-// Run executes all checks and returns the results.
-func (o *Orchestrator) Run(ctx context.Context) (*RunResult, error) {
-	start := time.Now()
+// resolveTagFilteredDependencies applies the tag filter's DependencyMode to
+// checks whose requires were excluded by tag. With DependenciesSkip the
+// checks are returned unchanged and skipped later in Run.
+func (o *Orchestrator) resolveTagFilteredDependencies(checks []config.Check, excluded map[string]bool) ([]config.Check, map[string]bool, error) {
+	if o.tagFilter == nil || len(excluded) == 0 {
+		return checks, excluded, nil
+	}
+
+	switch o.tagFilter.Dependencies {
+	case DependenciesInclude:
+		selected := make(map[string]bool, len(checks))
+		for _, check := range checks {
+			selected[check.ID] = true
+		}
+		included := selectWithDependencies(o.config.Checks, selected)
+		remaining := make(map[string]bool, len(excluded))
+		for id := range excluded {
+			remaining[id] = true
+		}
+		for _, check := range included {
+			delete(remaining, check.ID)
+		}
+		return included, remaining, nil
+
+	case DependenciesStrict:
+		for _, check := range checks {
+			for _, dep := range check.Requires {
+				if excluded[dep] {
+					return nil, nil, fmt.Errorf("check %q requires %q, which is excluded by the tag filter", check.ID, dep)
+				}
+			}
+			if len(check.RequiresAny) > 0 && allIn(check.RequiresAny, excluded) {
+				return nil, nil, fmt.Errorf("check %q requires any of %s, which are all excluded by the tag filter", check.ID, strings.Join(check.RequiresAny, ", "))
+			}
+		}
+	}
+
+	return checks, excluded, nil
+}
+
+// filterChecksByPathPrefix keeps checks whose paths globs intersect the
+// onlyTouching prefix, together with their transitive requires.
+func (o *Orchestrator) filterChecksByPathPrefix(checks []config.Check) []config.Check {
+	if o.onlyTouching == "" {
+		return checks
+	}
+
+	selected := make(map[string]bool)
+	for _, check := range checks {
+		for _, pattern := range check.Paths {
+			if glob.IntersectsPrefix(pattern, o.onlyTouching) {
+				selected[check.ID] = true
+				break
+			}
+		}
+	}
+
+	return selectWithDependencies(checks, selected)
+}
+
+// filterChecksBySelection keeps the selected checks together with their
+// transitive requires.
+func (o *Orchestrator) filterChecksBySelection(checks []config.Check) ([]config.Check, error) {
+	if o.selectedChecks == nil {
+		return checks, nil
+	}
+
+	selected := make(map[string]bool)
+	for _, id := range o.selectedChecks {
+		if !slices.ContainsFunc(o.config.Checks, func(c config.Check) bool { return c.ID == id }) {
+			return nil, checkNotFoundError(id, o.config.Checks)
+		}
+		selected[id] = true
+	}
+	return selectWithDependencies(checks, selected), nil
+}
+
+// filterChecksByChangedFiles keeps checks affected by the changed files,
+// together with their transitive requires. The checks left out are returned
+// separately so they can be reported as skipped.
+func (o *Orchestrator) filterChecksByChangedFiles(checks []config.Check) ([]config.Check, []config.Check) {
+	if o.changedFiles == nil {
+		return checks, nil
+	}
+
+	selected := make(map[string]bool)
+	for i := range checks {
+		if !o.matchesChangedFiles(&checks[i]) {
+			continue
+		}
+		if _, ok := o.changedScope(&checks[i]); checks[i].Scope != "" && !ok {
+			continue
+		}
+		selected[checks[i].ID] = true
+	}
+
+	kept := selectWithDependencies(checks, selected)
+	keptIDs := make(map[string]bool, len(kept))
+	for _, check := range kept {
+		keptIDs[check.ID] = true
+	}
+	var skipped []config.Check
+	for _, check := range checks {
+		if !keptIDs[check.ID] {
+			skipped = append(skipped, check)
+		}
+	}
+	return kept, skipped
+}
+
+// matchesChangedFiles reports whether a changed file matches one of check's
+// paths globs, or check has none.
+func (o *Orchestrator) matchesChangedFiles(check *config.Check) bool {
+	if len(check.Paths) == 0 {
+		return true
+	}
+	for _, file := range o.changedFiles {
+		if glob.MatchAny(check.Paths, file) {
+			return true
+		}
+	}
+	return false
+}
+
+// changedSkipReason returns the SkipReason of a check skipped by
+// SetChangedFiles.
+func (o *Orchestrator) changedSkipReason(check *config.Check) string {
+	if check.Scope != "" && o.matchesChangedFiles(check) {
+		return scopeSkipReason
+	}
+	return pathSkipReason
+}
+
+// selectWithDependencies returns the checks in selected plus every check they
+// transitively require or require any of, preserving the original check
+// order.
+func selectWithDependencies(checks []config.Check, selected map[string]bool) []config.Check {
+	checkByID := make(map[string]*config.Check)
+	for i := range checks {
+		checkByID[checks[i].ID] = &checks[i]
+	}
+
+	included := make(map[string]bool)
+	var visit func(id string)
+	visit = func(id string) {
+		if included[id] {
+			return
+		}
+		check, ok := checkByID[id]
+		if !ok {
+			return
+		}
+		included[id] = true
+		for _, dep := range slices.Concat(check.Requires, check.RequiresAny) {
+			visit(dep)
+		}
+	}
+	for _, check := range checks {
+		if selected[check.ID] {
+			visit(check.ID)
+		}
+	}
+
+	result := []config.Check{}
+	for _, check := range checks {
+		if included[check.ID] {
+			result = append(result, check)
+		}
+	}
+	return result
+}
+
+// checkSelection is the set of checks a run executes after filtering, and the
+// checks the filters skipped.
+type checkSelection struct {
+	checks        []config.Check
+	graph         *DependencyGraph
+	excludedByTag map[string]bool
+	checkIDs      map[string]bool // IDs of the checks that run
+	depSkipped    []*config.Check // Checks skipped because a dependency was filtered out
+	pathSkipped   []config.Check  // Checks skipped by the changed-files filter
+}
 
+// selectChecks applies the tag and path filters to the configured checks and
+// builds the dependency graph of the checks that remain.
+func (o *Orchestrator) selectChecks() (*checkSelection, error) {
 	// Apply tag filtering
-	filteredChecks, excludedByTag := o.filterChecksByTags(o.config.Checks)
+	filteredChecks, excludedByTag := o.filterChecksByTags(o.checksWithSeverities())
+	filteredChecks, excludedByTag, err := o.resolveTagFilteredDependencies(filteredChecks, excludedByTag)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply check ID and path filtering (pulls in required dependencies)
+	filteredChecks, err = o.filterChecksBySelection(filteredChecks)
+	if err != nil {
+		return nil, err
+	}
+	filteredChecks = o.filterChecksByPathPrefix(filteredChecks)
+	filteredChecks, pathSkipped := o.filterChecksByChangedFiles(filteredChecks)
+	filteredChecks = o.scopeChecks(filteredChecks)
 
 	// Pre-process filtered checks to identify those with missing dependencies
 	// (dependencies excluded by tag filter, not genuinely unknown)
@@ -267,6 +762,12 @@ func (o *Orchestrator) Run(ctx context.Context) (*RunResult, error) {
 				// which will return a proper error
 			}
 		}
+		// A requires_any check can only run if one of its dependencies does
+		if !hasMissingDep && len(check.RequiresAny) > 0 && !anyIn(check.RequiresAny, checkIDs) {
+			excludedByTag[check.ID] = true
+			hasMissingDep = true
+			skippedChecks = append(skippedChecks, check)
+		}
 		if !hasMissingDep {
 			validChecks = append(validChecks, *check)
 		}
@@ -279,269 +780,161 @@ func (o *Orchestrator) Run(ctx context.Context) (*RunResult, error) {
 		return nil, err
 	}
 
-	// Build lookup maps for checks by ID and index by ID
-	checkByID := make(map[string]*config.Check)
-	checkIndexByID := make(map[string]int)
-	for i := range filteredChecks {
-		checkByID[filteredChecks[i].ID] = &filteredChecks[i]
-		checkIndexByID[filteredChecks[i].ID] = i
-	}
+	return &checkSelection{
+		checks:        filteredChecks,
+		graph:         graph,
+		excludedByTag: excludedByTag,
+		checkIDs:      checkIDs,
+		depSkipped:    skippedChecks,
+		pathSkipped:   pathSkipped,
+	}, nil
+}
 
-	results := make([]*CheckResult, 0, len(o.config.Checks))
-	violations := make([]*Violation, 0)
+// PlannedSkip is a check that a run would skip, with the reason.
+type PlannedSkip struct {
+	Check  *config.Check
+	Reason string
+}
 
-	// Track which checks have passed (for dependency validation)
-	passedChecks := make(map[string]bool)
+// Plan describes what Run would do without executing anything.
+type Plan struct {
+	Levels  [][]*config.Check // Checks that would run, grouped by execution level
+	Skipped []PlannedSkip     // Checks the filters would skip
+}
 
-	// Mutex for thread-safe access to shared state
-	var mu sync.Mutex
-	// Flag to signal fail-fast termination
-	failFastTriggered := false
+// Plan applies the same filtering and dependency resolution as Run and
+// returns the checks it would execute, level by level, without running them.
+func (o *Orchestrator) Plan() (*Plan, error) {
+	sel, err := o.selectChecks()
+	if err != nil {
+		return nil, err
+	}
 
-	// Create a cancellable context for fail-fast cancellation
-	failFastCtx, cancelFailFast := context.WithCancel(ctx)
-	defer cancelFailFast()
+	checkByID := make(map[string]*config.Check, len(sel.checks))
+	for i := range sel.checks {
+		checkByID[sel.checks[i].ID] = &sel.checks[i]
+	}
 
-	// Execute checks level by level (topological order)
-	// Within each level, checks run in parallel (limited by maxParallel)
-	for _, level := range graph.Levels() {
-		// Check if fail-fast was triggered in a previous level
-		if failFastTriggered {
-			break
+	plan := &Plan{}
+	for _, level := range sel.graph.Levels() {
+		checks := make([]*config.Check, 0, len(level))
+		for _, id := range level {
+			checks = append(checks, checkByID[id])
 		}
+		plan.Levels = append(plan.Levels, checks)
+	}
+	for _, check := range sel.depSkipped {
+		plan.Skipped = append(plan.Skipped, PlannedSkip{Check: check, Reason: missingDependencyReason(check, sel.checkIDs)})
+	}
+	for i := range sel.pathSkipped {
+		plan.Skipped = append(plan.Skipped, PlannedSkip{Check: &sel.pathSkipped[i], Reason: o.changedSkipReason(&sel.pathSkipped[i])})
+	}
+	return plan, nil
+}
 
-		// Create results slice for this level to maintain order within level
-		levelResults := make([]*CheckResult, len(level))
-		levelViolations := make([]*Violation, 0)
-
-		// Use errgroup for parallel execution with context cancellation
-		g, gctx := errgroup.WithContext(failFastCtx)
-
-		// Semaphore to limit concurrency
-		sem := make(chan struct{}, o.maxParallel)
-
-		for i, checkID := range level {
-			i, checkID := i, checkID // capture for goroutine
-			check := checkByID[checkID]
-			checkIndex := checkIndexByID[checkID]
-
-			g.Go(func() error {
-				// Acquire semaphore
-				select {
-				case sem <- struct{}{}:
-				case <-gctx.Done():
-					return gctx.Err()
-				}
-				defer func() { <-sem }()
-
-				// Check if fail-fast was triggered by another goroutine
-				mu.Lock()
-				if failFastTriggered {
-					mu.Unlock()
-					return nil
-				}
-				// Verify all dependencies passed and are not excluded by tag filter
-				allDepsPassed := true
-				missingDep := ""
-				for _, depID := range check.Requires {
-					if excludedByTag[depID] {
-						allDepsPassed = false
-						missingDep = depID
-						break
-					}
-					if !passedChecks[depID] {
-						allDepsPassed = false
-						missingDep = depID
-						break
-					}
-				}
-				mu.Unlock()
-
-				// Skip this check if a required dependency failed or is excluded by tag filter
-				if !allDepsPassed {
-					result := &CheckResult{
-						Check:  check,
-						Passed: false,
-						Execution: &executor.Result{
-							CheckID:  checkID,
-							ExitCode: -1,
-							Success:  false,
-						},
-						Extracted: make(map[string]string),
-					}
-
-					var suggestion string
-					if excludedByTag[missingDep] {
-						suggestion = fmt.Sprintf("Skipped: required dependency %q not in filtered set", missingDep)
-					} else {
-						suggestion = "Skipped: required dependency failed"
-					}
-
-					violation := &Violation{
-						CheckID:    check.ID,
-						Severity:   check.Severity,
-						Command:    check.Run,
-						Suggestion: suggestion,
-						Fix:        check.Fix,
-						Extracted:  result.Extracted,
-					}
-
-					mu.Lock()
-					levelResults[i] = result
-					levelViolations = append(levelViolations, violation)
-					mu.Unlock()
-					return nil
-				}
-
-				// Apply timeout
-				checkCtx := gctx
-				var cancel context.CancelFunc
-				if check.Timeout > 0 {
-					checkCtx, cancel = context.WithTimeout(gctx, check.Timeout.AsDuration())
-				}
-
-				// Execute the check
-				execResult, execErr := o.executor.Execute(checkCtx, check.ID, check.Run)
-				if cancel != nil {
-					cancel()
-				}
-				if execErr != nil {
-					// Execution error (not just non-zero exit)
-					return execErr
-				}
-
-				// Write check output to log file (best-effort, don't fail if this fails)
-				_ = o.writeCheckLog(check.ID, execResult.Combined)
-
-				// Get the content to analyze (either from file or command output)
-				analysisOutput, analysisErr := o.getAnalysisOutput(check, execResult)
-				if analysisErr != nil {
-					// Wrap file reading error with check context
-					lineNum := o.config.FindCheckNodeLine(check.ID, checkIndex)
-					return &config.ExecutionError{
-						Message:   analysisErr.Error(),
-						Cause:     analysisErr,
-						CheckID:   check.ID,
-						LineNum:   lineNum,
-						ErrorType: "file",
-					}
-				}
+// PlanCheck returns the plan for running the single check with the given ID,
+// as RunCheck would. Its dependencies are not part of the plan.
+func (o *Orchestrator) PlanCheck(checkID string) (*Plan, error) {
+	for i := range o.config.Checks {
+		if o.config.Checks[i].ID == checkID {
+			check := o.scopeCheck(o.config.Checks[i])
+			return &Plan{Levels: [][]*config.Check{{&check}}}, nil
+		}
+	}
+	return nil, checkNotFoundError(checkID, o.config.Checks)
+}
 
-				// Apply grok patterns to extract values from output
-				extracted := make(map[string]string)
-				if len(check.Grok) > 0 {
-					matcher, matcherErr := grok.New(check.Grok)
-					if matcherErr != nil {
-						// Wrap grok error with check context
-						lineNum := o.config.FindCheckNodeLine(check.ID, checkIndex)
-						return &config.ExecutionError{
-							Message:   "failed to compile grok pattern",
-							Cause:     matcherErr,
-							CheckID:   check.ID,
-							LineNum:   lineNum,
-							ErrorType: "grok",
-						}
-					}
-					extracted, matcherErr = matcher.Match(analysisOutput)
-					if matcherErr != nil {
-						// Wrap grok error with check context
-						lineNum := o.config.FindCheckNodeLine(check.ID, checkIndex)
-						return &config.ExecutionError{
-							Message:   "failed to parse grok pattern",
-							Cause:     matcherErr,
-							CheckID:   check.ID,
-							LineNum:   lineNum,
-							ErrorType: "grok",
-						}
-					}
-				}
+// missingDependencyReason returns the skip reason for a check whose required
+// check, or all of whose requires_any checks, are not among the checks that
+// run.
+func missingDependencyReason(check *config.Check, checkIDs map[string]bool) string {
+	for _, dep := range check.Requires {
+		if !checkIDs[dep] {
+			return fmt.Sprintf("Skipped: required dependency %q not in filtered set", dep)
+		}
+	}
+	return fmt.Sprintf("Skipped: none of requires_any %s in filtered set", strings.Join(check.RequiresAny, ", "))
+}
 
-				// Determine pass/fail based on exit code and assertion (if specified)
-				passed := execResult.Success
-				if passed && check.Assert != "" {
-					evaluator := assert.New()
-					assertPassed, assertErr := evaluator.Eval(check.Assert, extracted)
-					if assertErr != nil {
-						// Wrap assert error with check context
-						lineNum := o.config.FindCheckNodeLine(check.ID, checkIndex)
-						return &config.ExecutionError{
-							Message:   "failed to evaluate assertion",
-							Cause:     assertErr,
-							CheckID:   check.ID,
-							LineNum:   lineNum,
-							ErrorType: "assert",
-						}
-					}
-					passed = assertPassed
-				}
+// anyIn reports whether any of ids is set in set.
+func anyIn(ids []string, set map[string]bool) bool {
+	for _, id := range ids {
+		if set[id] {
+			return true
+		}
+	}
+	return false
+}
 
-				result := &CheckResult{
-					Check:            check,
-					Execution:        execResult,
-					Passed:           passed,
-					Extracted:        extracted,
-					TriggeredPrompts: o.evaluateTriggeredPrompts(check, passed, execResult.Timedout),
-				}
+// allIn reports whether every one of ids is set in set.
+func allIn(ids []string, set map[string]bool) bool {
+	for _, id := range ids {
+		if !set[id] {
+			return false
+		}
+	}
+	return true
+}
 
-				mu.Lock()
-				levelResults[i] = result
-				passedChecks[checkID] = passed
+// Run executes all checks and returns the results.
+func (o *Orchestrator) Run(ctx context.Context) (result *RunResult, err error) {
+	start := time.Now()
 
-				if !passed {
-					suggestion := check.Suggestion
-					if execResult.Timedout {
-						suggestion = "Check timed out. Consider increasing the timeout value or optimizing the command."
-					}
-					violation := &Violation{
-						CheckID:          check.ID,
-						Severity:         check.Severity,
-						Command:          check.Run,
-						Suggestion:       suggestion,
-						Fix:              check.Fix,
-						Extracted:        result.Extracted,
-						Timedout:         execResult.Timedout,
-						LogFile:          filepath.Join(o.logDir, check.ID+".log"),
-						TriggeredPrompts: o.evaluateTriggeredPrompts(check, passed, execResult.Timedout),
-					}
-					levelViolations = append(levelViolations, violation)
+	sel, err := o.selectChecks()
+	if err != nil {
+		return nil, err
+	}
 
-					if o.failFast && check.Severity == config.SeverityError {
-						failFastTriggered = true
-						cancelFailFast() // Cancel in-flight checks
-					}
-				}
-				mu.Unlock()
+	// The after hooks run however the run ends, once the before hooks started
+	defer o.finishAfterHooks(ctx, &result, &err)
+	if err := o.runBeforeHooks(ctx); err != nil {
+		return nil, err
+	}
+	filteredChecks, graph, excludedByTag := sel.checks, sel.graph, sel.excludedByTag
+	checkIDs, skippedChecks, pathSkipped := sel.checkIDs, sel.depSkipped, sel.pathSkipped
+	o.resetCaptures()
+	if o.cache != nil {
+		o.cache.Reset()
+	}
 
-				return nil
-			})
-		}
+	// Build lookup maps for checks by ID and index by ID
+	checkByID := make(map[string]*config.Check)
+	checkIndexByID := make(map[string]int)
+	for i := range filteredChecks {
+		checkByID[filteredChecks[i].ID] = &filteredChecks[i]
+		checkIndexByID[filteredChecks[i].ID] = i
+	}
 
-		// Wait for all goroutines in this level to complete
-		if err := g.Wait(); err != nil {
-			// If fail-fast was triggered, context.Canceled is expected
-			if failFastTriggered && err == context.Canceled {
-				// Continue to collect results from this level
-			} else {
-				return nil, err
-			}
-		}
+	results := make([]*CheckResult, 0, len(o.config.Checks))
+	violations := make([]*Violation, 0)
 
-		// Append level results in order
-		for _, r := range levelResults {
-			if r != nil {
-				results = append(results, r)
-			}
-		}
-		violations = append(violations, levelViolations...)
+	// Create a cancellable context for fail-fast cancellation
+	failFastCtx, cancelFailFast := context.WithCancel(ctx)
+	defer cancelFailFast()
+	st := &runState{
+		passed:         make(map[string]bool),
+		excludedByTag:  excludedByTag,
+		cancelFailFast: cancelFailFast,
+	}
 
-		// If fail-fast was triggered, stop processing further levels
-		if failFastTriggered {
-			break
-		}
+	// Execute checks in topological order, at most maxParallel at once:
+	// level by level, or as their dependencies finish in pipeline mode
+	schedule := o.runLevels
+	if o.pipeline {
+		schedule = o.runPipelined
+	}
+	ranResults, ranViolations, err := schedule(failFastCtx, st, graph.Levels(), checkByID, checkIndexByID)
+	if err != nil {
+		return nil, err
 	}
+	results = append(results, ranResults...)
+	violations = append(violations, ranViolations...)
+	failFastTriggered := st.failFastTriggered
 
 	// Add skipped checks (with missing dependencies) to results and violations
 	for _, check := range skippedChecks {
+		suggestion := missingDependencyReason(check, checkIDs)
+
 		result := &CheckResult{
 			Check:  check,
 			Passed: false,
@@ -550,34 +943,51 @@ func (o *Orchestrator) Run(ctx context.Context) (*RunResult, error) {
 				ExitCode: -1,
 				Success:  false,
 			},
-			Extracted: make(map[string]string),
-		}
-
-		// Find the missing dependency
-		var missingDep string
-		for _, dep := range check.Requires {
-			if !checkIDs[dep] {
-				missingDep = dep
-				break
-			}
+			Extracted:  make(map[string]string),
+			Skipped:    true,
+			SkipReason: suggestion,
 		}
 
 		violation := &Violation{
-			CheckID:    check.ID,
-			Severity:   check.Severity,
-			Command:    check.Run,
-			Suggestion: fmt.Sprintf("Skipped: required dependency %q not in filtered set", missingDep),
-			Fix:        check.Fix,
-			Extracted:  result.Extracted,
+			CheckID:      check.ID,
+			Description:  check.Description,
+			Severity:     check.Severity,
+			Command:      check.Run,
+			Suggestion:   suggestion,
+			Fix:          o.renderTemplate(check.Fix, nil),
+			Extracted:    result.Extracted,
+			AllowFailure: check.AllowFailure,
 		}
 
 		results = append(results, result)
 		violations = append(violations, violation)
 	}
 
+	// Report checks skipped by the changed-files filter. Unlike dependency
+	// skips these are expected, so they produce no violation.
+	for i := range pathSkipped {
+		check := &pathSkipped[i]
+		results = append(results, &CheckResult{
+			Check: check,
+			Execution: &executor.Result{
+				CheckID:  check.ID,
+				ExitCode: -1,
+				Success:  false,
+			},
+			Extracted:   make(map[string]string),
+			Skipped:     true,
+			PathSkipped: true,
+			SkipReason:  o.changedSkipReason(check),
+		})
+	}
+
+	violations = o.applyFailUnder(results, violations)
 	return &RunResult{
+		Name:              o.config.DisplayName(),
+		Description:       o.config.Description,
+		Provenance:        o.provenance,
 		Results:           results,
-		Violations:        violations,
+		Violations:        o.locateViolations(violations),
 		Duration:          time.Since(start),
 		ExitCode:          o.calculateExitCode(violations),
 		FailFastTriggered: failFastTriggered,
@@ -585,70 +995,202 @@ func (o *Orchestrator) Run(ctx context.Context) (*RunResult, error) {
 }
 
 // calculateExitCode determines the exit code based on violations.
-// Exit codes: 0 = success, errorExitCode = failure (both FAIL and TIMEOUT)
 func (o *Orchestrator) calculateExitCode(violations []*Violation) int {
-	hasTimeout := false
-	hasError := false
+	return ExitCode(violations, o.errorExitCode, o.warningsAsErrors)
+}
 
+// ExitCode returns the exit code for a run with the given violations.
+// Timeouts and error-severity violations return errorExitCode, as do
+// warning-severity violations when warningsAsErrors is set. Info-severity
+// violations, even timeouts, never affect the exit code, and neither do
+// violations of checks with allow_failure or known from a baseline. Anything
+// else, including a run with only warnings, returns executor.ExitCodeSuccess.
+func ExitCode(violations []*Violation, errorExitCode int, warningsAsErrors bool) int {
 	for _, v := range violations {
-		if v.Timedout {
-			hasTimeout = true
+		if v.Severity == config.SeverityInfo || v.AllowFailure || v.Known {
+			continue
 		}
-		if v.Severity == config.SeverityError {
-			hasError = true
+		if v.Timedout || v.Severity == config.SeverityError || warningsAsErrors {
+			return errorExitCode
 		}
 	}
-
-	// Both timeout and error use the same configurable exit code
-	if hasTimeout || hasError {
-		return o.errorExitCode
-	}
 	return executor.ExitCodeSuccess
 }
 
 // RunCheck executes a single check by ID.
-func (o *Orchestrator) RunCheck(ctx context.Context, checkID string) (*RunResult, error) {
+func (o *Orchestrator) RunCheck(ctx context.Context, checkID string) (result *RunResult, err error) {
 	start := time.Now()
 
 	// Find the check and its index
 	var check *config.Check
 	var checkIndex int
-	for i := range o.config.Checks {
-		if o.config.Checks[i].ID == checkID {
-			check = &o.config.Checks[i]
+	checks := o.checksWithSeverities()
+	for i := range checks {
+		if checks[i].ID == checkID {
+			scoped := o.scopeCheck(checks[i])
+			check = &scoped
 			checkIndex = i
 			break
 		}
 	}
 	if check == nil {
-		return nil, &config.ConfigError{
-			Message: fmt.Sprintf("check with ID %q not found", checkID),
+		return nil, checkNotFoundError(checkID, o.config.Checks)
+	}
+
+	defer o.finishAfterHooks(ctx, &result, &err)
+	if err := o.runBeforeHooks(ctx); err != nil {
+		return nil, err
+	}
+
+	// The checks it requires don't run, so their values are empty
+	o.resetCaptures()
+	if o.cache != nil {
+		o.cache.Reset()
+	}
+	reason, err := o.gateReason(check, checkIndex)
+	if err != nil {
+		return nil, err
+	}
+	if reason != "" {
+		skipped := &CheckResult{
+			Check:      check,
+			Execution:  &executor.Result{CheckID: check.ID, ExitCode: -1},
+			Extracted:  make(map[string]string),
+			Skipped:    true,
+			SkipReason: reason,
 		}
+		o.checkFinished(skipped)
+		violations := []*Violation{{
+			CheckID:      check.ID,
+			Description:  check.Description,
+			Severity:     check.Severity,
+			Command:      check.Run,
+			Suggestion:   reason,
+			Fix:          o.renderTemplate(check.Fix, nil),
+			Extracted:    skipped.Extracted,
+			AllowFailure: check.AllowFailure,
+		}}
+		return &RunResult{
+			Name:        o.config.DisplayName(),
+			Description: o.config.Description,
+			Provenance:  o.provenance,
+			Results:     []*CheckResult{skipped},
+			Violations:  o.locateViolations(violations),
+			Duration:    time.Since(start),
+			ExitCode:    o.calculateExitCode(violations),
+		}, nil
+	}
+
+	o.checkStarted(check)
+	execResult, extracted, passed, err := o.evaluateCheck(ctx, check, checkIndex)
+	if err != nil {
+		return nil, err
 	}
 
+	fixAttempted := false
+	if !passed && o.shouldFix(check, execResult) {
+		fixAttempted = true
+		execResult, extracted, passed, err = o.fixAndRerun(ctx, check, checkIndex, execResult, extracted)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	checkResult := &CheckResult{
+		Check:            check,
+		Execution:        execResult,
+		Passed:           passed,
+		Extracted:        extracted,
+		TriggeredPrompts: o.evaluateTriggeredPrompts(check, passed, execResult.Timedout),
+		FixAttempted:     fixAttempted,
+		Fixed:            fixAttempted && passed,
+		Timeout:          o.timeoutFor(check),
+	}
+	o.checkFinished(checkResult)
+
+	var violations []*Violation
+	if !passed {
+		violation := &Violation{
+			CheckID:          check.ID,
+			Description:      check.Description,
+			Severity:         check.Severity,
+			Command:          check.Run,
+			Suggestion:       o.violationSuggestion(check, execResult, extracted),
+			Fix:              o.renderTemplate(check.Fix, extracted),
+			Extracted:        checkResult.Extracted,
+			Timedout:         execResult.Timedout,
+			LogFile:          filepath.Join(o.logDir, check.ID+".log"),
+			TriggeredPrompts: checkResult.TriggeredPrompts,
+			FixAttempted:     fixAttempted,
+			AllowFailure:     check.AllowFailure,
+			Explanation:      o.explainFailure(check, execResult, extracted),
+			GrokUnmatched:    o.grokMissesFor(check.ID),
+		}
+		violations = append(violations, violation)
+	}
+	violations = o.applyFailUnder([]*CheckResult{checkResult}, violations)
+
+	return &RunResult{
+		Name:        o.config.DisplayName(),
+		Description: o.config.Description,
+		Provenance:  o.provenance,
+		Results:     []*CheckResult{checkResult},
+		Violations:  o.locateViolations(violations),
+		Duration:    time.Since(start),
+		ExitCode:    o.calculateExitCode(violations),
+	}, nil
+}
+
+// evaluateCheck runs a check's command under its timeout and determines
+// whether it passed from the exit code, grok extraction and assertion.
+func (o *Orchestrator) evaluateCheck(ctx context.Context, check *config.Check, checkIndex int) (*executor.Result, map[string]string, bool, error) {
 	// Apply timeout
 	checkCtx := ctx
-	if check.Timeout > 0 {
+	if timeout := o.timeoutFor(check); timeout > 0 {
 		var cancel context.CancelFunc
-		checkCtx, cancel = context.WithTimeout(ctx, check.Timeout.AsDuration())
+		checkCtx, cancel = context.WithTimeout(ctx, timeout)
 		defer cancel()
 	}
 
-	// Execute the check
-	execResult, err := o.executor.Execute(checkCtx, check.ID, check.Run)
-	if err != nil {
-		return nil, err
+	// Reuse the last passing result if the command and its files are unchanged.
+	// A key that cannot be computed just disables the cache for this check.
+	var cacheKey string
+	var execResult *executor.Result
+	if o.cache != nil && check.Run != "" {
+		if key, keyErr := o.cache.Key(ctx, check); keyErr == nil {
+			cacheKey = key
+			execResult, _ = o.cache.Get(check.ID, key)
+		}
+	}
+
+	// Execute the check. A check without a command only analyzes its file.
+	if execResult == nil && check.Run == "" {
+		now := time.Now()
+		execResult = &executor.Result{CheckID: check.ID, StartTime: now, EndTime: now, Success: true}
+	}
+	if execResult == nil {
+		var err error
+		execResult, err = o.executor.ExecuteWithOptions(checkCtx, check.ID, check.Run, o.execOptions(check))
+		if err != nil {
+			// Execution error (not just non-zero exit)
+			return nil, nil, false, err
+		}
 	}
 
 	// Write check output to log file (best-effort, don't fail if this fails)
 	_ = o.writeCheckLog(check.ID, execResult.Combined)
 
+	// A cancelled command's output is incomplete, so there is nothing to evaluate
+	if execResult.Cancelled {
+		return execResult, make(map[string]string), false, nil
+	}
+
 	// Get the content to analyze (either from file or command output)
 	analysisOutput, analysisErr := o.getAnalysisOutput(check, execResult)
 	if analysisErr != nil {
 		// Wrap file reading error with check context
 		lineNum := o.config.FindCheckNodeLine(check.ID, checkIndex)
-		return nil, &config.ExecutionError{
+		return nil, nil, false, &config.ExecutionError{
 			Message:   analysisErr.Error(),
 			Cause:     analysisErr,
 			CheckID:   check.ID,
@@ -659,12 +1201,13 @@ func (o *Orchestrator) RunCheck(ctx context.Context, checkID string) (*RunResult
 
 	// Apply grok patterns to extract values from output
 	extracted := make(map[string]string)
+	var unmatched []string
 	if len(check.Grok) > 0 {
-		matcher, matcherErr := grok.New(check.Grok)
+		matcher, matcherErr := grok.NewWithDefinitions(check.Grok, o.config.GrokPatterns)
 		if matcherErr != nil {
 			// Wrap grok error with check context
 			lineNum := o.config.FindCheckNodeLine(check.ID, checkIndex)
-			return nil, &config.ExecutionError{
+			return nil, nil, false, &config.ExecutionError{
 				Message:   "failed to compile grok pattern",
 				Cause:     matcherErr,
 				CheckID:   check.ID,
@@ -676,7 +1219,7 @@ func (o *Orchestrator) RunCheck(ctx context.Context, checkID string) (*RunResult
 		if matcherErr != nil {
 			// Wrap grok error with check context
 			lineNum := o.config.FindCheckNodeLine(check.ID, checkIndex)
-			return nil, &config.ExecutionError{
+			return nil, nil, false, &config.ExecutionError{
 				Message:   "failed to parse grok pattern",
 				Cause:     matcherErr,
 				CheckID:   check.ID,
@@ -684,17 +1227,36 @@ func (o *Orchestrator) RunCheck(ctx context.Context, checkID string) (*RunResult
 				ErrorType: "grok",
 			}
 		}
+
+		// A required pattern that matches nothing fails the check instead of
+		// leaving its assertion to run without the captures. A failing
+		// command already fails it, unless the assertion decides the outcome.
+		if check.GrokRequired && (execResult.Success || assertDecidesOutcome(check.Assert)) {
+			unmatched = matcher.Unmatched(analysisOutput)
+		}
+	}
+	o.recordGrokMisses(check.ID, unmatched)
+
+	// Read coverage from the tool's structured report. A report that is
+	// missing or can't be parsed leaves the value grok extracted, if any.
+	if check.Coverage.Format != "" {
+		if percent, err := o.readCoverage(check); err == nil {
+			extracted[coverage.Variable] = coverage.Format(percent)
+		}
 	}
 
-	// Determine pass/fail based on exit code and assertion (if specified)
-	passed := execResult.Success
-	if passed && check.Assert != "" {
+	// Determine pass/fail based on exit code and assertion (if specified).
+	// An assertion on exit_code or timedout replaces the exit code check.
+	passed := execResult.Success && len(unmatched) == 0
+	if check.Assert != "" && len(unmatched) == 0 && (passed || (!execResult.Cancelled && assertDecidesOutcome(check.Assert))) {
 		evaluator := assert.New()
-		assertPassed, assertErr := evaluator.Eval(check.Assert, extracted)
+		vars := assertVars(extracted, execResult)
+		maps.Copy(vars, o.requiredCaptures(check))
+		assertPassed, assertErr := evaluator.Eval(check.Assert, vars)
 		if assertErr != nil {
 			// Wrap assert error with check context
 			lineNum := o.config.FindCheckNodeLine(check.ID, checkIndex)
-			return nil, &config.ExecutionError{
+			return nil, nil, false, &config.ExecutionError{
 				Message:   "failed to evaluate assertion",
 				Cause:     assertErr,
 				CheckID:   check.ID,
@@ -705,44 +1267,59 @@ func (o *Orchestrator) RunCheck(ctx context.Context, checkID string) (*RunResult
 		passed = assertPassed
 	}
 
-	result := &CheckResult{
-		Check:            check,
-		Execution:        execResult,
-		Passed:           passed,
-		Extracted:        extracted,
-		TriggeredPrompts: o.evaluateTriggeredPrompts(check, passed, execResult.Timedout),
+	// Cache passing results (best-effort); failures always run again
+	if passed && cacheKey != "" && !execResult.Cached {
+		_ = o.cache.Put(check.ID, cacheKey, execResult)
 	}
 
-	var violations []*Violation
-	exitCode := executor.ExitCodeSuccess
-	if !passed {
-		suggestion := check.Suggestion
-		if execResult.Timedout {
-			suggestion = "Check timed out. Consider increasing the timeout value or optimizing the command."
-		}
-		violation := &Violation{
-			CheckID:          check.ID,
-			Severity:         check.Severity,
-			Command:          check.Run,
-			Suggestion:       suggestion,
-			Fix:              check.Fix,
-			Extracted:        result.Extracted,
-			Timedout:         execResult.Timedout,
-			LogFile:          filepath.Join(o.logDir, check.ID+".log"),
-			TriggeredPrompts: result.TriggeredPrompts,
-		}
-		violations = append(violations, violation)
-		if execResult.Timedout || check.Severity == config.SeverityError {
-			exitCode = o.errorExitCode
-		}
+	return execResult, extracted, passed, nil
+}
+
+// shouldFix reports whether a failed check's fix command should be run.
+// Timed out and cancelled checks are not fixed.
+func (o *Orchestrator) shouldFix(check *config.Check, execResult *executor.Result) bool {
+	return o.autoFix && check.Fix != "" && !execResult.Timedout && !execResult.Cancelled
+}
+
+// fixAndRerun runs a failed check's fix command and, if the fix command
+// succeeds, evaluates the check once more. Fix commands run one at a time
+// because they usually rewrite files in the working tree. If the fix command
+// itself fails, the original result is returned unchanged. Otherwise the
+// re-run's timing is extended to cover the first run and the fix.
+func (o *Orchestrator) fixAndRerun(ctx context.Context, check *config.Check, checkIndex int, execResult *executor.Result, extracted map[string]string) (*executor.Result, map[string]string, bool, error) {
+	fixCmd := config.InterpolateWithExtracted(check.Fix, nil, extracted)
+
+	o.fixMu.Lock()
+	fixCtx := ctx
+	var cancel context.CancelFunc
+	if timeout := o.timeoutFor(check); timeout > 0 {
+		fixCtx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	fixResult, err := o.executor.ExecuteWithOptions(fixCtx, check.ID, fixCmd, o.execOptions(check))
+	if cancel != nil {
+		cancel()
+	}
+	o.fixMu.Unlock()
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if !fixResult.Success {
+		return execResult, extracted, false, nil
+	}
+	if o.cache != nil {
+		// The fix changed files the keys of later checks cover
+		o.cache.Reset()
 	}
 
-	return &RunResult{
-		Results:    []*CheckResult{result},
-		Violations: violations,
-		Duration:   time.Since(start),
-		ExitCode:   exitCode,
-	}, nil
+	rerun, extracted, passed, err := o.evaluateCheck(ctx, check, checkIndex)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if !execResult.StartTime.IsZero() && !rerun.EndTime.IsZero() {
+		rerun.StartTime = execResult.StartTime
+		rerun.Duration = rerun.EndTime.Sub(rerun.StartTime)
+	}
+	return rerun, extracted, passed, nil
 }
 
 // evaluateTriggeredPrompts evaluates which event is triggered and returns the prompts to display.
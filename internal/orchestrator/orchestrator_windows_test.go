@@ -0,0 +1,81 @@
+//go:build windows
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+// The other run tests in this package use POSIX shell commands, so they are
+// built everywhere but Windows. This one runs a minimal config through cmd.
+
+func TestRun_Windows(t *testing.T) {
+	cfg := &config.Config{
+		Version: "1",
+		Checks: []config.Check{
+			{
+				ID:       "build",
+				Run:      "echo ok",
+				Severity: config.SeverityError,
+				Timeout:  config.Duration(10 * time.Second),
+			},
+			{
+				ID:       "coverage",
+				Run:      "echo coverage: 85%",
+				Grok:     []string{"coverage: %{NUMBER:coverage}%"},
+				Assert:   "coverage >= 80",
+				Severity: config.SeverityError,
+				Requires: []string{"build"},
+				Timeout:  config.Duration(10 * time.Second),
+			},
+			{
+				ID:       "lint",
+				Run:      "exit /b 1",
+				Severity: config.SeverityError,
+				Requires: []string{"build"},
+				Timeout:  config.Duration(10 * time.Second),
+			},
+			{
+				ID:       "slow",
+				Run:      "ping -n 30 127.0.0.1 >NUL",
+				Severity: config.SeverityError,
+				Timeout:  config.Duration(500 * time.Millisecond),
+			},
+		},
+	}
+
+	orch := New(cfg, executor.New(""), 2, false, false, t.TempDir(), 1)
+	result, err := orch.Run(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	passed := make(map[string]bool)
+	for _, r := range result.Results {
+		passed[r.Check.ID] = r.Passed
+	}
+	want := map[string]bool{"build": true, "coverage": true, "lint": false, "slow": false}
+	for id, wantPassed := range want {
+		if passed[id] != wantPassed {
+			t.Errorf("check %s: expected passed=%v, got %v", id, wantPassed, passed[id])
+		}
+	}
+
+	timedout := false
+	for _, v := range result.Violations {
+		if v.CheckID == "slow" {
+			timedout = v.Timedout
+		}
+	}
+	if !timedout {
+		t.Error("expected the slow check to time out")
+	}
+	if result.ExitCode != 1 {
+		t.Errorf("expected exit code 1, got %d", result.ExitCode)
+	}
+}
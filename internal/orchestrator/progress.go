@@ -0,0 +1,34 @@
+package orchestrator
+
+import "github.com/vibeguard/vibeguard/internal/config"
+
+// Progress receives check lifecycle events while a run is in progress. Its
+// methods are called from the goroutines executing checks, so implementations
+// must be safe for concurrent use.
+type Progress interface {
+	// CheckStarted is called when a check's command starts running.
+	CheckStarted(check *config.Check)
+	// CheckFinished is called when a check completes, is cancelled or is
+	// skipped because a required check failed.
+	CheckFinished(result *CheckResult)
+}
+
+// SetProgress reports check starts and completions to p while checks run.
+// Passing nil disables progress reporting.
+func (o *Orchestrator) SetProgress(p Progress) {
+	o.progress = p
+}
+
+// checkStarted reports that check started, if progress reporting is enabled.
+func (o *Orchestrator) checkStarted(check *config.Check) {
+	if o.progress != nil {
+		o.progress.CheckStarted(check)
+	}
+}
+
+// checkFinished reports that a check finished, if progress reporting is enabled.
+func (o *Orchestrator) checkFinished(result *CheckResult) {
+	if o.progress != nil {
+		o.progress.CheckFinished(result)
+	}
+}
@@ -0,0 +1,33 @@
+package orchestrator
+
+import (
+	"slices"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+// Provenance records what a run was made from, so stored reports can be
+// correlated with the code they checked.
+type Provenance struct {
+	ConfigPath string // Absolute path of the config file
+	ConfigHash string // SHA-256 of the config file's content, in hex
+	GitCommit  string // Commit checked out in the config's directory, empty outside a repository
+	GitBranch  string // Branch checked out, empty outside a repository or when HEAD is detached
+}
+
+// SetProvenance sets the Provenance reported with every RunResult.
+func (o *Orchestrator) SetProvenance(p Provenance) {
+	o.provenance = p
+}
+
+// locateViolations sets the ConfigLine of each violation to the line of its
+// check in the config file, and returns violations.
+func (o *Orchestrator) locateViolations(violations []*Violation) []*Violation {
+	for _, v := range violations {
+		i := slices.IndexFunc(o.config.Checks, func(c config.Check) bool { return c.ID == v.CheckID })
+		if i >= 0 {
+			v.ConfigLine = o.config.FindCheckNodeLine(v.CheckID, i)
+		}
+	}
+	return violations
+}
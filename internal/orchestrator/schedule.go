@@ -0,0 +1,340 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+// SetPipeline enables dependency-driven scheduling: instead of running the
+// checks level by level, each check starts as soon as the checks it depends
+// on have finished and one of the maxParallel slots is free, so a slow check
+// only holds up the checks that depend on it.
+func (o *Orchestrator) SetPipeline(enabled bool) {
+	o.pipeline = enabled
+}
+
+// runState is the state the checks of a Run share while they are scheduled.
+type runState struct {
+	mu                sync.Mutex
+	passed            map[string]bool // Whether each finished check passed
+	excludedByTag     map[string]bool
+	failFastTriggered bool
+	cancelFailFast    context.CancelFunc // Kills in-flight checks in FailFastCancel mode
+}
+
+// stopped reports whether fail-fast stopped the run from starting checks.
+func (st *runState) stopped() bool {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.failFastTriggered
+}
+
+// runLevels runs the checks level by level: a level starts once every check
+// of the previous one has finished, and at most maxParallel of its checks
+// run at once. Results are in level order; violations in the order the
+// checks of each level finished.
+func (o *Orchestrator) runLevels(ctx context.Context, st *runState, levels [][]string, checkByID map[string]*config.Check, checkIndexByID map[string]int) ([]*CheckResult, []*Violation, error) {
+	var results []*CheckResult
+	var violations []*Violation
+	for levelIndex, level := range levels {
+		// Create results slice for this level to maintain order within level
+		levelResults := make([]*CheckResult, len(level))
+		levelViolations := make([]*Violation, 0)
+
+		// Use errgroup for parallel execution with context cancellation
+		g, gctx := errgroup.WithContext(ctx)
+
+		// Semaphore to limit concurrency
+		sem := make(chan struct{}, o.maxParallel)
+
+		for i, checkID := range level {
+			g.Go(func() error {
+				// Acquire semaphore
+				select {
+				case sem <- struct{}{}:
+				case <-gctx.Done():
+					return gctx.Err()
+				}
+				defer func() { <-sem }()
+
+				result, violation, err := o.runScheduledCheck(gctx, st, checkByID[checkID], checkIndexByID[checkID], levelIndex)
+				if err != nil {
+					return err
+				}
+				st.mu.Lock()
+				levelResults[i] = result
+				if violation != nil {
+					levelViolations = append(levelViolations, violation)
+				}
+				st.mu.Unlock()
+				return nil
+			})
+		}
+
+		// Wait for all goroutines in this level to complete. If fail-fast
+		// was triggered, context.Canceled is expected.
+		if err := g.Wait(); err != nil && !(st.stopped() && err == context.Canceled) {
+			return nil, nil, err
+		}
+
+		// Append level results in order
+		for _, r := range levelResults {
+			if r != nil {
+				results = append(results, r)
+			}
+		}
+		violations = append(violations, levelViolations...)
+
+		// If fail-fast was triggered, stop processing further levels
+		if st.stopped() {
+			break
+		}
+	}
+	return results, violations, nil
+}
+
+// runPipelined runs the checks as their dependencies finish, at most
+// maxParallel at once across the whole graph. Ready checks start in level
+// order, so with one slot the order is that of runLevels. Fail-fast stops
+// checks from starting; the running ones finish unless it cancels them.
+// Results and violations are in level order.
+func (o *Orchestrator) runPipelined(ctx context.Context, st *runState, levels [][]string, checkByID map[string]*config.Check, checkIndexByID map[string]int) ([]*CheckResult, []*Violation, error) {
+	var order []string
+	levelOf := make(map[string]int)
+	for levelIndex, level := range levels {
+		for _, id := range level {
+			levelOf[id] = levelIndex
+			order = append(order, id)
+		}
+	}
+	position := make(map[string]int, len(order))
+	for i, id := range order {
+		position[id] = i
+	}
+
+	// The graph's edges: every dependency that is part of the run
+	pending := make(map[string]int)
+	dependents := make(map[string][]string)
+	var ready []string
+	for _, id := range order {
+		check := checkByID[id]
+		for _, dep := range slices.Concat(check.Requires, check.OptionalRequires, check.RequiresAny) {
+			if _, ok := checkByID[dep]; ok {
+				pending[id]++
+				dependents[dep] = append(dependents[dep], id)
+			}
+		}
+		if pending[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	finished := make(chan string)
+	resultByID := make(map[string]*CheckResult)
+	violationByID := make(map[string]*Violation)
+	running := 0
+	for {
+		for running < o.maxParallel && len(ready) > 0 && gctx.Err() == nil && !st.stopped() {
+			checkID := ready[0]
+			ready = ready[1:]
+			running++
+			g.Go(func() error {
+				defer func() { finished <- checkID }()
+				result, violation, err := o.runScheduledCheck(gctx, st, checkByID[checkID], checkIndexByID[checkID], levelOf[checkID])
+				if err != nil {
+					return err
+				}
+				st.mu.Lock()
+				resultByID[checkID] = result
+				violationByID[checkID] = violation
+				st.mu.Unlock()
+				return nil
+			})
+		}
+		if running == 0 {
+			break // Done, or stopped by fail-fast or an error
+		}
+
+		checkID := <-finished
+		running--
+		for _, dependent := range dependents[checkID] {
+			if pending[dependent]--; pending[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+		sort.Slice(ready, func(i, j int) bool { return position[ready[i]] < position[ready[j]] })
+	}
+	if err := g.Wait(); err != nil && !(st.stopped() && err == context.Canceled) {
+		return nil, nil, err
+	}
+
+	var results []*CheckResult
+	var violations []*Violation
+	for _, id := range order {
+		if r := resultByID[id]; r != nil {
+			results = append(results, r)
+		}
+		if v := violationByID[id]; v != nil {
+			violations = append(violations, v)
+		}
+	}
+	return results, violations, nil
+}
+
+// runScheduledCheck runs a check of Run once it is scheduled: it is skipped
+// if a dependency didn't pass or its assertion on required checks' values
+// fails, and run otherwise. It returns the check's result and its
+// violation, if it has one, or a nil result if fail-fast stopped the run
+// before it started. A failing error-severity check triggers fail-fast.
+func (o *Orchestrator) runScheduledCheck(ctx context.Context, st *runState, check *config.Check, checkIndex, levelIndex int) (*CheckResult, *Violation, error) {
+	// Check if fail-fast was triggered by another goroutine
+	st.mu.Lock()
+	if st.failFastTriggered {
+		st.mu.Unlock()
+		return nil, nil, nil
+	}
+	// Verify all dependencies passed and are not excluded by tag filter
+	allDepsPassed := true
+	missingDep := ""
+	for _, depID := range check.Requires {
+		if st.excludedByTag[depID] {
+			allDepsPassed = false
+			missingDep = depID
+			break
+		}
+		if !st.passed[depID] {
+			allDepsPassed = false
+			missingDep = depID
+			break
+		}
+	}
+	// Of requires_any, one passing dependency is enough
+	anyDepPassed := len(check.RequiresAny) == 0
+	for _, depID := range check.RequiresAny {
+		if st.passed[depID] && !st.excludedByTag[depID] {
+			anyDepPassed = true
+			break
+		}
+	}
+	st.mu.Unlock()
+
+	// Skip this check if a required dependency failed or is excluded by tag
+	// filter, if none of its requires_any dependencies passed, or if its
+	// assertion on the values of required checks fails
+	var suggestion string
+	switch {
+	case !allDepsPassed && st.excludedByTag[missingDep]:
+		suggestion = fmt.Sprintf("Skipped: required dependency %q not in filtered set", missingDep)
+	case !allDepsPassed:
+		suggestion = "Skipped: required dependency failed"
+	case !anyDepPassed:
+		suggestion = "Skipped: no requires_any dependency passed"
+	default:
+		reason, err := o.gateReason(check, checkIndex)
+		if err != nil {
+			return nil, nil, err
+		}
+		suggestion = reason
+	}
+	if suggestion != "" {
+		result := &CheckResult{
+			Check:  check,
+			Passed: false,
+			Execution: &executor.Result{
+				CheckID:  check.ID,
+				ExitCode: -1,
+				Success:  false,
+			},
+			Extracted:  make(map[string]string),
+			Skipped:    true,
+			SkipReason: suggestion,
+			Level:      levelIndex,
+		}
+
+		violation := &Violation{
+			CheckID:      check.ID,
+			Description:  check.Description,
+			Severity:     check.Severity,
+			Command:      check.Run,
+			Suggestion:   suggestion,
+			Fix:          o.renderTemplate(check.Fix, nil),
+			Extracted:    result.Extracted,
+			AllowFailure: check.AllowFailure,
+		}
+		o.checkFinished(result)
+		return result, violation, nil
+	}
+
+	o.checkStarted(check)
+	execResult, extracted, passed, err := o.evaluateCheck(ctx, check, checkIndex)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fixAttempted := false
+	if !passed && o.shouldFix(check, execResult) {
+		fixAttempted = true
+		execResult, extracted, passed, err = o.fixAndRerun(ctx, check, checkIndex, execResult, extracted)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	result := &CheckResult{
+		Check:            check,
+		Execution:        execResult,
+		Passed:           passed,
+		Extracted:        extracted,
+		TriggeredPrompts: o.evaluateTriggeredPrompts(check, passed, execResult.Timedout),
+		FixAttempted:     fixAttempted,
+		Fixed:            fixAttempted && passed,
+		Timeout:          o.timeoutFor(check),
+		Level:            levelIndex,
+	}
+	o.recordCaptures(check.ID, extracted)
+
+	var violation *Violation
+	st.mu.Lock()
+	st.passed[check.ID] = passed
+
+	// A check cancelled by fail-fast didn't fail; the failure that
+	// cancelled it is reported instead
+	if !passed && !execResult.Cancelled {
+		violation = &Violation{
+			CheckID:          check.ID,
+			Description:      check.Description,
+			Severity:         check.Severity,
+			Command:          check.Run,
+			Suggestion:       o.violationSuggestion(check, execResult, extracted),
+			Fix:              o.renderTemplate(check.Fix, extracted),
+			Extracted:        result.Extracted,
+			Timedout:         execResult.Timedout,
+			LogFile:          filepath.Join(o.logDir, check.ID+".log"),
+			TriggeredPrompts: o.evaluateTriggeredPrompts(check, passed, execResult.Timedout),
+			FixAttempted:     fixAttempted,
+			AllowFailure:     check.AllowFailure,
+			Explanation:      o.explainFailure(check, execResult, extracted),
+			GrokUnmatched:    o.grokMissesFor(check.ID),
+		}
+
+		if o.failFast && check.Severity == config.SeverityError && !check.AllowFailure {
+			st.failFastTriggered = true
+			if o.failFastMode == FailFastCancel {
+				st.cancelFailFast() // Kill in-flight checks
+			}
+		}
+	}
+	st.mu.Unlock()
+	o.checkFinished(result)
+	return result, violation, nil
+}
@@ -0,0 +1,171 @@
+//go:build !windows
+
+package orchestrator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+// chainAndFanOut returns a chain lint → vet → test that is slow in the
+// middle, and a slow build fanning out to two quick checks. With pipelining
+// the chain moves on while build runs instead of waiting for it.
+func chainAndFanOut() []config.Check {
+	return []config.Check{
+		{ID: "lint", Run: "sleep 0.1", Severity: config.SeverityError},
+		{ID: "vet", Run: "sleep 0.4", Requires: []string{"lint"}, Severity: config.SeverityError},
+		{ID: "test", Run: "sleep 0.1", Requires: []string{"vet"}, Severity: config.SeverityError},
+		{ID: "build", Run: "sleep 0.5", Severity: config.SeverityError},
+		{ID: "smoke-linux", Run: "sleep 0.1", Requires: []string{"build"}, Severity: config.SeverityError},
+		{ID: "smoke-darwin", Run: "sleep 0.1", Requires: []string{"build"}, Severity: config.SeverityError},
+	}
+}
+
+func TestRun_Pipeline_StartsChecksEarly(t *testing.T) {
+	run := func(pipeline bool) *RunResult {
+		cfg := &config.Config{Version: "1", Checks: chainAndFanOut()}
+		orch := New(cfg, executor.New(""), 4, false, false, t.TempDir(), 1)
+		orch.SetPipeline(pipeline)
+		result, err := orch.Run(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.ExitCode != 0 || len(result.Results) != 6 {
+			t.Fatalf("expected 6 passing checks, got exit code %d and %d results", result.ExitCode, len(result.Results))
+		}
+		return result
+	}
+	vetStartsBeforeBuildEnds := func(result *RunResult) bool {
+		var vet, build *CheckResult
+		for _, r := range result.Results {
+			switch r.Check.ID {
+			case "vet":
+				vet = r
+			case "build":
+				build = r
+			}
+		}
+		return vet.Execution.StartTime.Before(build.Execution.EndTime)
+	}
+
+	// Level by level, vet waits for the slow build of the first level;
+	// pipelined, it starts as soon as lint ends. The order of start and end
+	// times holds however slow the machine is.
+	if vetStartsBeforeBuildEnds(run(false)) {
+		t.Error("expected vet to start after build ended without pipelining")
+	}
+	result := run(true)
+	if !vetStartsBeforeBuildEnds(result) {
+		t.Error("expected vet to start before build ended with pipelining")
+	}
+
+	// Every check started after the checks it requires ended
+	byID := make(map[string]*CheckResult)
+	var ids []string
+	for _, r := range result.Results {
+		byID[r.Check.ID] = r
+		ids = append(ids, r.Check.ID)
+	}
+	for _, r := range result.Results {
+		for _, dep := range r.Check.Requires {
+			if r.Execution.StartTime.Before(byID[dep].Execution.EndTime) {
+				t.Errorf("%s started before %s ended", r.Check.ID, dep)
+			}
+		}
+	}
+	// Results and levels are reported as without pipelining
+	if want := []string{"lint", "build", "vet", "smoke-linux", "smoke-darwin", "test"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("expected results in level order %v, got %v", want, ids)
+	}
+	if byID["test"].Level != 2 || byID["smoke-linux"].Level != 1 {
+		t.Errorf("expected levels 2 and 1, got %d and %d", byID["test"].Level, byID["smoke-linux"].Level)
+	}
+}
+
+func TestRun_Pipeline_GlobalParallelLimit(t *testing.T) {
+	cfg := &config.Config{Version: "1", Checks: chainAndFanOut()}
+	orch := New(cfg, executor.New(""), 2, false, false, t.TempDir(), 1)
+	orch.SetPipeline(true)
+	result, err := orch.Run(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// At no check's start were more than 2 checks running
+	for _, r := range result.Results {
+		running := 0
+		for _, other := range result.Results {
+			at := r.Execution.StartTime
+			if !other.Execution.StartTime.After(at) && other.Execution.EndTime.After(at) {
+				running++
+			}
+		}
+		if running > 2 {
+			t.Errorf("expected at most 2 checks running when %s started, got %d", r.Check.ID, running)
+		}
+	}
+}
+
+func TestRun_Pipeline_FailFastStopsStartingChecks(t *testing.T) {
+	cfg := &config.Config{
+		Version: "1",
+		Checks: []config.Check{
+			{ID: "lint", Run: "exit 1", Severity: config.SeverityError},
+			{ID: "build", Run: "sleep 0.3", Severity: config.SeverityError},
+			{ID: "vet", Run: "true", Requires: []string{"build"}, Severity: config.SeverityError},
+		},
+	}
+	orch := New(cfg, executor.New(""), 2, true, false, t.TempDir(), 1)
+	orch.SetPipeline(true)
+	result, err := orch.Run(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.FailFastTriggered {
+		t.Fatal("expected fail-fast to trigger")
+	}
+
+	var ids []string
+	for _, r := range result.Results {
+		ids = append(ids, r.Check.ID)
+	}
+	// The running build finishes; vet, which would start after it, doesn't
+	if want := []string{"lint", "build"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("expected results %v, got %v", want, ids)
+	}
+}
+
+func TestRun_Pipeline_SkipsDependentsOfFailedChecks(t *testing.T) {
+	cfg := &config.Config{
+		Version: "1",
+		Checks: []config.Check{
+			{ID: "build", Run: "exit 1", Severity: config.SeverityError},
+			{ID: "test", Run: "true", Requires: []string{"build"}, Severity: config.SeverityError},
+			{ID: "report", Run: "true", OptionalRequires: []string{"test"}, Severity: config.SeverityError},
+		},
+	}
+	orch := New(cfg, executor.New(""), 4, false, false, t.TempDir(), 1)
+	orch.SetPipeline(true)
+	result, err := orch.Run(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]struct{ passed, skipped bool }{
+		"build":  {false, false},
+		"test":   {false, true},
+		"report": {true, false},
+	}
+	for _, r := range result.Results {
+		if got := (struct{ passed, skipped bool }{r.Passed, r.Skipped}); got != want[r.Check.ID] {
+			t.Errorf("%s: expected passed/skipped %v, got %v", r.Check.ID, want[r.Check.ID], got)
+		}
+	}
+	if len(result.Violations) != 2 || result.Violations[0].CheckID != "build" || result.Violations[1].CheckID != "test" {
+		t.Errorf("expected violations for build then test, got %d", len(result.Violations))
+	}
+}
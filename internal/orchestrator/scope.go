@@ -0,0 +1,205 @@
+package orchestrator
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+// scopeSkipReason is the SkipReason for scoped checks skipped by
+// SetChangedFiles because nothing in their scope changed.
+const scopeSkipReason = "Skipped: no changed packages"
+
+// jestExtensions are the extensions of the files jest --findRelatedTests is
+// given.
+var jestExtensions = map[string]bool{
+	".js": true, ".jsx": true, ".mjs": true, ".cjs": true,
+	".ts": true, ".tsx": true, ".mts": true, ".cts": true,
+}
+
+// scopeChecks returns checks with the {{.packages}} placeholder of each
+// scoped check replaced by scopeValue. Unscoped checks are returned as is.
+func (o *Orchestrator) scopeChecks(checks []config.Check) []config.Check {
+	scoped := make([]config.Check, len(checks))
+	for i, check := range checks {
+		scoped[i] = o.scopeCheck(check)
+	}
+	return scoped
+}
+
+// scopeCheck returns check with the {{.packages}} placeholder of its run
+// command replaced by scopeValue, if it is scoped.
+func (o *Orchestrator) scopeCheck(check config.Check) config.Check {
+	if check.Scope == "" {
+		return check
+	}
+	check.Run = strings.ReplaceAll(check.Run, "{{."+config.ScopeVar+"}}", o.scopeValue(&check))
+	return check
+}
+
+// scopeValue returns what a scoped check's {{.packages}} stands for: its
+// changed packages, or, without changed files or when none of them is in
+// the check's scope, the whole project.
+func (o *Orchestrator) scopeValue(check *config.Check) string {
+	if value, ok := o.changedScope(check); ok {
+		return value
+	}
+	if isJestCommand(check.Run) {
+		return "" // jest runs every test without --findRelatedTests
+	}
+	if value, ok := o.config.Vars[config.ScopeVar]; ok {
+		return value
+	}
+	return "./..."
+}
+
+// changedScope returns the changed-files value of a scoped check's
+// {{.packages}}: the changed Go packages, or for a jest command
+// --findRelatedTests and the changed JavaScript and TypeScript files. It
+// reports false if SetChangedFiles wasn't called or none of the changed
+// files is in the check's scope.
+func (o *Orchestrator) changedScope(check *config.Check) (string, bool) {
+	if o.changedFiles == nil {
+		return "", false
+	}
+	dir := o.config.Dir()
+	if isJestCommand(check.Run) {
+		files := jestFiles(dir, o.changedFiles)
+		if len(files) == 0 {
+			return "", false
+		}
+		return "--findRelatedTests " + joinArgs(files), true
+	}
+	packages := goPackages(dir, o.changedFiles)
+	if len(packages) == 0 {
+		return "", false
+	}
+	return joinArgs(packages), true
+}
+
+// isJestCommand reports whether a run command invokes jest, directly or
+// through a runner such as npx, yarn or a node_modules/.bin path.
+func isJestCommand(run string) bool {
+	for _, field := range strings.Fields(run) {
+		field = strings.Trim(field, `"'`)
+		if path.Base(filepath.ToSlash(field)) == "jest" || strings.HasPrefix(field, "--findRelatedTests") {
+			return true
+		}
+	}
+	return false
+}
+
+// goPackages maps changed files, slash-separated and relative to dir, to the
+// ./-relative patterns of the Go packages they belong to, sorted. A .go file
+// belongs to the package of its directory and any file under a testdata
+// directory to the package holding that directory. Packages that no longer
+// have .go files, directories the go command ignores (vendor, testdata
+// itself, and names starting with . or _) and packages of nested modules
+// are left out. A change to the root go.mod or go.sum can affect any
+// package, so it maps to ./... .
+func goPackages(dir string, files []string) []string {
+	seen := make(map[string]bool)
+	var packages []string
+	for _, file := range files {
+		if file == "go.mod" || file == "go.sum" {
+			return []string{"./..."}
+		}
+		pkgDir, ok := goPackageDir(file)
+		if !ok || seen[pkgDir] {
+			continue
+		}
+		seen[pkgDir] = true
+		if !hasGoFiles(filepath.Join(dir, filepath.FromSlash(pkgDir))) || inNestedModule(dir, pkgDir) {
+			continue
+		}
+		if pkgDir == "." {
+			packages = append(packages, ".")
+		} else {
+			packages = append(packages, "./"+pkgDir)
+		}
+	}
+	sort.Strings(packages)
+	return packages
+}
+
+// goPackageDir returns the directory of the Go package a changed file
+// belongs to, or false if it belongs to none.
+func goPackageDir(file string) (string, bool) {
+	dir := path.Dir(file)
+	if dir == "." {
+		return ".", strings.HasSuffix(file, ".go")
+	}
+	elems := strings.Split(dir, "/")
+	for i, elem := range elems {
+		if elem == "testdata" {
+			// Test fixtures: the package whose tests read them
+			return path.Join(append([]string{"."}, elems[:i]...)...), true
+		}
+		if elem == "vendor" || strings.HasPrefix(elem, ".") || strings.HasPrefix(elem, "_") {
+			return "", false
+		}
+	}
+	return dir, strings.HasSuffix(file, ".go")
+}
+
+// hasGoFiles reports whether dir directly contains a .go file.
+func hasGoFiles(dir string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".go") {
+			return true
+		}
+	}
+	return false
+}
+
+// inNestedModule reports whether pkgDir, relative to root, is inside a module
+// other than root's: whether it or a directory between it and root has a
+// go.mod.
+func inNestedModule(root, pkgDir string) bool {
+	for d := pkgDir; d != "." && d != "/"; d = path.Dir(d) {
+		if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(d), "go.mod")); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// jestFiles returns the changed files, slash-separated and relative to dir,
+// that jest --findRelatedTests can take: existing JavaScript and TypeScript
+// files outside node_modules.
+func jestFiles(dir string, files []string) []string {
+	var related []string
+	for _, file := range files {
+		if !jestExtensions[path.Ext(file)] || strings.HasPrefix(file, "node_modules/") || strings.Contains(file, "/node_modules/") {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(file))); err != nil {
+			continue // Deleted: jest can't find tests related to it
+		}
+		related = append(related, file)
+	}
+	return related
+}
+
+// joinArgs joins arguments for a shell command line, single-quoting those
+// with characters the shell would interpret.
+func joinArgs(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if strings.ContainsFunc(arg, func(r rune) bool {
+			return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("./-_+@=,:", r))
+		}) {
+			arg = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+		quoted[i] = arg
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,205 @@
+//go:build !windows
+
+package orchestrator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+// writeTree creates empty files at the given slash-separated paths under
+// dir.
+func writeTree(t *testing.T, dir string, files ...string) {
+	t.Helper()
+	for _, file := range files {
+		path := filepath.Join(dir, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestGoPackages(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir,
+		"go.mod",
+		"main.go",
+		"internal/config/config.go",
+		"internal/config/config_test.go",
+		"internal/config/testdata/valid/vibeguard.yaml",
+		"internal/output/json.go",
+		"internal/output/templates/report.html",
+		"tools/go.mod",
+		"tools/lint/lint.go",
+		"vendor/example.com/dep/dep.go",
+		"_examples/demo/demo.go",
+		"docs/guide.md",
+	)
+
+	tests := []struct {
+		name  string
+		files []string
+		want  []string
+	}{
+		{
+			name:  "go files map to their directory once",
+			files: []string{"internal/config/config.go", "internal/config/config_test.go", "internal/output/json.go"},
+			want:  []string{"./internal/config", "./internal/output"},
+		},
+		{
+			name:  "root package",
+			files: []string{"main.go"},
+			want:  []string{"."},
+		},
+		{
+			name:  "testdata maps to the package using it",
+			files: []string{"internal/config/testdata/valid/vibeguard.yaml"},
+			want:  []string{"./internal/config"},
+		},
+		{
+			name:  "non-Go files outside testdata",
+			files: []string{"docs/guide.md", "internal/output/templates/report.html", "README.md"},
+		},
+		{
+			name:  "vendored, ignored and nested module packages",
+			files: []string{"vendor/example.com/dep/dep.go", "_examples/demo/demo.go", "tools/lint/lint.go"},
+		},
+		{
+			name:  "deleted package",
+			files: []string{"internal/removed/removed.go", "internal/output/json.go"},
+			want:  []string{"./internal/output"},
+		},
+		{
+			name:  "module files affect every package",
+			files: []string{"internal/output/json.go", "go.sum"},
+			want:  []string{"./..."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := goPackages(dir, tt.files); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("goPackages(%v) = %v, want %v", tt.files, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJestFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, "src/sum.ts", "src/App.tsx", "src/styles.css", "node_modules/left-pad/index.js")
+
+	files := []string{"src/App.tsx", "src/removed.ts", "src/styles.css", "src/sum.ts", "node_modules/left-pad/index.js"}
+	if got, want := jestFiles(dir, files), []string{"src/App.tsx", "src/sum.ts"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("jestFiles = %v, want %v", got, want)
+	}
+}
+
+func TestIsJestCommand(t *testing.T) {
+	tests := []struct {
+		run  string
+		want bool
+	}{
+		{"npx jest {{.packages}}", true},
+		{"./node_modules/.bin/jest --ci {{.packages}}", true},
+		{"npm test -- {{.packages}}", false},
+		{"npm test -- --findRelatedTests={{.packages}}", true},
+		{"go test {{.packages}}", false},
+	}
+	for _, tt := range tests {
+		if got := isJestCommand(tt.run); got != tt.want {
+			t.Errorf("isJestCommand(%q) = %v, want %v", tt.run, got, tt.want)
+		}
+	}
+}
+
+func TestJoinArgs(t *testing.T) {
+	got := joinArgs([]string{"./internal/config", "src/my file.ts", "src/it's.ts"})
+	want := `./internal/config 'src/my file.ts' 'src/it'\''s.ts'`
+	if got != want {
+		t.Errorf("joinArgs = %s, want %s", got, want)
+	}
+}
+
+func TestRun_ScopeChanged(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, "go.mod", "internal/config/config.go", "internal/output/json.go", "web/src/sum.ts")
+	configPath := filepath.Join(dir, "vibeguard.yaml")
+	configContent := `version: "1"
+vars:
+  packages: ./internal/...
+checks:
+  - id: go-test
+    run: echo go test {{.packages}}
+    scope: changed
+  - id: jest
+    run: echo npx jest {{.packages}}
+    scope: changed
+`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		changedFiles []string
+		want         map[string]string // Output by check ID; missing checks are skipped
+	}{
+		{
+			name: "no changed files runs the configured packages",
+			want: map[string]string{
+				"go-test": "go test ./internal/...\n",
+				"jest":    "npx jest\n",
+			},
+		},
+		{
+			name:         "changed packages and files",
+			changedFiles: []string{"internal/output/json.go", "web/src/sum.ts"},
+			want: map[string]string{
+				"go-test": "go test ./internal/output\n",
+				"jest":    "npx jest --findRelatedTests web/src/sum.ts\n",
+			},
+		},
+		{
+			name:         "nothing in scope changed",
+			changedFiles: []string{"README.md"},
+			want:         map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.Load(configPath)
+			if err != nil {
+				t.Fatalf("Load failed: %v", err)
+			}
+			orch := New(cfg, executor.New(""), 2, false, false, t.TempDir(), 1)
+			if tt.changedFiles != nil {
+				orch.SetChangedFiles(tt.changedFiles)
+			}
+			result, err := orch.Run(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, r := range result.Results {
+				want, ok := tt.want[r.Check.ID]
+				if !ok {
+					if !r.PathSkipped || r.SkipReason != scopeSkipReason {
+						t.Errorf("expected %s skipped with %q, got skipped=%v reason=%q", r.Check.ID, scopeSkipReason, r.PathSkipped, r.SkipReason)
+					}
+					continue
+				}
+				if r.Skipped || r.Execution.Stdout != want {
+					t.Errorf("expected %s to output %q, got skipped=%v output %q", r.Check.ID, want, r.Skipped, r.Execution.Stdout)
+				}
+			}
+		})
+	}
+}
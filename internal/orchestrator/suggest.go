@@ -0,0 +1,57 @@
+package orchestrator
+
+import (
+	"fmt"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+// maxSuggestDistance is the largest edit distance at which an unknown check
+// ID is taken for a typo of an existing one.
+const maxSuggestDistance = 2
+
+// checkNotFoundError returns the error for an unknown check ID, suggesting
+// the closest existing ID when there is one within maxSuggestDistance.
+func checkNotFoundError(checkID string, checks []config.Check) error {
+	msg := fmt.Sprintf("check with ID %q not found", checkID)
+	if suggestion := closestCheckID(checkID, checks); suggestion != "" {
+		msg += fmt.Sprintf("; did you mean %q?", suggestion)
+	}
+	return &config.ConfigError{Message: msg}
+}
+
+// closestCheckID returns the ID of the check closest to id by edit distance,
+// or "" if none is within maxSuggestDistance. Ties go to the check defined
+// first.
+func closestCheckID(id string, checks []config.Check) string {
+	best, bestDistance := "", maxSuggestDistance+1
+	for _, check := range checks {
+		if d := levenshtein(id, check.ID); d < bestDistance {
+			best, bestDistance = check.ID, d
+		}
+	}
+	return best
+}
+
+// levenshtein returns the number of single-character insertions, deletions
+// and substitutions needed to turn a into b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,43 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"test", "test", 0},
+		{"tset", "test", 2},
+		{"tst", "test", 1},
+		{"", "lint", 4},
+		{"vet", "fmt", 2},
+	}
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestClosestCheckID(t *testing.T) {
+	checks := []config.Check{{ID: "lint"}, {ID: "test"}, {ID: "coverage"}}
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{"tset", "test"},
+		{"lnit", "lint"},
+		{"covrage", "coverage"},
+		{"deploy", ""},
+	}
+	for _, tt := range tests {
+		if got := closestCheckID(tt.id, checks); got != tt.want {
+			t.Errorf("closestCheckID(%q) = %q, want %q", tt.id, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package orchestrator
+
+import (
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+// Summary aggregates the outcome of a run.
+type Summary struct {
+	Total     int
+	Passed    int
+	Cached    int // Passed checks whose result was replayed from the cache
+	Failed    int
+	Skipped   int // Skipped by a path filter or because a dependency did not pass
+	Cancelled int
+	Errors    int // Violations with error severity, including those of skipped checks
+	Warnings  int // Violations with warning severity
+	Infos     int // Violations with info severity
+	Duration  time.Duration
+
+	// SlowestID and Slowest identify the check that took longest to run.
+	// SlowestID is empty if no check ran; cached results do not count.
+	SlowestID string
+	Slowest   time.Duration
+}
+
+// Summary computes the aggregate statistics of the run from its results.
+func (r *RunResult) Summary() Summary {
+	s := Summary{
+		Total:    len(r.Results),
+		Duration: r.Duration,
+	}
+
+	for _, res := range r.Results {
+		switch {
+		case res.Passed:
+			s.Passed++
+			if res.Execution != nil && res.Execution.Cached {
+				s.Cached++
+			}
+		case res.Skipped:
+			s.Skipped++
+		case res.Execution != nil && res.Execution.Cancelled:
+			s.Cancelled++
+		default:
+			s.Failed++
+		}
+
+		if res.Skipped || res.Execution == nil || res.Execution.Cached {
+			continue
+		}
+		if s.SlowestID == "" || res.Execution.Duration > s.Slowest {
+			s.SlowestID = res.Check.ID
+			s.Slowest = res.Execution.Duration
+		}
+	}
+
+	// Count severities from the violations, which decide the exit code, so a
+	// check skipped because its dependency failed is counted as well
+	for _, v := range r.Violations {
+		switch v.Severity {
+		case config.SeverityWarning:
+			s.Warnings++
+		case config.SeverityInfo:
+			s.Infos++
+		default:
+			s.Errors++
+		}
+	}
+
+	return s
+}
+
+// Add accumulates the statistics of another run into s, as when several
+// configs run one after another.
+func (s *Summary) Add(other Summary) {
+	s.Total += other.Total
+	s.Passed += other.Passed
+	s.Cached += other.Cached
+	s.Failed += other.Failed
+	s.Skipped += other.Skipped
+	s.Cancelled += other.Cancelled
+	s.Errors += other.Errors
+	s.Warnings += other.Warnings
+	s.Infos += other.Infos
+	s.Duration += other.Duration
+	if other.SlowestID != "" && (s.SlowestID == "" || other.Slowest > s.Slowest) {
+		s.SlowestID = other.SlowestID
+		s.Slowest = other.Slowest
+	}
+}
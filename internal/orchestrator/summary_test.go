@@ -0,0 +1,90 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+)
+
+func TestRunResult_Summary(t *testing.T) {
+	result := &RunResult{
+		Results: []*CheckResult{
+			{
+				Check:     &config.Check{ID: "fmt", Severity: config.SeverityError},
+				Execution: &executor.Result{Duration: 300 * time.Millisecond},
+				Passed:    true,
+			},
+			{
+				Check:     &config.Check{ID: "test", Severity: config.SeverityError},
+				Execution: &executor.Result{Duration: 4100 * time.Millisecond, ExitCode: 1},
+			},
+			{
+				Check:     &config.Check{ID: "lint", Severity: config.SeverityWarning},
+				Execution: &executor.Result{Duration: 900 * time.Millisecond, ExitCode: 1},
+			},
+			{
+				Check:     &config.Check{ID: "build", Severity: config.SeverityError},
+				Execution: &executor.Result{ExitCode: -1},
+				Skipped:   true,
+			},
+			{
+				Check:     &config.Check{ID: "docs", Severity: config.SeverityError},
+				Execution: &executor.Result{ExitCode: -1},
+				Skipped:   true, PathSkipped: true,
+			},
+			{
+				Check:     &config.Check{ID: "e2e", Severity: config.SeverityError},
+				Execution: &executor.Result{Duration: 50 * time.Millisecond, ExitCode: -1, Cancelled: true},
+			},
+			{
+				Check:     &config.Check{ID: "vet", Severity: config.SeverityWarning},
+				Execution: &executor.Result{Duration: 200 * time.Millisecond},
+				Passed:    true,
+			},
+		},
+		// build was skipped because a dependency failed, which is a violation
+		Violations: []*Violation{
+			{CheckID: "test", Severity: config.SeverityError},
+			{CheckID: "lint", Severity: config.SeverityWarning},
+			{CheckID: "build", Severity: config.SeverityError},
+		},
+		Duration: 8300 * time.Millisecond,
+	}
+
+	got := result.Summary()
+	want := Summary{
+		Total:     7,
+		Passed:    2,
+		Failed:    2,
+		Skipped:   2,
+		Cancelled: 1,
+		Errors:    2,
+		Warnings:  1,
+		Duration:  8300 * time.Millisecond,
+		SlowestID: "test",
+		Slowest:   4100 * time.Millisecond,
+	}
+	if got != want {
+		t.Errorf("Summary() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRunResult_Summary_Empty(t *testing.T) {
+	got := (&RunResult{}).Summary()
+	if got != (Summary{}) {
+		t.Errorf("expected zero summary for empty run, got %+v", got)
+	}
+}
+
+func TestSummary_Add(t *testing.T) {
+	s := Summary{Total: 2, Passed: 1, Failed: 1, Errors: 1, Duration: time.Second, SlowestID: "build", Slowest: 800 * time.Millisecond}
+	s.Add(Summary{Total: 3, Passed: 2, Skipped: 1, Cached: 1, Duration: 2 * time.Second, SlowestID: "test", Slowest: 1500 * time.Millisecond})
+	s.Add(Summary{})
+
+	want := Summary{Total: 5, Passed: 3, Cached: 1, Failed: 1, Skipped: 1, Errors: 1, Duration: 3 * time.Second, SlowestID: "test", Slowest: 1500 * time.Millisecond}
+	if s != want {
+		t.Errorf("Add() = %+v, want %+v", s, want)
+	}
+}
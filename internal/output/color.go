@@ -0,0 +1,55 @@
+package output
+
+import "github.com/vibeguard/vibeguard/internal/config"
+
+// ANSI escape sequences for colored text output
+const (
+	ansiReset  = "\x1b[0m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+	ansiDim    = "\x1b[2m"
+)
+
+// Palette colors parts of the text report by meaning. The zero value leaves
+// text unchanged; only the text formatter uses one, so the JSON, SARIF and
+// JUnit reports never contain escape codes.
+type Palette struct {
+	enabled bool
+}
+
+// NewPalette returns a Palette that colors text if enabled is true.
+func NewPalette(enabled bool) Palette {
+	return Palette{enabled: enabled}
+}
+
+// Pass colors text for a passing or fixed check.
+func (p Palette) Pass(s string) string {
+	return p.wrap(ansiGreen, s)
+}
+
+// Skip colors text for a skipped or cancelled check.
+func (p Palette) Skip(s string) string {
+	return p.wrap(ansiDim, s)
+}
+
+// Severity colors text for a violation of the given severity: red for
+// errors, yellow for warnings. Info violations are left uncolored.
+func (p Palette) Severity(severity config.Severity, s string) string {
+	switch severity {
+	case config.SeverityWarning:
+		return p.wrap(ansiYellow, s)
+	case config.SeverityInfo:
+		return s
+	default:
+		return p.wrap(ansiRed, s)
+	}
+}
+
+// wrap surrounds s with the code and a reset when the palette is enabled.
+func (p Palette) wrap(code, s string) string {
+	if !p.enabled || s == "" {
+		return s
+	}
+	return code + s + ansiReset
+}
@@ -4,7 +4,9 @@ package output
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/vibeguard/vibeguard/internal/config"
 	"github.com/vibeguard/vibeguard/internal/orchestrator"
@@ -12,8 +14,10 @@ import (
 
 // Formatter handles output formatting.
 type Formatter struct {
-	out     io.Writer
-	verbose bool
+	out            io.Writer
+	verbose        bool
+	colors         Palette
+	sortByDuration bool
 }
 
 // New creates a new Formatter.
@@ -24,6 +28,20 @@ func New(out io.Writer, verbose bool) *Formatter {
 	}
 }
 
+// SetColor enables or disables ANSI colors: green for passing checks, red
+// for errors, yellow for warnings and dim for skipped checks. Colors are off
+// by default.
+func (f *Formatter) SetColor(enabled bool) {
+	f.colors = NewPalette(enabled)
+}
+
+// SetSortByDuration makes the formatter end its output with the summary and
+// the duration of every check that ran, slowest first, even when all checks
+// pass.
+func (f *Formatter) SetSortByDuration(enabled bool) {
+	f.sortByDuration = enabled
+}
+
 // FormatResult formats the run result for output.
 // In quiet mode (default), only violations are shown.
 // In verbose mode, all check results are shown.
@@ -35,17 +53,40 @@ func (f *Formatter) FormatResult(result *orchestrator.RunResult) {
 	}
 }
 
-// formatQuiet outputs only violations (silence is success).
+// formatQuiet outputs only violations (silence is success), preceded by any
+// checks that --fix repaired.
 func (f *Formatter) formatQuiet(result *orchestrator.RunResult) {
+	for _, r := range result.Results {
+		if r.Fixed {
+			_, _ = fmt.Fprintf(f.out, "%s  %s\n\n", f.colors.Pass("FIXED"), r.Check.ID)
+		}
+	}
+	var infos []*orchestrator.Violation
 	for _, v := range result.Violations {
+		if v.Severity == config.SeverityInfo {
+			infos = append(infos, v)
+			continue
+		}
 		f.formatViolation(v)
 	}
+	if len(infos) > 0 {
+		_, _ = fmt.Fprintf(f.out, "Info:\n\n")
+		for _, v := range infos {
+			f.formatViolation(v)
+		}
+	}
 	if result.FailFastTriggered {
 		_, _ = fmt.Fprintf(f.out, "Execution stopped early due to --fail-fast\n")
 	}
+	f.formatHookFailures(result.HookFailures)
+	if len(result.Violations) > 0 || f.sortByDuration {
+		_, _ = fmt.Fprintln(f.out, FormatSummary(result.Summary()))
+		f.formatCategorySummary(result.Results)
+	}
+	f.formatDurations(result.Results)
 }
 
-// formatVerbose outputs all check results.
+// formatVerbose outputs all check results, under a header naming the run.
 func (f *Formatter) formatVerbose(result *orchestrator.RunResult) {
 	// Build a map of violations by check ID for easy lookup
 	violationByID := make(map[string]*orchestrator.Violation)
@@ -53,85 +94,250 @@ func (f *Formatter) formatVerbose(result *orchestrator.RunResult) {
 		violationByID[v.CheckID] = v
 	}
 
-	for _, r := range result.Results {
-		if r.Passed {
-			_, _ = fmt.Fprintf(f.out, "✓ %-15s passed (%.1fs)\n",
-				r.Check.ID, r.Execution.Duration.Seconds())
-			if len(r.Check.Tags) > 0 {
-				_, _ = fmt.Fprintf(f.out, "  Tags: %s\n", strings.Join(r.Check.Tags, ", "))
-			}
-			if len(r.TriggeredPrompts) > 0 {
-				_, _ = fmt.Fprintln(f.out)
-				f.formatTriggeredPrompts(r.TriggeredPrompts)
-			}
-		} else if r.Execution.Cancelled {
-			_, _ = fmt.Fprintf(f.out, "⊘ %-15s cancelled\n", r.Check.ID)
-		} else {
-			// Get the violation for this check
-			v := violationByID[r.Check.ID]
-			if v == nil {
-				// Fallback if no violation found (shouldn't happen)
-				_, _ = fmt.Fprintf(f.out, "✗ %-15s FAIL (%.1fs)\n",
-					r.Check.ID, r.Execution.Duration.Seconds())
-				continue
-			}
+	f.formatHeader(result)
 
-			// Use WARN for warning severity, FAIL for everything else
-			header := "FAIL"
-			if v.Severity == config.SeverityWarning {
-				header = "WARN"
-			}
+	groups := orchestrator.GroupByCategory(result.Results)
+	if groups == nil {
+		for _, r := range result.Results {
+			f.formatVerboseResult(r, violationByID[r.Check.ID])
+		}
+	}
+	for i, g := range groups {
+		if i > 0 {
+			_, _ = fmt.Fprintln(f.out)
+		}
+		_, _ = fmt.Fprintf(f.out, "%s:\n", g.Category)
+		for _, r := range g.Results {
+			f.formatVerboseResult(r, violationByID[r.Check.ID])
+		}
+	}
+	if result.FailFastTriggered {
+		_, _ = fmt.Fprintf(f.out, "\nExecution stopped early due to --fail-fast\n")
+	}
+	if len(result.HookFailures) > 0 {
+		_, _ = fmt.Fprintln(f.out)
+	}
+	f.formatHookFailures(result.HookFailures)
+	_, _ = fmt.Fprintf(f.out, "\n%s\n", FormatSummary(result.Summary()))
+	f.formatCategorySummary(result.Results)
+	f.formatDurations(result.Results)
+}
+
+// formatVerboseResult outputs the result of a check and, if it failed, its
+// violation v.
+func (f *Formatter) formatVerboseResult(r *orchestrator.CheckResult, v *orchestrator.Violation) {
+	if r.Passed {
+		status := "passed"
+		if r.Fixed {
+			status = "fixed"
+		} else if r.Execution.Cached {
+			status = "cached"
+		}
+		_, _ = fmt.Fprintf(f.out, "%s %-15s %s (%.1fs)\n",
+			f.colors.Pass("✓"), r.Check.ID, f.colors.Pass(status), r.Execution.Duration.Seconds())
+		if len(r.Check.Tags) > 0 {
+			_, _ = fmt.Fprintf(f.out, "  Tags: %s\n", strings.Join(r.Check.Tags, ", "))
+		}
+		if len(r.TriggeredPrompts) > 0 {
+			_, _ = fmt.Fprintln(f.out)
+			f.formatTriggeredPrompts(r.TriggeredPrompts)
+		}
+	} else if r.PathSkipped {
+		_, _ = fmt.Fprintln(f.out, f.colors.Skip(fmt.Sprintf("⊘ %-15s skipped (no changed files match paths)", r.Check.ID)))
+	} else if r.Execution.Cancelled {
+		_, _ = fmt.Fprintln(f.out, f.colors.Skip(fmt.Sprintf("⊘ %-15s cancelled", r.Check.ID)))
+	} else {
+		if v == nil {
+			// Fallback if no violation found (shouldn't happen)
+			_, _ = fmt.Fprintf(f.out, "%s %-15s %s (%.1fs)\n",
+				f.colors.Severity(config.SeverityError, "✗"), r.Check.ID, f.colors.Severity(config.SeverityError, "FAIL"), r.Execution.Duration.Seconds())
+			return
+		}
 
-			_, _ = fmt.Fprintf(f.out, "✗ %-15s %s (%.1fs)\n",
-				r.Check.ID, header, r.Execution.Duration.Seconds())
+		symbol := "✗"
+		if v.Severity == config.SeverityInfo {
+			symbol = "ℹ"
+		}
+		_, _ = fmt.Fprintf(f.out, "%s %-15s %s (%.1fs)\n",
+			f.colors.Severity(v.Severity, symbol), r.Check.ID, f.colors.Severity(v.Severity, violationHeader(v.Severity)), r.Execution.Duration.Seconds())
 
-			if len(r.Check.Tags) > 0 {
-				_, _ = fmt.Fprintf(f.out, "  Tags: %s\n", strings.Join(r.Check.Tags, ", "))
-			}
+		if v.Description != "" {
+			_, _ = fmt.Fprintf(f.out, "  %s\n", v.Description)
+		}
+		if len(r.Check.Tags) > 0 {
+			_, _ = fmt.Fprintf(f.out, "  Tags: %s\n", strings.Join(r.Check.Tags, ", "))
+		}
 
-			// Show suggestion if present (interpolated with extracted values)
-			if v.Suggestion != "" {
-				suggestion := config.InterpolateWithExtracted(
-					v.Suggestion,
-					nil,
-					v.Extracted,
-				)
-				_, _ = fmt.Fprintf(f.out, "  %s\n", suggestion)
-			}
+		// Show suggestion if present (interpolated with extracted values)
+		if v.Suggestion != "" {
+			suggestion := config.InterpolateWithExtracted(
+				v.Suggestion,
+				nil,
+				v.Extracted,
+			)
+			_, _ = fmt.Fprintf(f.out, "  %s\n", suggestion)
+		}
 
-			// Show fix if present, otherwise fallback to run command
-			if v.Fix != "" {
-				fix := config.InterpolateWithExtracted(
-					v.Fix,
-					nil,
-					v.Extracted,
-				)
-				_, _ = fmt.Fprintf(f.out, "  Fix: %s\n", fix)
-			} else if v.Suggestion == "" {
-				// Fallback: show command as fix when no suggestion and no fix
-				_, _ = fmt.Fprintf(f.out, "  Fix: %s\n", v.Command)
-			}
+		// Show fix if present, otherwise fallback to run command
+		if v.Fix != "" {
+			fix := config.InterpolateWithExtracted(
+				v.Fix,
+				nil,
+				v.Extracted,
+			)
+			_, _ = fmt.Fprintf(f.out, "  Fix: %s\n", fix)
+		} else if v.Suggestion == "" && v.Command != "" {
+			// Fallback: show command as fix when no suggestion and no fix
+			_, _ = fmt.Fprintf(f.out, "  Fix: %s\n", v.Command)
+		}
+		if v.FixAttempted {
+			_, _ = fmt.Fprintf(f.out, "  Auto-fix ran but the check still fails\n")
+		}
+		f.formatExplanation(v)
+
+		_, _ = fmt.Fprintf(f.out, "  Advisory: %s\n", violationAdvisory(v))
+	}
+}
+
+// formatCategorySummary outputs the counts of each category of checks, if
+// the checks have categories.
+func (f *Formatter) formatCategorySummary(results []*orchestrator.CheckResult) {
+	groups := orchestrator.GroupByCategory(results)
+	if groups == nil {
+		return
+	}
+	w := tabwriter.NewWriter(f.out, 0, 0, 2, ' ', 0)
+	for _, g := range groups {
+		_, _ = fmt.Fprintf(w, "  %s\t%s\n", g.Category, formatCategoryCounts(g.Summary()))
+	}
+	_ = w.Flush()
+}
+
+// formatCategoryCounts renders the counts of a category's checks, for
+// example "2 passed, 1 failed".
+func formatCategoryCounts(s orchestrator.Summary) string {
+	counts := fmt.Sprintf("%d passed", s.Passed)
+	if s.Failed > 0 {
+		counts += fmt.Sprintf(", %d failed", s.Failed)
+	}
+	if s.Skipped > 0 {
+		counts += fmt.Sprintf(", %d skipped", s.Skipped)
+	}
+	if s.Cancelled > 0 {
+		counts += fmt.Sprintf(", %d cancelled", s.Cancelled)
+	}
+	return counts
+}
+
+// formatHeader outputs the line that labels the run with its config's name
+// and description, if it has a name.
+func (f *Formatter) formatHeader(result *orchestrator.RunResult) {
+	if result.Name == "" {
+		return
+	}
+	header := result.Name
+	if result.Description != "" {
+		header += " — " + result.Description
+	}
+	_, _ = fmt.Fprintf(f.out, "%s\n\n", header)
+}
 
-			// Show advisory line
-			advisory := "blocks commit"
-			if v.Severity == config.SeverityWarning {
-				advisory = "does not block commit"
+// formatDurations outputs the duration of each check that ran, slowest
+// first, if the formatter sorts by duration. Skipped checks are left out.
+func (f *Formatter) formatDurations(results []*orchestrator.CheckResult) {
+	if !f.sortByDuration {
+		return
+	}
+	var ran []*orchestrator.CheckResult
+	for _, r := range results {
+		if !r.Skipped && r.Execution != nil {
+			ran = append(ran, r)
+		}
+	}
+	if len(ran) == 0 {
+		return
+	}
+	sort.SliceStable(ran, func(i, j int) bool {
+		return ran[i].Execution.Duration > ran[j].Execution.Duration
+	})
+
+	_, _ = fmt.Fprintf(f.out, "\nDurations:\n")
+	w := tabwriter.NewWriter(f.out, 0, 0, 2, ' ', 0)
+	for _, r := range ran {
+		note := ""
+		if r.Execution.Cached {
+			note = "\tcached"
+		} else if r.Execution.Timedout {
+			note = "\ttimeout"
+		}
+		_, _ = fmt.Fprintf(w, "  %s\t%.1fs%s\n", r.Check.ID, r.Execution.Duration.Seconds(), note)
+	}
+	_ = w.Flush()
+}
+
+// formatHookFailures outputs the after hooks that failed, with the tail of
+// their output. They are shown even in quiet mode, but don't fail the run.
+func (f *Formatter) formatHookFailures(failures []*orchestrator.HookFailure) {
+	for _, h := range failures {
+		_, _ = fmt.Fprintf(f.out, "HOOK  %s\n", h)
+		if out := tailLines(h.Output, OutputTailLines); out != "" {
+			for _, line := range strings.Split(out, "\n") {
+				_, _ = fmt.Fprintf(f.out, "  %s\n", line)
 			}
-			_, _ = fmt.Fprintf(f.out, "  Advisory: %s\n", advisory)
 		}
+		_, _ = fmt.Fprintln(f.out)
 	}
-	if result.FailFastTriggered {
-		_, _ = fmt.Fprintf(f.out, "\nExecution stopped early due to --fail-fast\n")
+}
+
+// FormatSummary renders a run summary as a single line, for example
+// "12 checks: 10 passed, 1 failed, 1 skipped (2 errors) in 8.3s (slowest: test 4.1s)".
+func FormatSummary(s orchestrator.Summary) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d %s: %d passed", s.Total, plural(s.Total, "check", "checks"), s.Passed)
+	if s.Cached > 0 {
+		fmt.Fprintf(&b, " (%d cached)", s.Cached)
+	}
+	if s.Failed > 0 {
+		fmt.Fprintf(&b, ", %d failed", s.Failed)
+	}
+	if s.Skipped > 0 {
+		fmt.Fprintf(&b, ", %d skipped", s.Skipped)
+	}
+	if s.Cancelled > 0 {
+		fmt.Fprintf(&b, ", %d cancelled", s.Cancelled)
+	}
+	// The violations by severity, which include those of skipped checks
+	var bySeverity []string
+	if s.Errors > 0 {
+		bySeverity = append(bySeverity, fmt.Sprintf("%d %s", s.Errors, plural(s.Errors, "error", "errors")))
+	}
+	if s.Warnings > 0 {
+		bySeverity = append(bySeverity, fmt.Sprintf("%d %s", s.Warnings, plural(s.Warnings, "warning", "warnings")))
+	}
+	if s.Infos > 0 {
+		bySeverity = append(bySeverity, fmt.Sprintf("%d info", s.Infos))
+	}
+	if len(bySeverity) > 0 {
+		fmt.Fprintf(&b, " (%s)", strings.Join(bySeverity, ", "))
+	}
+	fmt.Fprintf(&b, " in %.1fs", s.Duration.Seconds())
+	if s.SlowestID != "" {
+		fmt.Fprintf(&b, " (slowest: %s %.1fs)", s.SlowestID, s.Slowest.Seconds())
+	}
+	return b.String()
+}
+
+// plural returns singular if n is 1 and plural otherwise.
+func plural(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
 	}
+	return plural
 }
 
 // formatViolation outputs a single violation.
 func (f *Formatter) formatViolation(v *orchestrator.Violation) {
-	// Use WARN for warning severity, FAIL for everything else
-	header := "FAIL"
-	if v.Severity == config.SeverityWarning {
-		header = "WARN"
-	}
+	header := f.colors.Severity(v.Severity, violationHeader(v.Severity))
 
 	// Format the status info (timeout vs severity)
 	statusInfo := string(v.Severity)
@@ -139,7 +345,11 @@ func (f *Formatter) formatViolation(v *orchestrator.Violation) {
 		statusInfo = "timeout"
 	}
 
-	_, _ = fmt.Fprintf(f.out, "%s  %s (%s)\n\n", header, v.CheckID, statusInfo)
+	_, _ = fmt.Fprintf(f.out, "%s  %s (%s)\n", header, v.CheckID, statusInfo)
+	if v.Description != "" {
+		_, _ = fmt.Fprintf(f.out, "  %s\n", v.Description)
+	}
+	_, _ = fmt.Fprintln(f.out)
 
 	// Show suggestion if present (interpolated with extracted values)
 	if v.Suggestion != "" {
@@ -159,10 +369,14 @@ func (f *Formatter) formatViolation(v *orchestrator.Violation) {
 			v.Extracted,
 		)
 		_, _ = fmt.Fprintf(f.out, "  Fix: %s\n", fix)
-	} else if v.Suggestion == "" {
+	} else if v.Suggestion == "" && v.Command != "" {
 		// Fallback: show command as fix when no suggestion and no fix
 		_, _ = fmt.Fprintf(f.out, "  Fix: %s\n", v.Command)
 	}
+	if v.FixAttempted {
+		_, _ = fmt.Fprintf(f.out, "  Auto-fix ran but the check still fails\n")
+	}
+	f.formatExplanation(v)
 
 	// Show log file location if present
 	if v.LogFile != "" {
@@ -175,16 +389,92 @@ func (f *Formatter) formatViolation(v *orchestrator.Violation) {
 		f.formatTriggeredPrompts(v.TriggeredPrompts)
 	}
 
-	// Show advisory line
-	advisory := "blocks commit"
-	if v.Severity == config.SeverityWarning {
-		advisory = "does not block commit"
-	}
-	_, _ = fmt.Fprintf(f.out, "  Advisory: %s\n", advisory)
+	_, _ = fmt.Fprintf(f.out, "  Advisory: %s\n", violationAdvisory(v))
 
 	_, _ = fmt.Fprintln(f.out)
 }
 
+// violationHeader returns the label of a violation: WARN for warning
+// severity, INFO for info severity and FAIL for everything else.
+func violationHeader(severity config.Severity) string {
+	switch severity {
+	case config.SeverityWarning:
+		return "WARN"
+	case config.SeverityInfo:
+		return "INFO"
+	default:
+		return "FAIL"
+	}
+}
+
+// violationAdvisory describes whether a violation blocks a commit.
+func violationAdvisory(v *orchestrator.Violation) string {
+	switch {
+	case v.Severity == config.SeverityInfo:
+		return "informational only"
+	case v.AllowFailure:
+		return "does not block commit (allow_failure)"
+	case v.Known:
+		return "does not block commit (in baseline)"
+	case v.Severity == config.SeverityWarning:
+		return "does not block commit"
+	default:
+		return "blocks commit"
+	}
+}
+
+// formatExplanation outputs the failure explanation of a violation, if it
+// has one: the assertion and what it evaluated to, the values it used, the
+// captured values, the exit code and the tail of each output stream.
+func (f *Formatter) formatExplanation(v *orchestrator.Violation) {
+	e := v.Explanation
+	if e == nil {
+		return
+	}
+
+	_, _ = fmt.Fprintln(f.out, "  Explanation:")
+	if e.Assert != "" {
+		result := "not evaluated: the command failed"
+		if e.AssertEvaluated {
+			result = fmt.Sprintf("%t", e.AssertPassed)
+		}
+		_, _ = fmt.Fprintf(f.out, "    Assert: %s => %s\n", e.Assert, result)
+		for _, name := range sortedKeys(e.AssertValues) {
+			value := e.AssertValues[name]
+			if value == "" {
+				value = "(not captured)"
+			}
+			_, _ = fmt.Fprintf(f.out, "      %s = %s\n", name, value)
+		}
+	}
+	if len(v.Extracted) > 0 {
+		_, _ = fmt.Fprintln(f.out, "    Captured:")
+		for _, name := range sortedKeys(v.Extracted) {
+			_, _ = fmt.Fprintf(f.out, "      %s = %s\n", name, v.Extracted[name])
+		}
+	}
+	_, _ = fmt.Fprintf(f.out, "    Exit code: %d\n", e.ExitCode)
+	for _, stream := range []struct{ name, tail string }{{"stdout", e.Stdout}, {"stderr", e.Stderr}} {
+		if stream.tail == "" {
+			continue
+		}
+		_, _ = fmt.Fprintf(f.out, "    Last lines of %s:\n", stream.name)
+		for _, line := range strings.Split(stream.tail, "\n") {
+			_, _ = fmt.Fprintf(f.out, "      %s\n", line)
+		}
+	}
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // formatTriggeredPrompts outputs triggered prompts in a formatted list.
 func (f *Formatter) formatTriggeredPrompts(prompts []*orchestrator.TriggeredPrompt) {
 	if len(prompts) == 0 {
@@ -214,8 +504,9 @@ func (f *Formatter) formatTriggeredPrompts(prompts []*orchestrator.TriggeredProm
 	}
 }
 
-// truncateCommand shortens long commands for display.
-func truncateCommand(cmd string) string {
+// TruncateCommand collapses a command to a single line and shortens it to at
+// most 60 characters for display.
+func TruncateCommand(cmd string) string {
 	// Collapse multiline commands to single line
 	cmd = strings.ReplaceAll(cmd, "\n", " ")
 	cmd = strings.Join(strings.Fields(cmd), " ")
@@ -2,6 +2,7 @@ package output
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 	"time"
 
@@ -87,6 +88,43 @@ func TestFormatter_QuietMode_WithViolations(t *testing.T) {
 	}
 }
 
+func TestFormatter_ViolationDescription(t *testing.T) {
+	violation := &orchestrator.Violation{
+		CheckID:     "coverage",
+		Description: "Test coverage stays at or above 80%",
+		Severity:    config.SeverityError,
+		Command:     "go test -cover ./...",
+		Suggestion:  "Coverage is 72%, need 80%.",
+	}
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "coverage", Severity: config.SeverityError},
+				Execution: &executor.Result{Duration: 900 * time.Millisecond},
+				Passed:    false,
+			},
+		},
+		Violations: []*orchestrator.Violation{violation},
+		ExitCode:   1,
+	}
+
+	for _, verbose := range []bool{false, true} {
+		var buf bytes.Buffer
+		New(&buf, verbose).FormatResult(result)
+		if !strings.Contains(buf.String(), "  Test coverage stays at or above 80%\n") {
+			t.Errorf("verbose=%v: expected description in output, got: %q", verbose, buf.String())
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := FormatJSON(&buf, result); err != nil {
+		t.Fatalf("FormatJSON failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"description": "Test coverage stays at or above 80%"`) {
+		t.Errorf("expected description in JSON violation, got: %s", buf.String())
+	}
+}
+
 func TestFormatter_VerboseMode_AllPassing(t *testing.T) {
 	var buf bytes.Buffer
 	f := New(&buf, true) // verbose mode
@@ -124,6 +162,40 @@ func TestFormatter_VerboseMode_AllPassing(t *testing.T) {
 	}
 }
 
+func TestFormatter_Header(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		verbose     bool
+		want        string
+	}{
+		{name: "payments-api", description: "Checks for the payments service", verbose: true, want: "payments-api — Checks for the payments service\n\n✓ fmt"},
+		{name: "payments-api", verbose: true, want: "payments-api\n\n✓ fmt"},
+		{verbose: true, want: "✓ fmt"},
+		{name: "payments-api", want: ""}, // Silence is success
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		result := &orchestrator.RunResult{
+			Name:        tt.name,
+			Description: tt.description,
+			Results: []*orchestrator.CheckResult{
+				{
+					Check:     &config.Check{ID: "fmt"},
+					Execution: &executor.Result{Duration: 100 * time.Millisecond},
+					Passed:    true,
+				},
+			},
+		}
+		New(&buf, tt.verbose).FormatResult(result)
+
+		if !strings.HasPrefix(buf.String(), tt.want) || (tt.want == "") != (buf.Len() == 0) {
+			t.Errorf("name=%q verbose=%v: expected output starting with %q, got %q", tt.name, tt.verbose, tt.want, buf.String())
+		}
+	}
+}
+
 func TestFormatter_VerboseMode_WithFailure(t *testing.T) {
 	var buf bytes.Buffer
 	f := New(&buf, true) // verbose mode
@@ -218,6 +290,124 @@ func TestFormatter_VerboseMode_WithCancelledCheck(t *testing.T) {
 	}
 }
 
+func TestFormatter_VerboseMode_WithPathSkippedCheck(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, true) // verbose mode
+
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:       &config.Check{ID: "docs-lint"},
+				Execution:   &executor.Result{ExitCode: -1},
+				Skipped:     true,
+				PathSkipped: true,
+				SkipReason:  "Skipped: no changed files match paths",
+			},
+		},
+	}
+
+	f.FormatResult(result)
+
+	output := buf.String()
+	if !strings.Contains(output, "⊘ docs-lint") || !strings.Contains(output, "no changed files match paths") {
+		t.Errorf("expected path-skipped marker, got: %q", output)
+	}
+	if strings.Contains(output, "FAIL") {
+		t.Errorf("path-skipped check should not be reported as a failure, got: %q", output)
+	}
+}
+
+func TestFormatter_AutoFixResults(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:        &config.Check{ID: "fmt"},
+				Execution:    &executor.Result{Duration: 100 * time.Millisecond},
+				Passed:       true,
+				FixAttempted: true,
+				Fixed:        true,
+			},
+			{
+				Check:        &config.Check{ID: "lint"},
+				Execution:    &executor.Result{Duration: 100 * time.Millisecond},
+				FixAttempted: true,
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{
+				CheckID:      "lint",
+				Severity:     config.SeverityError,
+				Command:      "golangci-lint run",
+				Fix:          "golangci-lint run --fix",
+				FixAttempted: true,
+			},
+		},
+	}
+
+	for _, verbose := range []bool{false, true} {
+		var buf bytes.Buffer
+		New(&buf, verbose).FormatResult(result)
+		output := buf.String()
+
+		wantFixed := "FIXED  fmt"
+		if verbose {
+			wantFixed = "✓ fmt             fixed"
+		}
+		if !strings.Contains(output, wantFixed) {
+			t.Errorf("verbose=%v: expected %q, got: %q", verbose, wantFixed, output)
+		}
+		if !strings.Contains(output, "Auto-fix ran but the check still fails") {
+			t.Errorf("verbose=%v: expected still-failing note, got: %q", verbose, output)
+		}
+	}
+}
+
+func TestFormatter_Explanation(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "coverage"},
+				Execution: &executor.Result{Duration: 100 * time.Millisecond},
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{
+				CheckID:   "coverage",
+				Severity:  config.SeverityError,
+				Command:   "go test -cover ./...",
+				Extracted: map[string]string{"coverage": "72.5", "pkg": "./internal/config"},
+				Explanation: &orchestrator.FailureExplanation{
+					Assert:          "coverage >= 80",
+					AssertEvaluated: true,
+					AssertValues:    map[string]string{"coverage": "72.5"},
+					Stdout:          "ok  ./internal/config\ncoverage: 72.5% of statements",
+				},
+			},
+		},
+	}
+
+	want := []string{
+		"  Explanation:\n",
+		"    Assert: coverage >= 80 => false\n      coverage = 72.5\n",
+		"    Captured:\n      coverage = 72.5\n      pkg = ./internal/config\n",
+		"    Exit code: 0\n",
+		"    Last lines of stdout:\n      ok  ./internal/config\n      coverage: 72.5% of statements\n",
+	}
+	for _, verbose := range []bool{false, true} {
+		var buf bytes.Buffer
+		New(&buf, verbose).FormatResult(result)
+		output := buf.String()
+		for _, w := range want {
+			if !strings.Contains(output, w) {
+				t.Errorf("verbose=%v: expected %q, got: %q", verbose, w, output)
+			}
+		}
+		if strings.Contains(output, "Last lines of stderr") {
+			t.Errorf("verbose=%v: expected no empty stderr section, got: %q", verbose, output)
+		}
+	}
+}
+
 func TestFormatter_VerboseMode_FailFastTriggered(t *testing.T) {
 	var buf bytes.Buffer
 	f := New(&buf, true) // verbose mode
@@ -299,6 +489,45 @@ func TestFormatter_VerboseMode_WarningViolation(t *testing.T) {
 	}
 }
 
+func TestFormatter_QuietMode_InfoViolation(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, false)
+
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "todo", Severity: config.SeverityInfo},
+				Execution: &executor.Result{Duration: 100 * time.Millisecond},
+			},
+			{
+				Check:     &config.Check{ID: "vet", Severity: config.SeverityError},
+				Execution: &executor.Result{Duration: 100 * time.Millisecond},
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{CheckID: "todo", Severity: config.SeverityInfo, Suggestion: "TODOs remain"},
+			{CheckID: "vet", Severity: config.SeverityError, Suggestion: "go vet failed"},
+		},
+	}
+
+	f.FormatResult(result)
+	output := buf.String()
+
+	// Info violations are listed after the others, under their own heading
+	vet := strings.Index(output, "FAIL  vet (error)")
+	heading := strings.Index(output, "Info:\n")
+	todo := strings.Index(output, "INFO  todo (info)")
+	if vet < 0 || heading < 0 || todo < 0 || !(vet < heading && heading < todo) {
+		t.Errorf("expected error violation, then Info heading, then info violation, got: %q", output)
+	}
+	if !strings.Contains(output, "Advisory: informational only") {
+		t.Errorf("expected info advisory in output, got: %q", output)
+	}
+	if !strings.Contains(output, "2 failed (1 error, 1 info)") {
+		t.Errorf("expected info count in summary, got: %q", output)
+	}
+}
+
 func TestFormatter_QuietMode_FailFastTriggered(t *testing.T) {
 	var buf bytes.Buffer
 	f := New(&buf, false) // quiet mode
@@ -326,6 +555,24 @@ func TestFormatter_QuietMode_FailFastTriggered(t *testing.T) {
 	}
 }
 
+func TestFormatter_QuietMode_HookFailure(t *testing.T) {
+	var buf bytes.Buffer
+	f := New(&buf, false) // quiet mode
+
+	result := &orchestrator.RunResult{
+		HookFailures: []*orchestrator.HookFailure{
+			{Command: "docker compose down", ExitCode: 1, Output: "no such service\n"},
+		},
+	}
+
+	f.FormatResult(result)
+
+	want := "HOOK  after hook \"docker compose down\" failed with exit code 1\n  no such service\n\n"
+	if got := buf.String(); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
 func TestFormatter_QuietMode_WithTriggeredPrompts(t *testing.T) {
 	var buf bytes.Buffer
 	f := New(&buf, false) // quiet mode
@@ -585,9 +832,9 @@ func TestTruncateCommand(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := truncateCommand(tt.input)
+			got := TruncateCommand(tt.input)
 			if got != tt.expected {
-				t.Errorf("truncateCommand(%q) = %q, want %q", tt.input, got, tt.expected)
+				t.Errorf("TruncateCommand(%q) = %q, want %q", tt.input, got, tt.expected)
 			}
 		})
 	}
@@ -756,3 +1003,223 @@ func TestFormatViolation_OutputCombinations(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatSummary(t *testing.T) {
+	tests := []struct {
+		name    string
+		summary orchestrator.Summary
+		want    string
+	}{
+		{
+			name: "mixed",
+			summary: orchestrator.Summary{
+				Total: 12, Passed: 10, Failed: 1, Skipped: 1, Errors: 1,
+				Duration: 8300 * time.Millisecond, SlowestID: "test", Slowest: 4100 * time.Millisecond,
+			},
+			want: "12 checks: 10 passed, 1 failed, 1 skipped (1 error) in 8.3s (slowest: test 4.1s)",
+		},
+		{
+			name: "errors, warnings and cancelled",
+			summary: orchestrator.Summary{
+				Total: 5, Passed: 1, Failed: 3, Cancelled: 1, Errors: 1, Warnings: 2,
+				Duration: time.Second, SlowestID: "lint", Slowest: 900 * time.Millisecond,
+			},
+			want: "5 checks: 1 passed, 3 failed, 1 cancelled (1 error, 2 warnings) in 1.0s (slowest: lint 0.9s)",
+		},
+		{
+			name:    "single check",
+			summary: orchestrator.Summary{Total: 1, Passed: 1, SlowestID: "fmt", Slowest: 100 * time.Millisecond, Duration: 100 * time.Millisecond},
+			want:    "1 check: 1 passed in 0.1s (slowest: fmt 0.1s)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatSummary(tt.summary); got != tt.want {
+				t.Errorf("FormatSummary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatter_PrintsSummary(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "fmt"},
+				Execution: &executor.Result{Duration: 100 * time.Millisecond},
+				Passed:    true,
+			},
+			{
+				Check:     &config.Check{ID: "vet", Severity: config.SeverityError},
+				Execution: &executor.Result{Duration: 300 * time.Millisecond, ExitCode: 1},
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{CheckID: "vet", Severity: config.SeverityError, Command: "go vet ./..."},
+		},
+		Duration: 400 * time.Millisecond,
+		ExitCode: 1,
+	}
+	want := "2 checks: 1 passed, 1 failed (1 error) in 0.4s (slowest: vet 0.3s)"
+
+	for _, verbose := range []bool{false, true} {
+		var buf bytes.Buffer
+		New(&buf, verbose).FormatResult(result)
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("verbose=%v: expected summary %q, got:\n%s", verbose, want, buf.String())
+		}
+	}
+}
+
+func TestFormatter_GroupsByCategory(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "golangci", Category: config.CategoryLint},
+				Execution: &executor.Result{Duration: 100 * time.Millisecond},
+				Passed:    true,
+			},
+			{
+				Check:     &config.Check{ID: "test", Category: config.CategoryTest, Severity: config.SeverityError},
+				Execution: &executor.Result{Duration: 300 * time.Millisecond, ExitCode: 1},
+			},
+			{
+				Check:     &config.Check{ID: "build"},
+				Execution: &executor.Result{Duration: 200 * time.Millisecond},
+				Passed:    true,
+			},
+			{
+				Check:     &config.Check{ID: "vet", Category: config.CategoryLint},
+				Execution: &executor.Result{Duration: 100 * time.Millisecond},
+				Passed:    true,
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{CheckID: "test", Severity: config.SeverityError, Suggestion: "Fix the failing tests"},
+		},
+		Duration: 300 * time.Millisecond,
+		ExitCode: 1,
+	}
+
+	var buf bytes.Buffer
+	New(&buf, true).FormatResult(result)
+	want := `lint:
+✓ golangci        passed (0.1s)
+✓ vet             passed (0.1s)
+
+test:
+✗ test            FAIL (0.3s)
+  Fix the failing tests
+  Advisory: blocks commit
+
+uncategorized:
+✓ build           passed (0.2s)
+
+4 checks: 3 passed, 1 failed (1 error) in 0.3s (slowest: test 0.3s)
+  lint           2 passed
+  test           0 passed, 1 failed
+  uncategorized  1 passed
+`
+	if buf.String() != want {
+		t.Errorf("expected output:\n%s\ngot:\n%s", want, buf.String())
+	}
+
+	// Without categories, the results keep their order and the summary is a
+	// single line
+	for _, r := range result.Results {
+		r.Check.Category = ""
+	}
+	buf.Reset()
+	New(&buf, true).FormatResult(result)
+	if strings.Contains(buf.String(), "uncategorized") || !strings.HasPrefix(buf.String(), "✓ golangci") {
+		t.Errorf("expected ungrouped output, got:\n%s", buf.String())
+	}
+}
+
+func TestFormatter_SortByDuration(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "fmt"},
+				Execution: &executor.Result{Duration: 100 * time.Millisecond},
+				Passed:    true,
+			},
+			{
+				Check:     &config.Check{ID: "test"},
+				Execution: &executor.Result{Duration: 4100 * time.Millisecond},
+				Passed:    true,
+			},
+			{
+				Check:     &config.Check{ID: "build"},
+				Execution: &executor.Result{ExitCode: -1},
+				Skipped:   true,
+			},
+			{
+				Check:     &config.Check{ID: "vet"},
+				Execution: &executor.Result{Duration: 300 * time.Millisecond},
+				Passed:    true,
+			},
+		},
+		Duration: 4200 * time.Millisecond,
+	}
+
+	// Even when every check passes, the summary and durations are shown,
+	// slowest first and without skipped checks
+	var buf bytes.Buffer
+	f := New(&buf, false)
+	f.SetSortByDuration(true)
+	f.FormatResult(result)
+
+	want := "4 checks: 3 passed, 1 skipped in 4.2s (slowest: test 4.1s)\n" +
+		"\nDurations:\n" +
+		"  test  4.1s\n" +
+		"  vet   0.3s\n" +
+		"  fmt   0.1s\n"
+	if got := buf.String(); got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestFormatter_Color(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{Check: &config.Check{ID: "fmt"}, Execution: &executor.Result{}, Passed: true},
+			{Check: &config.Check{ID: "lint", Severity: config.SeverityError}, Execution: &executor.Result{}},
+			{Check: &config.Check{ID: "docs", Severity: config.SeverityWarning}, Execution: &executor.Result{}},
+			{Check: &config.Check{ID: "e2e"}, Execution: &executor.Result{}, PathSkipped: true},
+		},
+		Violations: []*orchestrator.Violation{
+			{CheckID: "lint", Severity: config.SeverityError, Command: "golangci-lint run"},
+			{CheckID: "docs", Severity: config.SeverityWarning, Command: "make docs"},
+		},
+		ExitCode: 1,
+	}
+
+	var buf bytes.Buffer
+	f := New(&buf, true)
+	f.SetColor(true)
+	f.FormatResult(result)
+	out := buf.String()
+	for _, want := range []string{
+		ansiGreen + "✓" + ansiReset,
+		ansiRed + "FAIL" + ansiReset,
+		ansiYellow + "WARN" + ansiReset,
+		ansiDim + "⊘ e2e",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in colored output, got %q", want, out)
+		}
+	}
+
+	// Without colors, as with --no-color, there are no escape codes
+	for _, verbose := range []bool{false, true} {
+		buf.Reset()
+		f := New(&buf, verbose)
+		f.SetColor(false)
+		f.FormatResult(result)
+		if strings.Contains(buf.String(), "\x1b[") {
+			t.Errorf("verbose=%v: expected no escape codes, got %q", verbose, buf.String())
+		}
+	}
+}
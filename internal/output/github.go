@@ -0,0 +1,74 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+// FormatGitHub outputs each violation as a GitHub Actions workflow command,
+// which Actions shows as an annotation on the run and, when it has a
+// location, inline on the changed file.
+//
+// Mapping:
+//   - Error severity → ::error, warning or allow_failure → ::warning, info
+//     and baselined violations → ::notice
+//   - The check ID becomes the annotation title
+//   - The suggestion, interpolated with extracted values, becomes the message
+//   - Grok captures named file, line and column become the file, line and
+//     col properties; without a file the annotation applies to the whole run
+func FormatGitHub(out io.Writer, result *orchestrator.RunResult) error {
+	for _, v := range result.Violations {
+		props := githubLocation(v.Extracted)
+		props = append(props, "title="+githubEscapeProperty(v.CheckID))
+		if _, err := fmt.Fprintf(out, "::%s %s::%s\n", githubLevel(v), strings.Join(props, ","), githubEscapeData(sarifMessage(v))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// githubLevel maps a violation to a workflow command name.
+func githubLevel(v *orchestrator.Violation) string {
+	switch {
+	case v.Known || v.Severity == config.SeverityInfo:
+		return "notice"
+	case v.AllowFailure || v.Severity == config.SeverityWarning:
+		return "warning"
+	default:
+		return "error"
+	}
+}
+
+// githubLocation returns the file, line and col properties for grok-captured
+// location values. It returns nil when no file was captured.
+func githubLocation(extracted map[string]string) []string {
+	file := extracted[sarifFileKey]
+	if file == "" {
+		return nil
+	}
+
+	props := []string{"file=" + githubEscapeProperty(strings.TrimPrefix(filepath.ToSlash(file), "./"))}
+	if line, err := strconv.Atoi(extracted[sarifLineKey]); err == nil && line > 0 {
+		props = append(props, "line="+strconv.Itoa(line))
+		if col, err := strconv.Atoi(extracted[sarifColumnKey]); err == nil && col > 0 {
+			props = append(props, "col="+strconv.Itoa(col))
+		}
+	}
+	return props
+}
+
+// githubEscapeData escapes a workflow command message.
+func githubEscapeData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// githubEscapeProperty escapes a workflow command property value.
+func githubEscapeProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
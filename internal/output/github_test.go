@@ -0,0 +1,45 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+func TestFormatGitHub(t *testing.T) {
+	var buf bytes.Buffer
+	if err := FormatGitHub(&buf, sarifTestResult()); err != nil {
+		t.Fatalf("FormatGitHub failed: %v", err)
+	}
+
+	want := "::error file=internal/config/config.go,line=42,col=7,title=vet::Fix the unreachable code reported by go vet\n" +
+		"::warning title=coverage::Coverage is 72%25, need 80%25\n" +
+		"::error title=slow::Check \"slow\" timed out\n"
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected annotations:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFormatGitHub_Levels(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Violations: []*orchestrator.Violation{
+			{CheckID: "todo", Severity: config.SeverityInfo, Suggestion: "Resolve TODOs"},
+			{CheckID: "lint", Severity: config.SeverityError, Suggestion: "Fix lint", AllowFailure: true},
+			{CheckID: "vet", Severity: config.SeverityError, Suggestion: "line one\nline two", Known: true},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := FormatGitHub(&buf, result); err != nil {
+		t.Fatalf("FormatGitHub failed: %v", err)
+	}
+
+	want := "::notice title=todo::Resolve TODOs\n" +
+		"::warning title=lint::Fix lint\n" +
+		"::notice title=vet::line one%0Aline two\n"
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected annotations:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,124 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+// severityColors are the node fill colors used for each severity.
+var severityColors = map[config.Severity]string{
+	config.SeverityError:   "#f8d7da",
+	config.SeverityWarning: "#fff3cd",
+	config.SeverityInfo:    "#d1ecf1",
+}
+
+// FormatDOT outputs the dependency graph of checks as a Graphviz digraph.
+// Edges point from a required check to the checks that require it, with
+// optional_requires edges dashed and requires_any edges labeled "any". Nodes are colored by severity, and checks
+// in the same execution level share a rank.
+func FormatDOT(out io.Writer, checks []config.Check) error {
+	graph, err := orchestrator.BuildGraph(checks)
+	if err != nil {
+		return fmt.Errorf("failed to build dependency graph: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph vibeguard {\n")
+	b.WriteString("  node [shape=box, style=\"rounded,filled\"];\n")
+
+	for i, level := range graph.Levels() {
+		quoted := make([]string, len(level))
+		for j, id := range level {
+			quoted[j] = strconv.Quote(id)
+		}
+		fmt.Fprintf(&b, "\n  // Level %d\n", i+1)
+		fmt.Fprintf(&b, "  { rank=same; %s; }\n", strings.Join(quoted, "; "))
+	}
+
+	b.WriteString("\n")
+	for _, check := range checks {
+		fmt.Fprintf(&b, "  %s [fillcolor=%q, tooltip=%q];\n", strconv.Quote(check.ID), severityColors[check.Severity], string(check.Severity))
+	}
+
+	b.WriteString("\n")
+	for _, check := range checks {
+		for _, dep := range check.Requires {
+			fmt.Fprintf(&b, "  %s -> %s;\n", strconv.Quote(dep), strconv.Quote(check.ID))
+		}
+		for _, dep := range check.OptionalRequires {
+			if hasCheck(checks, dep) {
+				fmt.Fprintf(&b, "  %s -> %s [style=dashed];\n", strconv.Quote(dep), strconv.Quote(check.ID))
+			}
+		}
+		for _, dep := range check.RequiresAny {
+			if hasCheck(checks, dep) {
+				fmt.Fprintf(&b, "  %s -> %s [label=\"any\"];\n", strconv.Quote(dep), strconv.Quote(check.ID))
+			}
+		}
+	}
+	b.WriteString("}\n")
+
+	_, err = io.WriteString(out, b.String())
+	return err
+}
+
+// FormatMermaid outputs the dependency graph of checks as a Mermaid
+// flowchart, with edges from a required check to the checks that require
+// it (dotted for optional_requires, labeled "any" for requires_any) and one
+// class per severity.
+func FormatMermaid(out io.Writer, checks []config.Check) error {
+	if _, err := orchestrator.BuildGraph(checks); err != nil {
+		return fmt.Errorf("failed to build dependency graph: %w", err)
+	}
+
+	// Check IDs may collide with Mermaid keywords such as "end", so nodes
+	// get positional IDs and carry the check ID as their label
+	nodeID := make(map[string]string, len(checks))
+	for i, check := range checks {
+		nodeID[check.ID] = fmt.Sprintf("c%d", i)
+	}
+
+	var b strings.Builder
+	b.WriteString("flowchart TD\n")
+	for _, check := range checks {
+		fmt.Fprintf(&b, "  %s[%q]:::%s\n", nodeID[check.ID], check.ID, check.Severity)
+	}
+	for _, check := range checks {
+		for _, dep := range check.Requires {
+			fmt.Fprintf(&b, "  %s --> %s\n", nodeID[dep], nodeID[check.ID])
+		}
+		for _, dep := range check.OptionalRequires {
+			if hasCheck(checks, dep) {
+				fmt.Fprintf(&b, "  %s -.-> %s\n", nodeID[dep], nodeID[check.ID])
+			}
+		}
+		for _, dep := range check.RequiresAny {
+			if hasCheck(checks, dep) {
+				fmt.Fprintf(&b, "  %s -->|any| %s\n", nodeID[dep], nodeID[check.ID])
+			}
+		}
+	}
+	for _, severity := range config.Severities {
+		fmt.Fprintf(&b, "  classDef %s fill:%s\n", severity, severityColors[severity])
+	}
+
+	_, err := io.WriteString(out, b.String())
+	return err
+}
+
+// hasCheck reports whether checks contains a check with the given ID.
+// Optional requires and requires_any may name checks that are not part of
+// the graph.
+func hasCheck(checks []config.Check, id string) bool {
+	for _, check := range checks {
+		if check.ID == id {
+			return true
+		}
+	}
+	return false
+}
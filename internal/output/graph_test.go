@@ -0,0 +1,109 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+// diamondChecks returns a diamond: fmt and vet both require base, and test
+// requires fmt and vet.
+func diamondChecks() []config.Check {
+	return []config.Check{
+		{ID: "base", Run: "true", Severity: config.SeverityError},
+		{ID: "fmt", Run: "true", Severity: config.SeverityWarning, Requires: []string{"base"}},
+		{ID: "vet", Run: "true", Severity: config.SeverityError, Requires: []string{"base"}},
+		{ID: "test", Run: "true", Severity: config.SeverityInfo, Requires: []string{"fmt", "vet"}},
+	}
+}
+
+func TestFormatDOT_Diamond(t *testing.T) {
+	var buf bytes.Buffer
+	if err := FormatDOT(&buf, diamondChecks()); err != nil {
+		t.Fatalf("FormatDOT failed: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"digraph vibeguard {",
+		`"base" -> "fmt";`,
+		`"base" -> "vet";`,
+		`"fmt" -> "test";`,
+		`"vet" -> "test";`,
+		`{ rank=same; "base"; }`,
+		`{ rank=same; "fmt"; "vet"; }`,
+		`{ rank=same; "test"; }`,
+		`"fmt" [fillcolor="#fff3cd", tooltip="warning"];`,
+		`"vet" [fillcolor="#f8d7da", tooltip="error"];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected DOT output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if got := strings.Count(out, "->"); got != 4 {
+		t.Errorf("expected 4 edges, got %d:\n%s", got, out)
+	}
+}
+
+func TestFormatMermaid_Diamond(t *testing.T) {
+	var buf bytes.Buffer
+	if err := FormatMermaid(&buf, diamondChecks()); err != nil {
+		t.Fatalf("FormatMermaid failed: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"flowchart TD",
+		`c0["base"]:::error`,
+		`c3["test"]:::info`,
+		"c0 --> c1",
+		"c0 --> c2",
+		"c1 --> c3",
+		"c2 --> c3",
+		"classDef warning fill:#fff3cd",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected Mermaid output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestFormatGraph_OptionalRequires(t *testing.T) {
+	checks := []config.Check{
+		{ID: "test", Run: "true", Severity: config.SeverityError},
+		{ID: "report", Run: "true", Severity: config.SeverityInfo, OptionalRequires: []string{"test", "absent"}},
+	}
+
+	var dot bytes.Buffer
+	if err := FormatDOT(&dot, checks); err != nil {
+		t.Fatalf("FormatDOT failed: %v", err)
+	}
+	if !strings.Contains(dot.String(), `"test" -> "report" [style=dashed];`) {
+		t.Errorf("expected dashed edge in DOT output:\n%s", dot.String())
+	}
+	if strings.Contains(dot.String(), "absent") {
+		t.Errorf("expected absent optional requirement to be omitted:\n%s", dot.String())
+	}
+
+	var mermaid bytes.Buffer
+	if err := FormatMermaid(&mermaid, checks); err != nil {
+		t.Fatalf("FormatMermaid failed: %v", err)
+	}
+	if !strings.Contains(mermaid.String(), "c0 -.-> c1") {
+		t.Errorf("expected dotted edge in Mermaid output:\n%s", mermaid.String())
+	}
+}
+
+func TestFormatDOT_Cycle(t *testing.T) {
+	checks := []config.Check{
+		{ID: "a", Run: "true", Requires: []string{"b"}},
+		{ID: "b", Run: "true", Requires: []string{"a"}},
+	}
+	var buf bytes.Buffer
+	err := FormatDOT(&buf, checks)
+	if err == nil || !strings.Contains(err.Error(), "a -> b -> a") {
+		t.Errorf("expected cycle error, got: %v", err)
+	}
+}
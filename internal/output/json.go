@@ -3,25 +3,106 @@ package output
 import (
 	"encoding/json"
 	"io"
+	"strings"
+	"time"
 
+	"github.com/vibeguard/vibeguard/internal/config"
 	"github.com/vibeguard/vibeguard/internal/orchestrator"
 )
 
+// JSONSchemaVersion identifies the layout of JSONOutput. It is incremented
+// whenever a field is removed, renamed, or changes meaning; adding new
+// optional fields does not change the version.
+const JSONSchemaVersion = "1"
+
+// OutputTailLines is the maximum number of trailing stdout/stderr lines
+// included for each check in JSON output.
+const OutputTailLines = 20
+
 // JSONOutput represents the JSON output format.
 type JSONOutput struct {
-	Checks            []JSONCheck     `json:"checks"`
-	Violations        []JSONViolation `json:"violations"`
-	ExitCode          int             `json:"exit_code"`
-	FailFastTriggered bool            `json:"fail_fast_triggered,omitempty"`
+	SchemaVersion     string            `json:"schema_version"`
+	Metadata          *JSONMetadata     `json:"metadata,omitempty"`
+	Checks            []JSONCheck       `json:"checks"`
+	Violations        []JSONViolation   `json:"violations"`
+	DurationMS        int64             `json:"duration_ms"`
+	ExitCode          int               `json:"exit_code"`
+	FailFastTriggered bool              `json:"fail_fast_triggered,omitempty"`
+	HookFailures      []JSONHookFailure `json:"hook_failures,omitempty"`
+	Summary           JSONSummary       `json:"summary"`
+}
+
+// JSONMetadata labels a run with its config's name and description, and
+// records the config file and git commit it was made from.
+type JSONMetadata struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	ConfigPath  string `json:"config_path,omitempty"`
+	ConfigHash  string `json:"config_hash,omitempty"`
+	GitCommit   string `json:"git_commit,omitempty"`
+	GitBranch   string `json:"git_branch,omitempty"`
+}
+
+// JSONHookFailure represents a failed after hook in JSON format.
+type JSONHookFailure struct {
+	Command    string `json:"command"`
+	ExitCode   int    `json:"exit_code"`
+	OutputTail string `json:"output_tail,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// JSONSummary represents the aggregate run statistics in JSON format.
+type JSONSummary struct {
+	Total     int               `json:"total"`
+	Passed    int               `json:"passed"`
+	Failed    int               `json:"failed"`
+	Skipped   int               `json:"skipped"`
+	Cancelled int               `json:"cancelled"`
+	Cached    int               `json:"cached,omitempty"`
+	Errors    int               `json:"errors"`
+	Warnings  int               `json:"warnings"`
+	Info      int               `json:"info,omitempty"`
+	Slowest   *JSONSlowestCheck `json:"slowest,omitempty"`
+
+	// Categories counts the checks of each category, in the order of the
+	// text output; omitted if no check has a category
+	Categories []JSONCategorySummary `json:"categories,omitempty"`
+}
+
+// JSONCategorySummary counts the results of the checks of one category.
+type JSONCategorySummary struct {
+	Category  string `json:"category"`
+	Total     int    `json:"total"`
+	Passed    int    `json:"passed"`
+	Failed    int    `json:"failed"`
+	Skipped   int    `json:"skipped"`
+	Cancelled int    `json:"cancelled"`
+}
+
+// JSONSlowestCheck identifies the check that took longest to run.
+type JSONSlowestCheck struct {
+	ID         string `json:"id"`
+	DurationMS int64  `json:"duration_ms"`
 }
 
 // JSONCheck represents a check result in JSON format.
 type JSONCheck struct {
 	ID               string                 `json:"id"`
+	Category         string                 `json:"category,omitempty"`
 	Tags             []string               `json:"tags,omitempty"`
 	Status           string                 `json:"status"`
+	Passed           bool                   `json:"passed"`
+	ExitCode         int                    `json:"exit_code"`
+	Timedout         bool                   `json:"timedout"`
 	DurationMS       int64                  `json:"duration_ms"`
+	StartedAt        *time.Time             `json:"started_at,omitempty"`  // Unset for checks that didn't run
+	FinishedAt       *time.Time             `json:"finished_at,omitempty"` // Unset for checks that didn't run
+	StdoutTail       string                 `json:"stdout_tail,omitempty"`
+	StderrTail       string                 `json:"stderr_tail,omitempty"`
 	TriggeredPrompts []*JSONTriggeredPrompt `json:"triggered_prompts,omitempty"`
+	FixAttempted     bool                   `json:"fix_attempted,omitempty"`
+	Fixed            bool                   `json:"fixed,omitempty"`
+	Cached           bool                   `json:"cached,omitempty"`
 }
 
 // JSONTriggeredPrompt represents a triggered prompt in JSON format.
@@ -34,27 +115,137 @@ type JSONTriggeredPrompt struct {
 // JSONViolation represents a violation in JSON format.
 type JSONViolation struct {
 	ID               string                 `json:"id"`
+	Description      string                 `json:"description,omitempty"`
 	Severity         string                 `json:"severity"`
 	Command          string                 `json:"command"`
 	Suggestion       string                 `json:"suggestion,omitempty"`
 	Fix              string                 `json:"fix,omitempty"`
 	Extracted        map[string]string      `json:"extracted,omitempty"`
+	Timedout         bool                   `json:"timedout"`
 	LogFile          string                 `json:"log_file,omitempty"`
 	TriggeredPrompts []*JSONTriggeredPrompt `json:"triggered_prompts,omitempty"`
+	FixAttempted     bool                   `json:"fix_attempted,omitempty"`
+	AllowFailure     bool                   `json:"allow_failure,omitempty"`
+	Known            bool                   `json:"known,omitempty"`
+	Explanation      *JSONExplanation       `json:"explanation,omitempty"`
+	GrokUnmatched    []string               `json:"grok_unmatched,omitempty"`
+}
+
+// JSONExplanation represents a failure explanation (--explain-failures) in
+// JSON format. AssertResult is unset if the assertion wasn't evaluated.
+type JSONExplanation struct {
+	Assert       string            `json:"assert,omitempty"`
+	AssertResult *bool             `json:"assert_result,omitempty"`
+	AssertValues map[string]string `json:"assert_values,omitempty"`
+	ExitCode     int               `json:"exit_code"`
+	StdoutTail   string            `json:"stdout_tail,omitempty"`
+	StderrTail   string            `json:"stderr_tail,omitempty"`
 }
 
 // FormatJSON outputs the result in JSON format.
 func FormatJSON(out io.Writer, result *orchestrator.RunResult) error {
+	return writeJSON(out, newJSONOutput(result))
+}
+
+// ConfigResult is the result of running one of the config files that
+// check --recursive found.
+type ConfigResult struct {
+	Config string // Path of the config file, relative to the directory searched
+	Result *orchestrator.RunResult
+}
+
+// JSONRecursiveOutput represents the JSON output of check --recursive: one
+// report per config file, with the totals of all of them.
+type JSONRecursiveOutput struct {
+	SchemaVersion string             `json:"schema_version"`
+	Configs       []JSONConfigReport `json:"configs"`
+	DurationMS    int64              `json:"duration_ms"`
+	ExitCode      int                `json:"exit_code"` // The highest exit code of any config
+	Summary       JSONSummary        `json:"summary"`
+}
+
+// JSONConfigReport is the report of one config file in JSONRecursiveOutput.
+type JSONConfigReport struct {
+	Config string     `json:"config"`
+	Report JSONOutput `json:"report"`
+}
+
+// FormatRecursiveJSON outputs the results of several config files in JSON
+// format, with exitCode as the overall exit code.
+func FormatRecursiveJSON(out io.Writer, results []ConfigResult, exitCode int) error {
+	output := JSONRecursiveOutput{
+		SchemaVersion: JSONSchemaVersion,
+		Configs:       make([]JSONConfigReport, 0, len(results)),
+		ExitCode:      exitCode,
+	}
+	var summary orchestrator.Summary
+	for _, r := range results {
+		output.Configs = append(output.Configs, JSONConfigReport{Config: r.Config, Report: newJSONOutput(r.Result)})
+		summary.Add(r.Result.Summary())
+	}
+	output.DurationMS = summary.Duration.Milliseconds()
+	output.Summary = newJSONSummary(summary)
+	return writeJSON(out, output)
+}
+
+// writeJSON writes v as indented JSON.
+func writeJSON(out io.Writer, v any) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// newJSONOutput converts a run result to its JSON form.
+func newJSONOutput(result *orchestrator.RunResult) JSONOutput {
 	output := JSONOutput{
+		SchemaVersion:     JSONSchemaVersion,
+		DurationMS:        result.Duration.Milliseconds(),
 		Checks:            make([]JSONCheck, 0, len(result.Results)),
 		Violations:        make([]JSONViolation, 0, len(result.Violations)),
 		ExitCode:          result.ExitCode,
 		FailFastTriggered: result.FailFastTriggered,
+		Summary:           newJSONSummary(result.Summary()),
+	}
+	metadata := JSONMetadata{
+		Name:        result.Name,
+		Description: result.Description,
+		ConfigPath:  result.Provenance.ConfigPath,
+		ConfigHash:  result.Provenance.ConfigHash,
+		GitCommit:   result.Provenance.GitCommit,
+		GitBranch:   result.Provenance.GitBranch,
+	}
+	if metadata != (JSONMetadata{}) {
+		output.Metadata = &metadata
+	}
+	for _, g := range orchestrator.GroupByCategory(result.Results) {
+		s := g.Summary()
+		output.Summary.Categories = append(output.Summary.Categories, JSONCategorySummary{
+			Category:  g.Category,
+			Total:     s.Total,
+			Passed:    s.Passed,
+			Failed:    s.Failed,
+			Skipped:   s.Skipped,
+			Cancelled: s.Cancelled,
+		})
+	}
+
+	for _, h := range result.HookFailures {
+		jh := JSONHookFailure{
+			Command:    h.Command,
+			ExitCode:   h.ExitCode,
+			OutputTail: tailLines(h.Output, OutputTailLines),
+		}
+		if h.Err != nil {
+			jh.Error = h.Err.Error()
+		}
+		output.HookFailures = append(output.HookFailures, jh)
 	}
 
 	for _, r := range result.Results {
 		status := "passed"
-		if r.Execution.Cancelled {
+		if r.Skipped {
+			status = "skipped"
+		} else if r.Execution.Cancelled {
 			status = "cancelled"
 		} else if !r.Passed {
 			status = "failed"
@@ -72,10 +263,21 @@ func FormatJSON(out io.Writer, result *orchestrator.RunResult) error {
 
 		output.Checks = append(output.Checks, JSONCheck{
 			ID:               r.Check.ID,
+			Category:         r.Check.Category,
 			Tags:             r.Check.Tags,
 			Status:           status,
+			Passed:           r.Passed,
+			ExitCode:         r.Execution.ExitCode,
+			Timedout:         r.Execution.Timedout,
 			DurationMS:       r.Execution.Duration.Milliseconds(),
+			StartedAt:        timeOrNil(r.Execution.StartTime),
+			FinishedAt:       timeOrNil(r.Execution.EndTime),
+			StdoutTail:       tailLines(r.Execution.Stdout, OutputTailLines),
+			StderrTail:       tailLines(r.Execution.Stderr, OutputTailLines),
 			TriggeredPrompts: jsonPrompts,
+			FixAttempted:     r.FixAttempted,
+			Fixed:            r.Fixed,
+			Cached:           r.Execution.Cached,
 		})
 	}
 
@@ -92,17 +294,81 @@ func FormatJSON(out io.Writer, result *orchestrator.RunResult) error {
 
 		output.Violations = append(output.Violations, JSONViolation{
 			ID:               v.CheckID,
+			Description:      v.Description,
 			Severity:         string(v.Severity),
 			Command:          v.Command,
-			Suggestion:       v.Suggestion,
-			Fix:              v.Fix,
+			Suggestion:       config.InterpolateWithExtracted(v.Suggestion, nil, v.Extracted),
+			Fix:              config.InterpolateWithExtracted(v.Fix, nil, v.Extracted),
 			Extracted:        v.Extracted,
+			Timedout:         v.Timedout,
 			LogFile:          v.LogFile,
 			TriggeredPrompts: jsonPrompts,
+			FixAttempted:     v.FixAttempted,
+			AllowFailure:     v.AllowFailure,
+			Known:            v.Known,
+			Explanation:      newJSONExplanation(v.Explanation),
+			GrokUnmatched:    v.GrokUnmatched,
 		})
 	}
+	return output
+}
 
-	encoder := json.NewEncoder(out)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(output)
+// newJSONExplanation converts a failure explanation to its JSON form, or
+// returns nil if there is none.
+func newJSONExplanation(e *orchestrator.FailureExplanation) *JSONExplanation {
+	if e == nil {
+		return nil
+	}
+	explanation := &JSONExplanation{
+		Assert:       e.Assert,
+		AssertValues: e.AssertValues,
+		ExitCode:     e.ExitCode,
+		StdoutTail:   e.Stdout,
+		StderrTail:   e.Stderr,
+	}
+	if e.AssertEvaluated {
+		passed := e.AssertPassed
+		explanation.AssertResult = &passed
+	}
+	return explanation
+}
+
+// newJSONSummary converts a run summary to its JSON form.
+func newJSONSummary(s orchestrator.Summary) JSONSummary {
+	summary := JSONSummary{
+		Total:     s.Total,
+		Passed:    s.Passed,
+		Failed:    s.Failed,
+		Skipped:   s.Skipped,
+		Cancelled: s.Cancelled,
+		Cached:    s.Cached,
+		Errors:    s.Errors,
+		Warnings:  s.Warnings,
+		Info:      s.Infos,
+	}
+	if s.SlowestID != "" {
+		summary.Slowest = &JSONSlowestCheck{ID: s.SlowestID, DurationMS: s.Slowest.Milliseconds()}
+	}
+	return summary
+}
+
+// timeOrNil returns a pointer to t, or nil if t is zero.
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// tailLines returns the last n lines of s, without a trailing newline.
+func tailLines(s string, n int) string {
+	s = strings.TrimRight(s, "\n")
+	if s == "" {
+		return ""
+	}
+	lines := strings.Split(s, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
 }
@@ -3,6 +3,9 @@ package output
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -152,6 +155,82 @@ func TestFormatJSON_DurationInMilliseconds(t *testing.T) {
 	}
 }
 
+func TestFormatJSON_StartAndFinishTimes(t *testing.T) {
+	var buf bytes.Buffer
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "test"},
+				Execution: &executor.Result{StartTime: start, EndTime: start.Add(1500 * time.Millisecond), Duration: 1500 * time.Millisecond},
+				Passed:    true,
+			},
+			{
+				Check:     &config.Check{ID: "skipped"},
+				Execution: &executor.Result{ExitCode: -1},
+				Skipped:   true,
+			},
+		},
+	}
+
+	if err := FormatJSON(&buf, result); err != nil {
+		t.Fatalf("FormatJSON failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"started_at": "2026-01-02T03:04:05Z"`) || !strings.Contains(buf.String(), `"finished_at": "2026-01-02T03:04:06.5Z"`) {
+		t.Errorf("expected started_at and finished_at for test, got:\n%s", buf.String())
+	}
+
+	var output JSONOutput
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("failed to unmarshal output: %v", err)
+	}
+	if skipped := output.Checks[1]; skipped.StartedAt != nil || skipped.FinishedAt != nil || skipped.DurationMS != 0 {
+		t.Errorf("expected no times and zero duration for a skipped check, got %+v", skipped)
+	}
+}
+
+func TestFormatJSON_DependencySkippedStatus(t *testing.T) {
+	var buf bytes.Buffer
+
+	// e requires b, which failed
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "b", Severity: config.SeverityError},
+				Execution: &executor.Result{ExitCode: 1},
+			},
+			{
+				Check:      &config.Check{ID: "e", Severity: config.SeverityError, Requires: []string{"b"}},
+				Execution:  &executor.Result{ExitCode: -1},
+				Skipped:    true,
+				SkipReason: "Skipped: required dependency failed",
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{CheckID: "b", Severity: config.SeverityError},
+			{CheckID: "e", Severity: config.SeverityError, Suggestion: "Skipped: required dependency failed"},
+		},
+		ExitCode: 1,
+	}
+
+	if err := FormatJSON(&buf, result); err != nil {
+		t.Fatalf("FormatJSON failed: %v", err)
+	}
+	var output JSONOutput
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("failed to unmarshal output: %v", err)
+	}
+
+	if got := output.Checks[1].Status; got != "skipped" {
+		t.Errorf("expected dependency-skipped check to be skipped, got %q", got)
+	}
+	s := output.Summary
+	if s.Failed != 1 || s.Skipped != 1 || s.Errors != 2 {
+		t.Errorf("expected 1 failed, 1 skipped and 2 errors, got %+v", s)
+	}
+}
+
 func TestFormatJSON_CancelledStatus(t *testing.T) {
 	var buf bytes.Buffer
 
@@ -243,6 +322,262 @@ func TestFormatJSON_WithFixField(t *testing.T) {
 	}
 }
 
+func TestFormatJSON_RendersSuggestionTemplate(t *testing.T) {
+	var buf bytes.Buffer
+
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "coverage", Severity: config.SeverityError},
+				Execution: &executor.Result{Duration: 900 * time.Millisecond},
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{
+				CheckID:    "coverage",
+				Severity:   config.SeverityError,
+				Suggestion: "Coverage is {{.coverage}}%, need 80%.",
+				Fix:        "open coverage.html # {{.coverage}}%",
+				Extracted:  map[string]string{"coverage": "72.5"},
+			},
+		},
+		ExitCode: 1,
+	}
+
+	if err := FormatJSON(&buf, result); err != nil {
+		t.Fatalf("FormatJSON failed: %v", err)
+	}
+	var output JSONOutput
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("failed to unmarshal output: %v", err)
+	}
+
+	v := output.Violations[0]
+	if v.Suggestion != "Coverage is 72.5%, need 80%." {
+		t.Errorf("expected rendered suggestion, got %q", v.Suggestion)
+	}
+	if v.Fix != "open coverage.html # 72.5%" {
+		t.Errorf("expected rendered fix, got %q", v.Fix)
+	}
+}
+
+func TestFormatJSON_Explanation(t *testing.T) {
+	var buf bytes.Buffer
+
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "coverage", Severity: config.SeverityError},
+				Execution: &executor.Result{Duration: 900 * time.Millisecond},
+			},
+			{
+				Check:     &config.Check{ID: "test", Severity: config.SeverityError},
+				Execution: &executor.Result{ExitCode: 1, Duration: 900 * time.Millisecond},
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{
+				CheckID:   "coverage",
+				Severity:  config.SeverityError,
+				Extracted: map[string]string{"coverage": "72.5"},
+				Explanation: &orchestrator.FailureExplanation{
+					Assert:          "coverage >= 80",
+					AssertEvaluated: true,
+					AssertValues:    map[string]string{"coverage": "72.5"},
+					Stdout:          "coverage: 72.5% of statements",
+				},
+			},
+			{
+				CheckID:  "test",
+				Severity: config.SeverityError,
+				Explanation: &orchestrator.FailureExplanation{
+					Assert:   "coverage >= 80",
+					ExitCode: 1,
+					Stderr:   "FAIL",
+				},
+			},
+		},
+		ExitCode: 1,
+	}
+
+	if err := FormatJSON(&buf, result); err != nil {
+		t.Fatalf("FormatJSON failed: %v", err)
+	}
+	var output struct {
+		Violations []struct {
+			Explanation map[string]any `json:"explanation"`
+		} `json:"violations"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("failed to unmarshal output: %v", err)
+	}
+
+	evaluated := output.Violations[0].Explanation
+	if evaluated["assert"] != "coverage >= 80" || evaluated["assert_result"] != false {
+		t.Errorf("expected the assertion and its false result, got %v", evaluated)
+	}
+	if values, _ := evaluated["assert_values"].(map[string]any); values["coverage"] != "72.5" {
+		t.Errorf("expected coverage among the assertion values, got %v", evaluated["assert_values"])
+	}
+	if evaluated["stdout_tail"] != "coverage: 72.5% of statements" {
+		t.Errorf("expected the stdout tail, got %v", evaluated["stdout_tail"])
+	}
+
+	notEvaluated := output.Violations[1].Explanation
+	if _, ok := notEvaluated["assert_result"]; ok {
+		t.Errorf("expected no assert_result for an assertion that wasn't evaluated, got %v", notEvaluated)
+	}
+	if notEvaluated["exit_code"] != float64(1) || notEvaluated["stderr_tail"] != "FAIL" {
+		t.Errorf("expected exit code 1 and the stderr tail, got %v", notEvaluated)
+	}
+}
+
+func TestFormatJSON_NoExplanation(t *testing.T) {
+	var buf bytes.Buffer
+
+	result := &orchestrator.RunResult{
+		Violations: []*orchestrator.Violation{{CheckID: "lint", Severity: config.SeverityError}},
+		ExitCode:   1,
+	}
+	if err := FormatJSON(&buf, result); err != nil {
+		t.Fatalf("FormatJSON failed: %v", err)
+	}
+	if strings.Contains(buf.String(), `"explanation"`) {
+		t.Errorf("expected no explanation without --explain-failures, got %s", buf.String())
+	}
+}
+
+func TestFormatJSON_Metadata(t *testing.T) {
+	tests := []struct {
+		name   string
+		result *orchestrator.RunResult
+		want   *JSONMetadata
+	}{
+		{
+			name:   "name and description",
+			result: &orchestrator.RunResult{Name: "payments-api", Description: "Checks for the payments service"},
+			want:   &JSONMetadata{Name: "payments-api", Description: "Checks for the payments service"},
+		},
+		{
+			name: "provenance",
+			result: &orchestrator.RunResult{Provenance: orchestrator.Provenance{
+				ConfigPath: "/src/app/vibeguard.yaml",
+				ConfigHash: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+				GitCommit:  "4b825dc642cb6eb9a060e54bf8d69288fbee4904",
+				GitBranch:  "main",
+			}},
+			want: &JSONMetadata{
+				ConfigPath: "/src/app/vibeguard.yaml",
+				ConfigHash: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+				GitCommit:  "4b825dc642cb6eb9a060e54bf8d69288fbee4904",
+				GitBranch:  "main",
+			},
+		},
+		{
+			name:   "unnamed",
+			result: &orchestrator.RunResult{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := FormatJSON(&buf, tt.result); err != nil {
+				t.Fatalf("FormatJSON failed: %v", err)
+			}
+			var output JSONOutput
+			if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+				t.Fatalf("failed to unmarshal output: %v", err)
+			}
+			if !reflect.DeepEqual(output.Metadata, tt.want) {
+				t.Errorf("expected metadata %+v, got %+v", tt.want, output.Metadata)
+			}
+		})
+	}
+}
+
+func TestFormatJSON_Categories(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{Check: &config.Check{ID: "test", Category: config.CategoryTest}, Execution: &executor.Result{ExitCode: 1}},
+			{Check: &config.Check{ID: "golangci", Category: config.CategoryLint}, Execution: &executor.Result{}, Passed: true},
+			{Check: &config.Check{ID: "vet", Category: config.CategoryLint}, Execution: &executor.Result{}, Skipped: true},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := FormatJSON(&buf, result); err != nil {
+		t.Fatalf("FormatJSON failed: %v", err)
+	}
+	var output JSONOutput
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("failed to unmarshal output: %v", err)
+	}
+
+	if output.Checks[0].Category != "test" {
+		t.Errorf("expected the test check's category, got %q", output.Checks[0].Category)
+	}
+	want := []JSONCategorySummary{
+		{Category: "lint", Total: 2, Passed: 1, Skipped: 1},
+		{Category: "test", Total: 1, Failed: 1},
+	}
+	if !reflect.DeepEqual(output.Summary.Categories, want) {
+		t.Errorf("expected categories %+v, got %+v", want, output.Summary.Categories)
+	}
+}
+
+func TestFormatJSON_AutoFixFields(t *testing.T) {
+	var buf bytes.Buffer
+
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:        &config.Check{ID: "fmt"},
+				Execution:    &executor.Result{},
+				Passed:       true,
+				FixAttempted: true,
+				Fixed:        true,
+			},
+			{
+				Check:        &config.Check{ID: "lint"},
+				Execution:    &executor.Result{ExitCode: 1},
+				FixAttempted: true,
+			},
+			{
+				Check:     &config.Check{ID: "test"},
+				Execution: &executor.Result{},
+				Passed:    true,
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{CheckID: "lint", Severity: config.SeverityError, FixAttempted: true},
+		},
+	}
+
+	if err := FormatJSON(&buf, result); err != nil {
+		t.Fatalf("FormatJSON failed: %v", err)
+	}
+
+	var output JSONOutput
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("failed to unmarshal output: %v", err)
+	}
+
+	fmtCheck, lintCheck, testCheck := output.Checks[0], output.Checks[1], output.Checks[2]
+	if fmtCheck.Status != "passed" || !fmtCheck.FixAttempted || !fmtCheck.Fixed {
+		t.Errorf("fmt: expected passed and fixed, got %+v", fmtCheck)
+	}
+	if lintCheck.Status != "failed" || !lintCheck.FixAttempted || lintCheck.Fixed {
+		t.Errorf("lint: expected failed after fix attempt, got %+v", lintCheck)
+	}
+	if !output.Violations[0].FixAttempted {
+		t.Error("expected violation to record the fix attempt")
+	}
+	if strings.Contains(buf.String(), `"fixed": false`) || testCheck.FixAttempted {
+		t.Error("fix fields should be omitted when no fix ran")
+	}
+}
+
 func TestFormatJSON_WithTags(t *testing.T) {
 	var buf bytes.Buffer
 
@@ -527,3 +862,144 @@ func TestFormatJSON_WithTriggeredPrompts_DifferentEvents(t *testing.T) {
 		t.Errorf("expected event 'timeout', got %q", v.TriggeredPrompts[0].Event)
 	}
 }
+
+func TestFormatJSON_RoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check: &config.Check{ID: "fmt", Tags: []string{"format"}},
+				Execution: &executor.Result{
+					Duration: 120 * time.Millisecond,
+					Stdout:   "formatted 3 files\n",
+					Success:  true,
+				},
+				Passed: true,
+			},
+			{
+				Check: &config.Check{ID: "slow", Severity: config.SeverityError},
+				Execution: &executor.Result{
+					ExitCode: -1,
+					Duration: 5 * time.Second,
+					Stderr:   "still running\n",
+					Timedout: true,
+				},
+				Passed: false,
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{
+				CheckID:    "slow",
+				Severity:   config.SeverityError,
+				Command:    "sleep 60",
+				Suggestion: "Check timed out",
+				Timedout:   true,
+			},
+		},
+		Duration: 5200 * time.Millisecond,
+		ExitCode: 1,
+	}
+
+	if err := FormatJSON(&buf, result); err != nil {
+		t.Fatalf("FormatJSON failed: %v", err)
+	}
+
+	var output JSONOutput
+	decoder := json.NewDecoder(bytes.NewReader(buf.Bytes()))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&output); err != nil {
+		t.Fatalf("failed to decode output into JSONOutput: %v", err)
+	}
+
+	expected := JSONOutput{
+		SchemaVersion: JSONSchemaVersion,
+		Checks: []JSONCheck{
+			{
+				ID:         "fmt",
+				Tags:       []string{"format"},
+				Status:     "passed",
+				Passed:     true,
+				DurationMS: 120,
+				StdoutTail: "formatted 3 files",
+			},
+			{
+				ID:         "slow",
+				Status:     "failed",
+				ExitCode:   -1,
+				Timedout:   true,
+				DurationMS: 5000,
+				StderrTail: "still running",
+			},
+		},
+		Violations: []JSONViolation{
+			{
+				ID:         "slow",
+				Severity:   "error",
+				Command:    "sleep 60",
+				Suggestion: "Check timed out",
+				Timedout:   true,
+			},
+		},
+		DurationMS: 5200,
+		ExitCode:   1,
+		Summary: JSONSummary{
+			Total:   2,
+			Passed:  1,
+			Failed:  1,
+			Errors:  1,
+			Slowest: &JSONSlowestCheck{ID: "slow", DurationMS: 5000},
+		},
+	}
+	// Empty prompt slices are omitted from JSON and decode as nil
+	if !reflect.DeepEqual(output, expected) {
+		t.Errorf("round-trip mismatch:\ngot:  %+v\nwant: %+v", output, expected)
+	}
+
+	// Re-encoding the decoded struct must reproduce the original document
+	var again bytes.Buffer
+	encoder := json.NewEncoder(&again)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(output); err != nil {
+		t.Fatalf("failed to re-encode output: %v", err)
+	}
+	if again.String() != buf.String() {
+		t.Errorf("re-encoded JSON differs:\ngot:\n%s\nwant:\n%s", again.String(), buf.String())
+	}
+}
+
+func TestFormatJSON_OutputTailIsTruncated(t *testing.T) {
+	var buf bytes.Buffer
+
+	var lines []string
+	for i := 1; i <= OutputTailLines+5; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "noisy"},
+				Execution: &executor.Result{Stdout: strings.Join(lines, "\n") + "\n"},
+				Passed:    true,
+			},
+		},
+	}
+
+	if err := FormatJSON(&buf, result); err != nil {
+		t.Fatalf("FormatJSON failed: %v", err)
+	}
+
+	var output JSONOutput
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("failed to unmarshal output: %v", err)
+	}
+
+	tail := strings.Split(output.Checks[0].StdoutTail, "\n")
+	if len(tail) != OutputTailLines {
+		t.Fatalf("expected %d tail lines, got %d", OutputTailLines, len(tail))
+	}
+	if tail[0] != "line 6" || tail[len(tail)-1] != fmt.Sprintf("line %d", OutputTailLines+5) {
+		t.Errorf("unexpected tail bounds: %q ... %q", tail[0], tail[len(tail)-1])
+	}
+}
@@ -0,0 +1,173 @@
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+// JUnitTestSuites is the root element of a JUnit XML report.
+type JUnitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []JUnitTestSuite `xml:"testsuite"`
+}
+
+// JUnitTestSuite groups the checks of a single run.
+type JUnitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []JUnitTestCase `xml:"testcase"`
+}
+
+// JUnitTestCase represents a single check result.
+type JUnitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *JUnitFailure `xml:"failure,omitempty"`
+	Skipped   *JUnitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+	SystemErr string        `xml:"system-err,omitempty"`
+}
+
+// JUnitFailure marks a test case as failed.
+type JUnitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// JUnitSkipped marks a test case as skipped.
+type JUnitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// FormatJUnit outputs the result as JUnit XML, with one <testcase> per check
+// grouped under a single <testsuite>.
+//
+// Mapping:
+//   - Error-severity violations render as <failure type="error">
+//   - Timeouts render as <failure type="timeout"> with a distinct message
+//   - Checks skipped because of their dependencies, or cancelled by
+//     fail-fast, render as <skipped>
+//   - Warning- and info-severity violations pass, with the suggestion in
+//     <system-err>
+//   - Violations in the baseline and failures of checks with allow_failure
+//     render as <skipped>, with the details in <system-err>
+//   - The check's combined output is included in <system-out>
+func FormatJUnit(out io.Writer, result *orchestrator.RunResult) error {
+	violationByID := make(map[string]*orchestrator.Violation, len(result.Violations))
+	for _, v := range result.Violations {
+		violationByID[v.CheckID] = v
+	}
+
+	suite := JUnitTestSuite{
+		Name:      "vibeguard",
+		Time:      junitSeconds(result.Duration.Seconds()),
+		TestCases: make([]JUnitTestCase, 0, len(result.Results)),
+	}
+
+	for _, r := range result.Results {
+		tc := JUnitTestCase{
+			Name:      r.Check.ID,
+			ClassName: "vibeguard",
+			Time:      junitSeconds(r.Execution.Duration.Seconds()),
+			SystemOut: r.Execution.Combined,
+		}
+		v := violationByID[r.Check.ID]
+
+		switch {
+		case r.Skipped:
+			tc.Skipped = &JUnitSkipped{Message: r.SkipReason}
+			suite.Skipped++
+		case r.Execution.Cancelled:
+			tc.Skipped = &JUnitSkipped{Message: "Cancelled due to --fail-fast"}
+			suite.Skipped++
+		case r.Passed || v == nil:
+			// Passed
+		case v.Severity == config.SeverityInfo:
+			// Info never fails the run, even on timeout
+			tc.SystemErr = "info: " + junitFailureBody(v)
+		case v.Known:
+			tc.Skipped = &JUnitSkipped{Message: "in baseline: " + junitFailureMessage(v)}
+			tc.SystemErr = junitFailureBody(v)
+			suite.Skipped++
+		case v.AllowFailure:
+			tc.Skipped = &JUnitSkipped{Message: "allowed failure: " + junitFailureMessage(v)}
+			tc.SystemErr = junitFailureBody(v)
+			suite.Skipped++
+		case r.Execution.Timedout:
+			tc.Failure = &JUnitFailure{
+				Message: fmt.Sprintf("Check timed out after %s", r.Timeout),
+				Type:    "timeout",
+				Body:    junitFailureBody(v),
+			}
+			suite.Failures++
+		case v.Severity == config.SeverityWarning:
+			tc.SystemErr = "warning: " + junitFailureBody(v)
+		default:
+			tc.Failure = &JUnitFailure{
+				Message: junitFailureMessage(v),
+				Type:    string(v.Severity),
+				Body:    junitFailureBody(v),
+			}
+			suite.Failures++
+		}
+
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+	suite.Tests = len(suite.TestCases)
+
+	report := JUnitTestSuites{
+		Name:     "vibeguard",
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Skipped:  suite.Skipped,
+		Time:     suite.Time,
+		Suites:   []JUnitTestSuite{suite},
+	}
+
+	if _, err := io.WriteString(out, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(out)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(out, "\n")
+	return err
+}
+
+// junitSeconds formats a duration in seconds with millisecond precision.
+func junitSeconds(seconds float64) string {
+	return fmt.Sprintf("%.3f", seconds)
+}
+
+// junitFailureMessage returns the short failure message for a violation.
+func junitFailureMessage(v *orchestrator.Violation) string {
+	if v.Suggestion != "" {
+		return config.InterpolateWithExtracted(v.Suggestion, nil, v.Extracted)
+	}
+	return fmt.Sprintf("Check %q failed", v.CheckID)
+}
+
+// junitFailureBody returns the detailed failure text for a violation.
+func junitFailureBody(v *orchestrator.Violation) string {
+	body := junitFailureMessage(v)
+	if v.Fix != "" {
+		body += "\nFix: " + config.InterpolateWithExtracted(v.Fix, nil, v.Extracted)
+	}
+	return body + "\nCommand: " + v.Command
+}
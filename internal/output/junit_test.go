@@ -0,0 +1,281 @@
+package output
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+func formatJUnitForTest(t *testing.T, result *orchestrator.RunResult) (JUnitTestSuites, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := FormatJUnit(&buf, result); err != nil {
+		t.Fatalf("FormatJUnit failed: %v", err)
+	}
+
+	var report JUnitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("output is not valid XML: %v\n%s", err, buf.String())
+	}
+	return report, buf.String()
+}
+
+func TestFormatJUnit_Passed(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "fmt"},
+				Execution: &executor.Result{Duration: 1250 * time.Millisecond, Combined: "all files formatted\n", Success: true},
+				Passed:    true,
+			},
+		},
+		Duration: 1300 * time.Millisecond,
+	}
+
+	report, raw := formatJUnitForTest(t, result)
+
+	if !strings.HasPrefix(raw, xml.Header) {
+		t.Error("expected XML header")
+	}
+	if report.Tests != 1 || report.Failures != 0 || report.Skipped != 0 {
+		t.Errorf("unexpected totals: %+v", report)
+	}
+	if len(report.Suites) != 1 || len(report.Suites[0].TestCases) != 1 {
+		t.Fatalf("expected one suite with one test case, got %+v", report.Suites)
+	}
+
+	tc := report.Suites[0].TestCases[0]
+	if tc.Name != "fmt" || tc.Time != "1.250" {
+		t.Errorf("unexpected test case: %+v", tc)
+	}
+	if tc.Failure != nil || tc.Skipped != nil {
+		t.Errorf("expected passing test case, got %+v", tc)
+	}
+	if tc.SystemOut != "all files formatted\n" {
+		t.Errorf("expected command output in system-out, got %q", tc.SystemOut)
+	}
+}
+
+func TestFormatJUnit_ErrorAndWarning(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "coverage", Severity: config.SeverityError},
+				Execution: &executor.Result{ExitCode: 0, Combined: "coverage: 65%\n"},
+				Extracted: map[string]string{"coverage": "65"},
+			},
+			{
+				Check:     &config.Check{ID: "lint", Severity: config.SeverityWarning},
+				Execution: &executor.Result{ExitCode: 1},
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{
+				CheckID:    "coverage",
+				Severity:   config.SeverityError,
+				Command:    "go test -cover ./...",
+				Suggestion: "Coverage is {{.coverage}}%",
+				Fix:        "Add tests",
+				Extracted:  map[string]string{"coverage": "65"},
+			},
+			{
+				CheckID:    "lint",
+				Severity:   config.SeverityWarning,
+				Command:    "golangci-lint run",
+				Suggestion: "Fix lint warnings",
+			},
+		},
+	}
+
+	report, _ := formatJUnitForTest(t, result)
+	if report.Failures != 1 {
+		t.Errorf("expected 1 failure (warnings do not fail), got %d", report.Failures)
+	}
+
+	cov := report.Suites[0].TestCases[0]
+	if cov.Failure == nil {
+		t.Fatal("expected coverage failure")
+	}
+	if cov.Failure.Type != "error" || cov.Failure.Message != "Coverage is 65%" {
+		t.Errorf("unexpected failure: %+v", cov.Failure)
+	}
+	if !strings.Contains(cov.Failure.Body, "Fix: Add tests") || !strings.Contains(cov.Failure.Body, "Command: go test -cover ./...") {
+		t.Errorf("unexpected failure body: %q", cov.Failure.Body)
+	}
+
+	lint := report.Suites[0].TestCases[1]
+	if lint.Failure != nil {
+		t.Errorf("expected warning not to render as failure, got %+v", lint.Failure)
+	}
+	if !strings.HasPrefix(lint.SystemErr, "warning: Fix lint warnings") {
+		t.Errorf("expected warning in system-err, got %q", lint.SystemErr)
+	}
+}
+
+func TestFormatJUnit_Timeout(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				// The effective timeout, here from a --timeout override, is reported
+				Check:     &config.Check{ID: "slow", Severity: config.SeverityError, Timeout: config.Duration(time.Minute)},
+				Execution: &executor.Result{ExitCode: -1, Duration: 5 * time.Second, Timedout: true},
+				Timeout:   5 * time.Second,
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{
+				CheckID:    "slow",
+				Severity:   config.SeverityError,
+				Command:    "sleep 60",
+				Suggestion: "Check timed out. Consider increasing the timeout value or optimizing the command.",
+				Timedout:   true,
+			},
+		},
+	}
+
+	report, _ := formatJUnitForTest(t, result)
+	tc := report.Suites[0].TestCases[0]
+	if tc.Failure == nil {
+		t.Fatal("expected timeout to render as failure")
+	}
+	if tc.Failure.Type != "timeout" || tc.Failure.Message != "Check timed out after 5s" {
+		t.Errorf("unexpected timeout failure: %+v", tc.Failure)
+	}
+	if report.Failures != 1 {
+		t.Errorf("expected 1 failure, got %d", report.Failures)
+	}
+}
+
+func TestFormatJUnit_AllowFailure(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "lint", Severity: config.SeverityError, AllowFailure: true},
+				Execution: &executor.Result{ExitCode: 1},
+			},
+			{
+				Check:     &config.Check{ID: "slow", Severity: config.SeverityError, AllowFailure: true},
+				Execution: &executor.Result{ExitCode: -1, Timedout: true},
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{CheckID: "lint", Severity: config.SeverityError, Command: "golangci-lint run", Suggestion: "Fix lint errors", AllowFailure: true},
+			{CheckID: "slow", Severity: config.SeverityError, Command: "sleep 60", Timedout: true, AllowFailure: true},
+		},
+	}
+
+	report, _ := formatJUnitForTest(t, result)
+	if report.Failures != 0 || report.Skipped != 2 {
+		t.Errorf("expected allowed failures to be skipped, got failures=%d skipped=%d", report.Failures, report.Skipped)
+	}
+
+	lint := report.Suites[0].TestCases[0]
+	if lint.Failure != nil || lint.Skipped == nil {
+		t.Fatalf("expected allowed failure to render as skipped, got %+v", lint)
+	}
+	if lint.Skipped.Message != "allowed failure: Fix lint errors" {
+		t.Errorf("unexpected skipped message: %q", lint.Skipped.Message)
+	}
+	if !strings.Contains(lint.SystemErr, "Command: golangci-lint run") {
+		t.Errorf("expected failure details in system-err, got %q", lint.SystemErr)
+	}
+
+	slow := report.Suites[0].TestCases[1]
+	if slow.Failure != nil || slow.Skipped == nil {
+		t.Errorf("expected allowed timeout to render as skipped, got %+v", slow)
+	}
+}
+
+func TestFormatJUnit_Known(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "vet", Severity: config.SeverityError},
+				Execution: &executor.Result{ExitCode: 1},
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{CheckID: "vet", Severity: config.SeverityError, Command: "go vet ./...", Suggestion: "Fix vet errors", Known: true},
+		},
+	}
+
+	report, _ := formatJUnitForTest(t, result)
+	if report.Failures != 0 || report.Skipped != 1 {
+		t.Errorf("expected baselined violation to be skipped, got failures=%d skipped=%d", report.Failures, report.Skipped)
+	}
+	tc := report.Suites[0].TestCases[0]
+	if tc.Failure != nil || tc.Skipped == nil {
+		t.Fatalf("expected baselined violation to render as skipped, got %+v", tc)
+	}
+	if tc.Skipped.Message != "in baseline: Fix vet errors" {
+		t.Errorf("unexpected skipped message: %q", tc.Skipped.Message)
+	}
+}
+
+func TestFormatJUnit_Skipped(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "build", Severity: config.SeverityError},
+				Execution: &executor.Result{ExitCode: 1},
+			},
+			{
+				Check:      &config.Check{ID: "test", Severity: config.SeverityError, Requires: []string{"build"}},
+				Execution:  &executor.Result{ExitCode: -1},
+				Skipped:    true,
+				SkipReason: "Skipped: required dependency failed",
+			},
+			{
+				Check:     &config.Check{ID: "lint", Severity: config.SeverityError},
+				Execution: &executor.Result{ExitCode: -1, Cancelled: true},
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{CheckID: "build", Severity: config.SeverityError, Command: "go build ./..."},
+			{CheckID: "test", Severity: config.SeverityError, Command: "go test ./...", Suggestion: "Skipped: required dependency failed"},
+		},
+		FailFastTriggered: true,
+	}
+
+	report, _ := formatJUnitForTest(t, result)
+	if report.Tests != 3 || report.Failures != 1 || report.Skipped != 2 {
+		t.Errorf("unexpected totals: tests=%d failures=%d skipped=%d", report.Tests, report.Failures, report.Skipped)
+	}
+
+	cases := report.Suites[0].TestCases
+	if cases[0].Failure == nil || cases[0].Failure.Message != `Check "build" failed` {
+		t.Errorf("expected build failure, got %+v", cases[0].Failure)
+	}
+	if cases[1].Skipped == nil || cases[1].Skipped.Message != "Skipped: required dependency failed" || cases[1].Failure != nil {
+		t.Errorf("expected dependency-skipped test case, got %+v", cases[1])
+	}
+	if cases[2].Skipped == nil || cases[2].Failure != nil {
+		t.Errorf("expected cancelled check to be skipped, got %+v", cases[2])
+	}
+}
+
+func TestFormatJUnit_EscapesOutput(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "xml"},
+				Execution: &executor.Result{Combined: "<error> & \"quotes\"\n"},
+				Passed:    true,
+			},
+		},
+	}
+
+	report, raw := formatJUnitForTest(t, result)
+	if strings.Contains(raw, "<error>") {
+		t.Error("expected command output to be escaped")
+	}
+	if report.Suites[0].TestCases[0].SystemOut != "<error> & \"quotes\"\n" {
+		t.Errorf("expected output to round-trip, got %q", report.Suites[0].TestCases[0].SystemOut)
+	}
+}
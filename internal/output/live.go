@@ -0,0 +1,178 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+// spinnerFrames animate the status of running checks.
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// liveRefresh is how often the live status table is redrawn.
+const liveRefresh = 100 * time.Millisecond
+
+// ANSI escape sequences used to redraw the table in place
+const (
+	ansiCursorUp  = "\x1b[%dA"
+	ansiClearLine = "\x1b[2K"
+	ansiClearDown = "\x1b[J"
+)
+
+// liveRow is one check in the live status table.
+type liveRow struct {
+	id       string
+	started  time.Time
+	finished bool
+	symbol   string
+	status   string
+	duration time.Duration
+}
+
+// LiveStatus renders a live-updating table of running and completed checks
+// to a terminal. It implements orchestrator.Progress. The table is erased
+// when the run stops, so the report that follows is unchanged.
+type LiveStatus struct {
+	out io.Writer
+	now func() time.Time
+
+	mu    sync.Mutex
+	rows  []*liveRow
+	byID  map[string]*liveRow
+	frame int
+	lines int // Lines drawn by the previous frame
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewLiveStatus creates a LiveStatus writing to out, which should be a terminal.
+func NewLiveStatus(out io.Writer) *LiveStatus {
+	return &LiveStatus{
+		out:  out,
+		now:  time.Now,
+		byID: make(map[string]*liveRow),
+	}
+}
+
+// Start begins redrawing the table periodically until Stop is called.
+func (s *LiveStatus) Start() {
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(liveRefresh)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+				s.redraw()
+			}
+		}
+	}()
+}
+
+// Stop stops redrawing and erases the table.
+func (s *LiveStatus) Stop() {
+	if s.stop != nil {
+		close(s.stop)
+		<-s.done
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lines > 0 {
+		_, _ = fmt.Fprintf(s.out, ansiCursorUp+ansiClearDown, s.lines)
+		s.lines = 0
+	}
+}
+
+// CheckStarted adds a running check to the table.
+func (s *LiveStatus) CheckStarted(check *config.Check) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row := &liveRow{id: check.ID, started: s.now()}
+	s.rows = append(s.rows, row)
+	s.byID[check.ID] = row
+}
+
+// CheckFinished marks a check in the table as completed.
+func (s *LiveStatus) CheckFinished(result *orchestrator.CheckResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row := s.byID[result.Check.ID]
+	if row == nil {
+		// Skipped checks never start
+		row = &liveRow{id: result.Check.ID}
+		s.rows = append(s.rows, row)
+		s.byID[result.Check.ID] = row
+	}
+	row.finished = true
+	if result.Execution != nil {
+		row.duration = result.Execution.Duration
+	}
+	switch {
+	case result.Passed:
+		row.symbol, row.status = "✓", "passed"
+	case result.Skipped:
+		row.symbol, row.status = "⊘", "skipped"
+	case result.Execution != nil && result.Execution.Cancelled:
+		row.symbol, row.status = "⊘", "cancelled"
+	case result.Execution != nil && result.Execution.Timedout:
+		row.symbol, row.status = "✗", "timeout"
+	default:
+		row.symbol, row.status = "✗", "failed"
+	}
+}
+
+// redraw replaces the previous frame with the current state of the table.
+func (s *LiveStatus) redraw() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var b strings.Builder
+	if s.lines > 0 {
+		fmt.Fprintf(&b, ansiCursorUp, s.lines)
+	}
+	table := s.render()
+	for _, line := range table {
+		b.WriteString(ansiClearLine)
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	s.lines = len(table)
+	s.frame++
+	_, _ = io.WriteString(s.out, b.String())
+}
+
+// render returns the lines of the table: one row per check in start order,
+// with a spinner and the elapsed time for running checks.
+func (s *LiveStatus) render() []string {
+	width := 0
+	for _, row := range s.rows {
+		width = max(width, len(row.id))
+	}
+
+	lines := make([]string, 0, len(s.rows))
+	for _, row := range s.rows {
+		if row.finished {
+			line := fmt.Sprintf("%s %-*s %s", row.symbol, width, row.id, row.status)
+			if row.duration > 0 {
+				line += fmt.Sprintf(" (%.1fs)", row.duration.Seconds())
+			}
+			lines = append(lines, line)
+			continue
+		}
+		spinner := spinnerFrames[s.frame%len(spinnerFrames)]
+		elapsed := s.now().Sub(row.started)
+		lines = append(lines, fmt.Sprintf("%s %-*s running (%.1fs)", spinner, width, row.id, elapsed.Seconds()))
+	}
+	return lines
+}
@@ -0,0 +1,68 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+func TestLiveStatus_Render(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewLiveStatus(&buf)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+
+	lint := &config.Check{ID: "lint"}
+	test := &config.Check{ID: "test"}
+	deploy := &config.Check{ID: "deploy"}
+	s.CheckStarted(lint)
+	s.CheckStarted(test)
+	now = now.Add(1500 * time.Millisecond)
+	s.CheckFinished(&orchestrator.CheckResult{
+		Check:     lint,
+		Passed:    true,
+		Execution: &executor.Result{Duration: 1200 * time.Millisecond},
+	})
+	s.CheckFinished(&orchestrator.CheckResult{Check: deploy, Skipped: true})
+
+	want := []string{
+		"✓ lint   passed (1.2s)",
+		"⠋ test   running (1.5s)",
+		"⊘ deploy skipped",
+	}
+	if got := s.render(); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unexpected table:\ngot:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestLiveStatus_RedrawAndStop(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewLiveStatus(&buf)
+	s.CheckStarted(&config.Check{ID: "a"})
+	s.CheckStarted(&config.Check{ID: "b"})
+
+	s.redraw()
+	first := buf.String()
+	if strings.Count(first, "\n") != 2 || strings.Contains(first, "\x1b[2A") {
+		t.Errorf("expected the first frame to draw 2 lines without moving up, got %q", first)
+	}
+
+	// Later frames overwrite the previous one in place
+	buf.Reset()
+	s.redraw()
+	if !strings.HasPrefix(buf.String(), "\x1b[2A") {
+		t.Errorf("expected the redraw to move the cursor up 2 lines, got %q", buf.String())
+	}
+
+	// Stopping erases the table
+	buf.Reset()
+	s.Stop()
+	if got := buf.String(); got != "\x1b[2A\x1b[J" {
+		t.Errorf("expected Stop to erase the table, got %q", got)
+	}
+}
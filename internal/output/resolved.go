@@ -0,0 +1,181 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+// ResolvedSchemaVersion identifies the layout of ResolvedChecksOutput.
+const ResolvedSchemaVersion = "1"
+
+// ResolvedChecksOutput is the fully resolved check set, as consumed by the
+// AI agent-assisted setup flow. It describes what would run, not results.
+type ResolvedChecksOutput struct {
+	SchemaVersion string            `json:"schema_version"`
+	Version       string            `json:"version"`
+	Vars          map[string]string `json:"vars,omitempty"`
+	GrokPatterns  map[string]string `json:"grok_patterns,omitempty"`
+	Checks        []ResolvedCheck   `json:"checks"`
+	Levels        [][]string        `json:"levels"`
+}
+
+// ResolvedCheck is a single check after defaults, validation, variable
+// interpolation and severity_overrides have been applied.
+type ResolvedCheck struct {
+	ID               string                `json:"id"`
+	Description      string                `json:"description,omitempty"`
+	Run              string                `json:"run,omitempty"`
+	File             string                `json:"file,omitempty"`
+	Grok             []string              `json:"grok,omitempty"`
+	Assert           string                `json:"assert,omitempty"`
+	Severity         string                `json:"severity"`
+	AllowFailure     bool                  `json:"allow_failure,omitempty"`
+	Category         string                `json:"category,omitempty"`
+	Suggestion       string                `json:"suggestion,omitempty"`
+	Fix              string                `json:"fix,omitempty"`
+	Requires         []string              `json:"requires"`
+	AllRequires      []string              `json:"all_requires"`
+	OptionalRequires []string              `json:"optional_requires,omitempty"`
+	RequiresAny      []string              `json:"requires_any,omitempty"`
+	Level            int                   `json:"level"`
+	Tags             []string              `json:"tags,omitempty"`
+	Paths            []string              `json:"paths,omitempty"`
+	TimeoutMS        int64                 `json:"timeout_ms"`
+	On               *ResolvedEventHandler `json:"on,omitempty"`
+}
+
+// ResolvedEventHandler describes the prompts attached to check outcomes.
+type ResolvedEventHandler struct {
+	Success *ResolvedEventValue `json:"success,omitempty"`
+	Failure *ResolvedEventValue `json:"failure,omitempty"`
+	Timeout *ResolvedEventValue `json:"timeout,omitempty"`
+}
+
+// ResolvedEventValue holds either prompt ID references or inline content.
+type ResolvedEventValue struct {
+	Prompts []string `json:"prompts,omitempty"`
+	Content string   `json:"content,omitempty"`
+}
+
+// FormatResolvedChecks outputs the resolved check definitions of a loaded
+// configuration as JSON. Each check lists its direct requirements, the full
+// transitive set of checks it depends on (in execution order), and the
+// execution level it runs in.
+func FormatResolvedChecks(out io.Writer, cfg *config.Config) error {
+	graph, err := orchestrator.BuildGraph(cfg.Checks)
+	if err != nil {
+		return fmt.Errorf("failed to build dependency graph: %w", err)
+	}
+
+	levelOf := make(map[string]int)
+	order := make(map[string]int)
+	for i, level := range graph.Levels() {
+		for _, id := range level {
+			levelOf[id] = i
+			order[id] = len(order)
+		}
+	}
+
+	checkByID := make(map[string]*config.Check, len(cfg.Checks))
+	for i := range cfg.Checks {
+		checkByID[cfg.Checks[i].ID] = &cfg.Checks[i]
+	}
+
+	output := ResolvedChecksOutput{
+		SchemaVersion: ResolvedSchemaVersion,
+		Version:       cfg.Version,
+		Vars:          cfg.Vars,
+		GrokPatterns:  cfg.GrokPatterns,
+		Checks:        make([]ResolvedCheck, 0, len(cfg.Checks)),
+		Levels:        graph.Levels(),
+	}
+	if output.Levels == nil {
+		output.Levels = [][]string{}
+	}
+
+	for _, check := range cfg.Checks {
+		requires := check.Requires
+		if requires == nil {
+			requires = []string{}
+		}
+		output.Checks = append(output.Checks, ResolvedCheck{
+			ID:               check.ID,
+			Description:      check.Description,
+			Run:              check.Run,
+			File:             check.File,
+			Grok:             check.Grok,
+			Assert:           check.Assert,
+			Severity:         string(cfg.SeverityOf(&check)),
+			AllowFailure:     check.AllowFailure,
+			Category:         check.Category,
+			Suggestion:       check.Suggestion,
+			Fix:              check.Fix,
+			Requires:         requires,
+			AllRequires:      transitiveRequires(check.ID, checkByID, order),
+			OptionalRequires: check.OptionalRequires,
+			RequiresAny:      check.RequiresAny,
+			Level:            levelOf[check.ID],
+			Tags:             check.Tags,
+			Paths:            check.Paths,
+			TimeoutMS:        check.Timeout.AsDuration().Milliseconds(),
+			On:               resolveEventHandler(check.On),
+		})
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
+
+// transitiveRequires returns every check that id depends on, directly or
+// indirectly, sorted by execution order.
+func transitiveRequires(id string, checkByID map[string]*config.Check, order map[string]int) []string {
+	seen := make(map[string]bool)
+	var visit func(string)
+	visit = func(current string) {
+		for _, dep := range checkByID[current].Requires {
+			if !seen[dep] {
+				seen[dep] = true
+				visit(dep)
+			}
+		}
+	}
+	visit(id)
+
+	deps := make([]string, 0, len(seen))
+	for dep := range seen {
+		deps = append(deps, dep)
+	}
+	sort.Slice(deps, func(i, j int) bool { return order[deps[i]] < order[deps[j]] })
+	return deps
+}
+
+// resolveEventHandler converts configured event handlers, returning nil when
+// no events are defined.
+func resolveEventHandler(on config.EventHandler) *ResolvedEventHandler {
+	handler := &ResolvedEventHandler{
+		Success: resolveEventValue(on.Success),
+		Failure: resolveEventValue(on.Failure),
+		Timeout: resolveEventValue(on.Timeout),
+	}
+	if handler.Success == nil && handler.Failure == nil && handler.Timeout == nil {
+		return nil
+	}
+	return handler
+}
+
+// resolveEventValue converts a single event value, returning nil when empty.
+func resolveEventValue(ev config.EventValue) *ResolvedEventValue {
+	if ev.IsInline && ev.Content != "" {
+		return &ResolvedEventValue{Content: ev.Content}
+	}
+	if len(ev.IDs) > 0 {
+		return &ResolvedEventValue{Prompts: ev.IDs}
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+)
+
+func TestFormatResolvedChecks_ComplexConfig(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "vibeguard.yaml")
+
+	content := `
+version: "1"
+
+vars:
+  MIN_COVERAGE: "80"
+  packages: "./..."
+
+checks:
+  - id: fmt
+    run: "! gofmt -l . | grep ."
+    severity: error
+    suggestion: "Run 'gofmt -w .' to format code"
+
+  - id: vet
+    run: go vet {{.packages}}
+    severity: error
+
+  - id: test
+    run: go test -cover {{.packages}}
+    grok:
+      - "coverage: %{NUMBER:coverage}%"
+    assert: "coverage >= {{.MIN_COVERAGE}}"
+    requires:
+      - vet
+      - fmt
+    timeout: 5m
+    severity: error
+    on:
+      failure: "Add tests for uncovered packages"
+
+  - id: lint
+    run: golangci-lint run
+    severity: warning
+    requires:
+      - test
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := FormatResolvedChecks(&buf, cfg); err != nil {
+		t.Fatalf("FormatResolvedChecks failed: %v", err)
+	}
+
+	var output ResolvedChecksOutput
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("failed to unmarshal output: %v", err)
+	}
+
+	if output.SchemaVersion != ResolvedSchemaVersion {
+		t.Errorf("expected schema version %q, got %q", ResolvedSchemaVersion, output.SchemaVersion)
+	}
+	if len(output.Checks) != 4 {
+		t.Fatalf("expected 4 checks, got %d", len(output.Checks))
+	}
+
+	byID := make(map[string]ResolvedCheck)
+	for _, c := range output.Checks {
+		byID[c.ID] = c
+	}
+	for _, id := range []string{"fmt", "vet", "test", "lint"} {
+		if _, ok := byID[id]; !ok {
+			t.Errorf("expected check %q in output", id)
+		}
+	}
+
+	// Commands and assertions are interpolated
+	if byID["vet"].Run != "go vet ./..." {
+		t.Errorf("expected interpolated vet command, got %q", byID["vet"].Run)
+	}
+	if byID["test"].Run != "go test -cover ./..." {
+		t.Errorf("expected interpolated test command, got %q", byID["test"].Run)
+	}
+	if byID["test"].Assert != "coverage >= 80" {
+		t.Errorf("expected interpolated assertion, got %q", byID["test"].Assert)
+	}
+
+	// Defaults are applied
+	if byID["fmt"].TimeoutMS != config.DefaultTimeout.Milliseconds() {
+		t.Errorf("expected default timeout, got %dms", byID["fmt"].TimeoutMS)
+	}
+	if byID["test"].TimeoutMS != 300000 {
+		t.Errorf("expected 5m timeout, got %dms", byID["test"].TimeoutMS)
+	}
+
+	// Dependencies are resolved
+	if !reflect.DeepEqual(byID["test"].Requires, []string{"vet", "fmt"}) {
+		t.Errorf("unexpected direct requires for test: %v", byID["test"].Requires)
+	}
+	if !reflect.DeepEqual(byID["lint"].AllRequires, []string{"fmt", "vet", "test"}) {
+		t.Errorf("unexpected transitive requires for lint: %v", byID["lint"].AllRequires)
+	}
+	if len(byID["fmt"].AllRequires) != 0 || byID["fmt"].Requires == nil {
+		t.Errorf("expected empty (non-null) requires for fmt, got %v / %v", byID["fmt"].Requires, byID["fmt"].AllRequires)
+	}
+	if byID["fmt"].Level != 0 || byID["test"].Level != 1 || byID["lint"].Level != 2 {
+		t.Errorf("unexpected levels: fmt=%d test=%d lint=%d", byID["fmt"].Level, byID["test"].Level, byID["lint"].Level)
+	}
+	if !reflect.DeepEqual(output.Levels, [][]string{{"fmt", "vet"}, {"test"}, {"lint"}}) {
+		t.Errorf("unexpected levels: %v", output.Levels)
+	}
+
+	// Event handlers are included
+	if on := byID["test"].On; on == nil || on.Failure == nil || on.Failure.Content != "Add tests for uncovered packages" {
+		t.Errorf("expected failure event, got %+v", byID["test"].On)
+	}
+	if byID["vet"].On != nil {
+		t.Errorf("expected no events for vet, got %+v", byID["vet"].On)
+	}
+}
+
+func TestFormatResolvedChecks_EffectivePolicy(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "vibeguard.yaml")
+
+	content := `
+version: "1"
+
+severity_overrides:
+  lint: warning
+
+checks:
+  - id: lint
+    run: golangci-lint run
+    severity: error
+    category: lint
+  - id: migrate
+    run: ./migrate --check
+    allow_failure: true
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := FormatResolvedChecks(&buf, cfg); err != nil {
+		t.Fatalf("FormatResolvedChecks failed: %v", err)
+	}
+	var output ResolvedChecksOutput
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("failed to unmarshal output: %v", err)
+	}
+
+	lint, migrate := output.Checks[0], output.Checks[1]
+	if lint.Severity != "warning" {
+		t.Errorf("expected severity_overrides to demote lint to warning, got %q", lint.Severity)
+	}
+	if lint.Category != "lint" {
+		t.Errorf("expected lint category, got %q", lint.Category)
+	}
+	if !migrate.AllowFailure || lint.AllowFailure {
+		t.Errorf("expected allow_failure only on migrate, got lint=%v migrate=%v", lint.AllowFailure, migrate.AllowFailure)
+	}
+}
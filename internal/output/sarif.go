@@ -0,0 +1,259 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+	"github.com/vibeguard/vibeguard/internal/version"
+)
+
+// SARIF 2.1.0 identifiers.
+const (
+	SARIFVersion = "2.1.0"
+	SARIFSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// SARIF location keys. When a check's grok patterns capture any of these
+// names, violations include a physical location pointing at the source.
+const (
+	sarifFileKey   = "file"
+	sarifLineKey   = "line"
+	sarifColumnKey = "column"
+)
+
+// SARIFLog is the root object of a SARIF 2.1.0 document.
+type SARIFLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+// SARIFRun describes a single invocation of vibeguard.
+type SARIFRun struct {
+	Tool       SARIFTool           `json:"tool"`
+	Results    []SARIFResult       `json:"results"`
+	Properties *SARIFRunProperties `json:"properties,omitempty"`
+}
+
+// SARIFRunProperties is the property bag of a run, labelling it with its
+// config's name and description.
+type SARIFRunProperties struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// SARIFTool describes the tool that produced the results.
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+// SARIFDriver describes the vibeguard driver and the rules (checks) it ran.
+type SARIFDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []SARIFRule `json:"rules"`
+}
+
+// SARIFRule describes a check that produced at least one result.
+type SARIFRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     SARIFMessage       `json:"shortDescription"`
+	DefaultConfiguration SARIFConfiguration `json:"defaultConfiguration"`
+}
+
+// SARIFConfiguration holds a rule's default reporting level.
+type SARIFConfiguration struct {
+	Level string `json:"level"`
+}
+
+// SARIFMessage is a plain-text SARIF message.
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+// SARIFResult represents a single violation.
+type SARIFResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   SARIFMessage    `json:"message"`
+	Locations []SARIFLocation `json:"locations,omitempty"`
+}
+
+// SARIFLocation wraps a physical location.
+type SARIFLocation struct {
+	PhysicalLocation SARIFPhysicalLocation `json:"physicalLocation"`
+}
+
+// SARIFPhysicalLocation identifies a file and optional region within it.
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+	Region           *SARIFRegion          `json:"region,omitempty"`
+}
+
+// SARIFArtifactLocation identifies a file by URI relative to the repository root.
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// SARIFRegion identifies a line (and optionally column) within a file.
+type SARIFRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// FormatSARIF outputs the violations in SARIF 2.1.0 format, suitable for
+// upload to GitHub code scanning.
+//
+// Mapping:
+//   - Each violation becomes a result whose ruleId is the check ID
+//   - Severity maps to level: error → "error", warning → "warning",
+//     info → "note"; violations in the baseline are "note" and
+//     error-severity violations of checks with allow_failure are "warning"
+//   - The suggestion, interpolated with extracted values, becomes the message
+//   - Grok captures named file, line and column populate the physical location;
+//     without a file capture the location is the check in the config file
+//   - The config's name and description go in the run's property bag
+func FormatSARIF(out io.Writer, result *orchestrator.RunResult) error {
+	run := SARIFRun{
+		Tool: SARIFTool{
+			Driver: SARIFDriver{
+				Name:           "vibeguard",
+				Version:        version.String(),
+				InformationURI: "https://github.com/vibeguard/vibeguard",
+				Rules:          []SARIFRule{},
+			},
+		},
+		Results: make([]SARIFResult, 0, len(result.Violations)),
+	}
+	if result.Name != "" || result.Description != "" {
+		run.Properties = &SARIFRunProperties{Name: result.Name, Description: result.Description}
+	}
+
+	configURI := sarifConfigURI(result.Provenance.ConfigPath)
+	seenRules := make(map[string]bool)
+	for _, v := range result.Violations {
+		if !seenRules[v.CheckID] {
+			seenRules[v.CheckID] = true
+			description := v.Description
+			if description == "" {
+				description = v.Command
+			}
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, SARIFRule{
+				ID:                   v.CheckID,
+				ShortDescription:     SARIFMessage{Text: description},
+				DefaultConfiguration: SARIFConfiguration{Level: sarifLevel(v.Severity)},
+			})
+		}
+
+		run.Results = append(run.Results, SARIFResult{
+			RuleID:    v.CheckID,
+			Level:     sarifResultLevel(v),
+			Message:   SARIFMessage{Text: sarifMessage(v)},
+			Locations: sarifLocations(v, configURI),
+		})
+	}
+
+	log := SARIFLog{
+		Schema:  SARIFSchema,
+		Version: SARIFVersion,
+		Runs:    []SARIFRun{run},
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(log)
+}
+
+// sarifLevel maps a vibeguard severity to a SARIF result level.
+func sarifLevel(severity config.Severity) string {
+	switch severity {
+	case config.SeverityWarning:
+		return "warning"
+	case config.SeverityInfo:
+		return "note"
+	default:
+		return "error"
+	}
+}
+
+// sarifResultLevel returns the SARIF level of a violation, downgrading
+// baselined violations and allowed failures so they don't read as blocking.
+func sarifResultLevel(v *orchestrator.Violation) string {
+	switch {
+	case v.Known:
+		return "note"
+	case v.AllowFailure && v.Severity == config.SeverityError:
+		return "warning"
+	default:
+		return sarifLevel(v.Severity)
+	}
+}
+
+// sarifMessage returns the message text for a violation.
+func sarifMessage(v *orchestrator.Violation) string {
+	if v.Suggestion != "" {
+		return config.InterpolateWithExtracted(v.Suggestion, nil, v.Extracted)
+	}
+	if v.Timedout {
+		return fmt.Sprintf("Check %q timed out", v.CheckID)
+	}
+	return fmt.Sprintf("Check %q failed", v.CheckID)
+}
+
+// sarifLocations builds the physical location of a violation from its
+// grok-captured file, line and column values. Code scanning requires a
+// location, so without a captured file it points at the check's line in the
+// config file at configURI. It returns nil if neither is known.
+func sarifLocations(v *orchestrator.Violation, configURI string) []SARIFLocation {
+	extracted := v.Extracted
+	file := extracted[sarifFileKey]
+	if file == "" {
+		if configURI == "" {
+			return nil
+		}
+		loc := SARIFPhysicalLocation{ArtifactLocation: SARIFArtifactLocation{URI: configURI}}
+		if v.ConfigLine > 0 {
+			loc.Region = &SARIFRegion{StartLine: v.ConfigLine}
+		}
+		return []SARIFLocation{{PhysicalLocation: loc}}
+	}
+
+	loc := SARIFPhysicalLocation{
+		ArtifactLocation: SARIFArtifactLocation{URI: strings.TrimPrefix(filepath.ToSlash(file), "./")},
+	}
+	if line, err := strconv.Atoi(extracted[sarifLineKey]); err == nil && line > 0 {
+		loc.Region = &SARIFRegion{StartLine: line}
+		if col, err := strconv.Atoi(extracted[sarifColumnKey]); err == nil && col > 0 {
+			loc.Region.StartColumn = col
+		}
+	}
+
+	return []SARIFLocation{{PhysicalLocation: loc}}
+}
+
+// sarifConfigURI returns the URI of the config file at path: relative to the
+// working directory, like grok-captured files, or an absolute file URI if it
+// lies outside it. It returns "" if path is empty.
+func sarifConfigURI(path string) string {
+	if path == "" {
+		return ""
+	}
+	if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, path); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return filepath.ToSlash(rel)
+		}
+	}
+	uri := filepath.ToSlash(path)
+	if !strings.HasPrefix(uri, "/") {
+		uri = "/" + uri // A Windows drive letter
+	}
+	return "file://" + uri
+}
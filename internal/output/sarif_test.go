@@ -0,0 +1,286 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+	"github.com/vibeguard/vibeguard/internal/version"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files in testdata")
+
+// assertGolden compares got against testdata/<name>, rewriting the file when
+// the -update flag is set.
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output does not match %s (run with -update to regenerate):\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
+
+func sarifTestResult() *orchestrator.RunResult {
+	return &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{
+				Check:     &config.Check{ID: "fmt"},
+				Execution: &executor.Result{Duration: 100 * time.Millisecond},
+				Passed:    true,
+			},
+			{
+				Check:     &config.Check{ID: "vet", Severity: config.SeverityError},
+				Execution: &executor.Result{Duration: 300 * time.Millisecond, ExitCode: 1},
+			},
+			{
+				Check:     &config.Check{ID: "coverage", Severity: config.SeverityWarning},
+				Execution: &executor.Result{Duration: 900 * time.Millisecond},
+			},
+			{
+				Check:     &config.Check{ID: "slow", Severity: config.SeverityError},
+				Execution: &executor.Result{Duration: time.Second, Timedout: true},
+			},
+		},
+		Violations: []*orchestrator.Violation{
+			{
+				CheckID:    "vet",
+				Severity:   config.SeverityError,
+				Command:    "go vet ./...",
+				Suggestion: "Fix the unreachable code reported by go vet",
+				Extracted:  map[string]string{"file": "./internal/config/config.go", "line": "42", "column": "7"},
+			},
+			{
+				CheckID:    "coverage",
+				Severity:   config.SeverityWarning,
+				Command:    "go test -cover ./...",
+				Suggestion: "Coverage is 72%, need 80%",
+				Extracted:  map[string]string{"coverage": "72"},
+			},
+			{
+				CheckID:  "slow",
+				Severity: config.SeverityError,
+				Command:  "sleep 60",
+				Timedout: true,
+			},
+		},
+		ExitCode: 1,
+	}
+}
+
+func TestFormatSARIF_Golden(t *testing.T) {
+	oldVersion := version.Version
+	version.Version = "v0.0.0-test"
+	defer func() { version.Version = oldVersion }()
+
+	var buf bytes.Buffer
+	if err := FormatSARIF(&buf, sarifTestResult()); err != nil {
+		t.Fatalf("FormatSARIF failed: %v", err)
+	}
+
+	assertGolden(t, "sarif.golden", buf.Bytes())
+}
+
+func TestFormatSARIF_SchemaShape(t *testing.T) {
+	var buf bytes.Buffer
+	if err := FormatSARIF(&buf, sarifTestResult()); err != nil {
+		t.Fatalf("FormatSARIF failed: %v", err)
+	}
+
+	// Every document must decode strictly into the SARIF types
+	var log SARIFLog
+	decoder := json.NewDecoder(bytes.NewReader(buf.Bytes()))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&log); err != nil {
+		t.Fatalf("output is not valid SARIF shape: %v", err)
+	}
+
+	if log.Version != SARIFVersion || log.Schema != SARIFSchema {
+		t.Errorf("unexpected SARIF header: version=%q schema=%q", log.Version, log.Schema)
+	}
+	if len(log.Runs) != 1 {
+		t.Fatalf("expected exactly 1 run, got %d", len(log.Runs))
+	}
+
+	run := log.Runs[0]
+	if run.Tool.Driver.Name != "vibeguard" {
+		t.Errorf("expected driver name vibeguard, got %q", run.Tool.Driver.Name)
+	}
+
+	ruleIDs := make(map[string]bool)
+	for _, rule := range run.Tool.Driver.Rules {
+		ruleIDs[rule.ID] = true
+	}
+	for _, r := range run.Results {
+		if !ruleIDs[r.RuleID] {
+			t.Errorf("result references undeclared rule %q", r.RuleID)
+		}
+		if r.Level != "error" && r.Level != "warning" {
+			t.Errorf("result %q has invalid level %q", r.RuleID, r.Level)
+		}
+		if r.Message.Text == "" {
+			t.Errorf("result %q has empty message", r.RuleID)
+		}
+	}
+
+	if len(run.Results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(run.Results))
+	}
+	vet := run.Results[0]
+	if len(vet.Locations) != 1 {
+		t.Fatalf("expected vet result to have a location, got %d", len(vet.Locations))
+	}
+	phys := vet.Locations[0].PhysicalLocation
+	if phys.ArtifactLocation.URI != "internal/config/config.go" {
+		t.Errorf("unexpected artifact URI %q", phys.ArtifactLocation.URI)
+	}
+	if phys.Region == nil || phys.Region.StartLine != 42 || phys.Region.StartColumn != 7 {
+		t.Errorf("unexpected region %+v", phys.Region)
+	}
+	if run.Results[1].Level != "warning" || len(run.Results[1].Locations) != 0 {
+		t.Errorf("unexpected coverage result: %+v", run.Results[1])
+	}
+	if run.Results[2].Message.Text != `Check "slow" timed out` {
+		t.Errorf("unexpected timeout message: %q", run.Results[2].Message.Text)
+	}
+}
+
+func TestFormatSARIF_RunProperties(t *testing.T) {
+	var buf bytes.Buffer
+	result := &orchestrator.RunResult{Name: "payments-api", Description: "Checks for the payments service"}
+	if err := FormatSARIF(&buf, result); err != nil {
+		t.Fatalf("FormatSARIF failed: %v", err)
+	}
+
+	var log SARIFLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	want := &SARIFRunProperties{Name: "payments-api", Description: "Checks for the payments service"}
+	if got := log.Runs[0].Properties; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected run properties %+v, got %+v", want, got)
+	}
+}
+
+func TestFormatSARIF_AllowFailureLevel(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Violations: []*orchestrator.Violation{
+			{CheckID: "lint", Severity: config.SeverityError, Command: "golangci-lint run", AllowFailure: true},
+			{CheckID: "docs", Severity: config.SeverityInfo, Command: "mdlint", AllowFailure: true},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := FormatSARIF(&buf, result); err != nil {
+		t.Fatalf("FormatSARIF failed: %v", err)
+	}
+	var log SARIFLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	run := log.Runs[0]
+	if got := run.Results[0].Level; got != "warning" {
+		t.Errorf("expected allowed error to be a warning, got %q", got)
+	}
+	if got := run.Tool.Driver.Rules[0].DefaultConfiguration.Level; got != "error" {
+		t.Errorf("expected rule to keep the check's severity, got %q", got)
+	}
+	if got := run.Results[1].Level; got != "note" {
+		t.Errorf("expected allowed info to stay a note, got %q", got)
+	}
+}
+
+func TestFormatSARIF_KnownLevel(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Violations: []*orchestrator.Violation{
+			{CheckID: "vet", Severity: config.SeverityError, Command: "go vet ./...", Known: true},
+			{CheckID: "lint", Severity: config.SeverityError, Command: "golangci-lint run"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := FormatSARIF(&buf, result); err != nil {
+		t.Fatalf("FormatSARIF failed: %v", err)
+	}
+	var log SARIFLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	run := log.Runs[0]
+	if got := run.Results[0].Level; got != "note" {
+		t.Errorf("expected baselined violation to be a note, got %q", got)
+	}
+	if got := run.Results[1].Level; got != "error" {
+		t.Errorf("expected new violation to stay an error, got %q", got)
+	}
+}
+
+func TestFormatSARIF_ConfigLocation(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := &orchestrator.RunResult{
+		Provenance: orchestrator.Provenance{ConfigPath: filepath.Join(wd, "vibeguard.yaml")},
+		Violations: []*orchestrator.Violation{
+			{CheckID: "test", Severity: config.SeverityError, Command: "go test ./...", ConfigLine: 7},
+			{CheckID: "vet", Severity: config.SeverityError, Command: "go vet ./...", Extracted: map[string]string{"file": "main.go"}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := FormatSARIF(&buf, result); err != nil {
+		t.Fatalf("FormatSARIF failed: %v", err)
+	}
+	var log SARIFLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	// Without a file capture the result points at the check in the config
+	want := []SARIFLocation{{PhysicalLocation: SARIFPhysicalLocation{
+		ArtifactLocation: SARIFArtifactLocation{URI: "vibeguard.yaml"},
+		Region:           &SARIFRegion{StartLine: 7},
+	}}}
+	if got := log.Runs[0].Results[0].Locations; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected config file location %+v, got %+v", want, got)
+	}
+	if got := log.Runs[0].Results[1].Locations; len(got) != 1 || got[0].PhysicalLocation.ArtifactLocation.URI != "main.go" {
+		t.Errorf("expected captured file to take precedence, got %+v", got)
+	}
+}
+
+func TestFormatSARIF_NoViolations(t *testing.T) {
+	var buf bytes.Buffer
+	if err := FormatSARIF(&buf, &orchestrator.RunResult{}); err != nil {
+		t.Fatalf("FormatSARIF failed: %v", err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &raw); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	runs := raw["runs"].([]interface{})
+	run := runs[0].(map[string]interface{})
+	if results, ok := run["results"].([]interface{}); !ok || len(results) != 0 {
+		t.Errorf("expected empty results array, got %v", run["results"])
+	}
+}
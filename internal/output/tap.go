@@ -0,0 +1,102 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+// TAPDiagnostic is the YAML diagnostic block written after a failing test
+// point.
+type TAPDiagnostic struct {
+	Message  string `yaml:"message"`
+	Severity string `yaml:"severity"`
+	ExitCode int    `yaml:"exit_code"`
+	Timedout bool   `yaml:"timed_out,omitempty"`
+	Command  string `yaml:"command"`
+	Fix      string `yaml:"fix,omitempty"`
+}
+
+// FormatTAP outputs the result as TAP version 13, with one test point per
+// check in execution order.
+//
+// Mapping:
+//   - Passed checks are "ok"
+//   - Checks skipped because of their dependencies, or cancelled by
+//     fail-fast, are "ok" with a # SKIP directive and the reason
+//   - Error-severity violations and timeouts are "not ok"
+//   - Warning- and info-severity, allow_failure and baselined violations are
+//     "not ok" with a # TODO directive, so they don't fail the run
+//   - Every violation is followed by a YAML block with the suggestion,
+//     severity, exit code and command
+func FormatTAP(out io.Writer, result *orchestrator.RunResult) error {
+	violationByID := make(map[string]*orchestrator.Violation, len(result.Violations))
+	for _, v := range result.Violations {
+		violationByID[v.CheckID] = v
+	}
+
+	var b strings.Builder
+	b.WriteString("TAP version 13\n")
+	fmt.Fprintf(&b, "1..%d\n", len(result.Results))
+	for i, r := range result.Results {
+		n := i + 1
+		v := violationByID[r.Check.ID]
+
+		switch {
+		case r.Skipped:
+			fmt.Fprintf(&b, "ok %d - %s # SKIP %s\n", n, r.Check.ID, tapEscape(r.SkipReason))
+		case r.Execution.Cancelled:
+			fmt.Fprintf(&b, "ok %d - %s # SKIP Cancelled due to --fail-fast\n", n, r.Check.ID)
+		case r.Passed || v == nil:
+			fmt.Fprintf(&b, "ok %d - %s\n", n, r.Check.ID)
+		default:
+			fmt.Fprintf(&b, "not ok %d - %s%s\n", n, r.Check.ID, tapTodo(v))
+			diagnostic, err := yaml.Marshal(TAPDiagnostic{
+				Message:  sarifMessage(v),
+				Severity: string(v.Severity),
+				ExitCode: r.Execution.ExitCode,
+				Timedout: v.Timedout,
+				Command:  v.Command,
+				Fix:      config.InterpolateWithExtracted(v.Fix, nil, v.Extracted),
+			})
+			if err != nil {
+				return err
+			}
+			b.WriteString("  ---\n")
+			for _, line := range strings.SplitAfter(strings.TrimSuffix(string(diagnostic), "\n"), "\n") {
+				b.WriteString("  " + line)
+			}
+			b.WriteString("\n  ...\n")
+		}
+	}
+
+	_, err := io.WriteString(out, b.String())
+	return err
+}
+
+// tapTodo returns the # TODO directive for a violation that doesn't fail the
+// run, or "" for one that does.
+func tapTodo(v *orchestrator.Violation) string {
+	switch {
+	case v.Known:
+		return " # TODO in baseline"
+	case v.AllowFailure:
+		return " # TODO allow_failure"
+	case v.Severity == config.SeverityWarning || v.Severity == config.SeverityInfo:
+		return " # TODO " + string(v.Severity)
+	default:
+		return ""
+	}
+}
+
+// tapEscape keeps a directive reason on one line and escapes the characters
+// TAP treats specially in a test point description.
+func tapEscape(s string) string {
+	s = strings.NewReplacer("\\", "\\\\", "#", "\\#").Replace(s)
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,73 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+func TestFormatTAP(t *testing.T) {
+	result := sarifTestResult()
+	result.Results = append(result.Results, &orchestrator.CheckResult{
+		Check:      &config.Check{ID: "report", Requires: []string{"vet"}},
+		Execution:  &executor.Result{},
+		Skipped:    true,
+		SkipReason: "Skipped: dependency vet failed",
+	})
+
+	var buf bytes.Buffer
+	if err := FormatTAP(&buf, result); err != nil {
+		t.Fatalf("FormatTAP failed: %v", err)
+	}
+
+	want := `TAP version 13
+1..5
+ok 1 - fmt
+not ok 2 - vet
+  ---
+  message: Fix the unreachable code reported by go vet
+  severity: error
+  exit_code: 1
+  command: go vet ./...
+  ...
+not ok 3 - coverage # TODO warning
+  ---
+  message: Coverage is 72%, need 80%
+  severity: warning
+  exit_code: 0
+  command: go test -cover ./...
+  ...
+not ok 4 - slow
+  ---
+  message: Check "slow" timed out
+  severity: error
+  exit_code: 0
+  timed_out: true
+  command: sleep 60
+  ...
+ok 5 - report # SKIP Skipped: dependency vet failed
+`
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected TAP output:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFormatTAP_Cancelled(t *testing.T) {
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			{Check: &config.Check{ID: "lint"}, Execution: &executor.Result{Cancelled: true}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := FormatTAP(&buf, result); err != nil {
+		t.Fatalf("FormatTAP failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "ok 1 - lint # SKIP Cancelled due to --fail-fast\n") {
+		t.Errorf("expected a cancelled check to be skipped, got:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,125 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+// Trace is a Chrome trace event file, as loaded by chrome://tracing and
+// Perfetto.
+type Trace struct {
+	TraceEvents     []TraceEvent `json:"traceEvents"`
+	DisplayTimeUnit string       `json:"displayTimeUnit"`
+}
+
+// TraceEvent is one event of a Chrome trace. Checks are complete ("X")
+// events; metadata ("M") events name the process and its lanes.
+type TraceEvent struct {
+	Name string         `json:"name"`
+	Cat  string         `json:"cat,omitempty"`
+	Ph   string         `json:"ph"`
+	Ts   int64          `json:"ts"`            // Microseconds since the first check started
+	Dur  int64          `json:"dur,omitempty"` // Microseconds
+	Pid  int            `json:"pid"`
+	Tid  int            `json:"tid"`
+	Args map[string]any `json:"args,omitempty"`
+}
+
+// tracePid is the process ID every event is reported under.
+const tracePid = 1
+
+// FormatTrace outputs the timing of the executed checks as a Chrome trace.
+//
+// Mapping:
+//   - Every check that ran its command is a complete event spanning its
+//     execution, with its level, status and exit code as args
+//   - Skipped checks and cached results didn't run and have no event
+//   - Checks are laid out on lanes (threads) so that checks running at the
+//     same time are on different lanes; the number of lanes is the peak
+//     parallelism of the run
+func FormatTrace(out io.Writer, result *orchestrator.RunResult) error {
+	var executed []*orchestrator.CheckResult
+	for _, r := range result.Results {
+		if !r.Skipped && r.Execution != nil && !r.Execution.StartTime.IsZero() {
+			executed = append(executed, r)
+		}
+	}
+	sort.SliceStable(executed, func(i, j int) bool {
+		return executed[i].Execution.StartTime.Before(executed[j].Execution.StartTime)
+	})
+
+	trace := Trace{
+		TraceEvents: []TraceEvent{{
+			Name: "process_name",
+			Ph:   "M",
+			Pid:  tracePid,
+			Args: map[string]any{"name": "vibeguard"},
+		}},
+		DisplayTimeUnit: "ms",
+	}
+
+	// laneEnds holds, per lane, when its last check ended
+	var laneEnds []time.Time
+	for _, r := range executed {
+		exec := r.Execution
+		lane := -1
+		for i, end := range laneEnds {
+			if !end.After(exec.StartTime) {
+				lane = i
+				break
+			}
+		}
+		if lane < 0 {
+			lane = len(laneEnds)
+			laneEnds = append(laneEnds, time.Time{})
+			trace.TraceEvents = append(trace.TraceEvents, TraceEvent{
+				Name: "thread_name",
+				Ph:   "M",
+				Pid:  tracePid,
+				Tid:  lane + 1,
+				Args: map[string]any{"name": fmt.Sprintf("lane %d", lane+1)},
+			})
+		}
+		laneEnds[lane] = exec.StartTime.Add(exec.Duration)
+
+		trace.TraceEvents = append(trace.TraceEvents, TraceEvent{
+			Name: r.Check.ID,
+			Cat:  "check",
+			Ph:   "X",
+			Ts:   exec.StartTime.Sub(executed[0].Execution.StartTime).Microseconds(),
+			Dur:  exec.Duration.Microseconds(),
+			Pid:  tracePid,
+			Tid:  lane + 1,
+			Args: map[string]any{
+				"level":     r.Level,
+				"status":    traceStatus(r),
+				"exit_code": exec.ExitCode,
+			},
+		})
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(trace)
+}
+
+// traceStatus describes how an executed check ended.
+func traceStatus(r *orchestrator.CheckResult) string {
+	switch {
+	case r.Execution.Timedout:
+		return "timed out"
+	case r.Execution.Cancelled:
+		return "cancelled"
+	case r.Fixed:
+		return "fixed"
+	case r.Passed:
+		return "passed"
+	default:
+		return "failed"
+	}
+}
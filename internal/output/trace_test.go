@@ -0,0 +1,104 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/vibeguard/vibeguard/internal/config"
+	"github.com/vibeguard/vibeguard/internal/executor"
+	"github.com/vibeguard/vibeguard/internal/orchestrator"
+)
+
+func TestFormatTrace(t *testing.T) {
+	start := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	ran := func(id string, level int, offset, duration time.Duration, passed bool) *orchestrator.CheckResult {
+		exitCode := 0
+		if !passed {
+			exitCode = 1
+		}
+		return &orchestrator.CheckResult{
+			Check: &config.Check{ID: id},
+			Execution: &executor.Result{
+				CheckID:   id,
+				ExitCode:  exitCode,
+				StartTime: start.Add(offset),
+				EndTime:   start.Add(offset + duration),
+				Duration:  duration,
+				Success:   passed,
+			},
+			Passed: passed,
+			Level:  level,
+		}
+	}
+	result := &orchestrator.RunResult{
+		Results: []*orchestrator.CheckResult{
+			ran("fmt", 0, 0, 100*time.Millisecond, true),
+			ran("vet", 0, 10*time.Millisecond, 50*time.Millisecond, false),
+			ran("test", 1, 100*time.Millisecond, 20*time.Millisecond, true),
+			{
+				Check:      &config.Check{ID: "report"},
+				Execution:  &executor.Result{ExitCode: -1},
+				Skipped:    true,
+				SkipReason: "Skipped: required dependency failed",
+				Level:      1,
+			},
+			{
+				Check:     &config.Check{ID: "lint"},
+				Execution: &executor.Result{CheckID: "lint", Success: true, Cached: true},
+				Passed:    true,
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := FormatTrace(&buf, result); err != nil {
+		t.Fatalf("FormatTrace failed: %v", err)
+	}
+
+	var trace Trace
+	if err := json.Unmarshal(buf.Bytes(), &trace); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+
+	type span struct {
+		ts, dur int64
+		tid     int
+		level   float64
+		status  string
+	}
+	spans := make(map[string]span)
+	lanes := 0
+	for _, e := range trace.TraceEvents {
+		switch e.Ph {
+		case "X":
+			if _, dup := spans[e.Name]; dup {
+				t.Errorf("duplicate complete event for %q", e.Name)
+			}
+			spans[e.Name] = span{e.Ts, e.Dur, e.Tid, e.Args["level"].(float64), e.Args["status"].(string)}
+		case "M":
+			if e.Name == "thread_name" {
+				lanes++
+			}
+		}
+	}
+
+	// One complete event per executed check; skipped and cached checks have none
+	want := map[string]span{
+		"fmt":  {0, 100000, 1, 0, "passed"},
+		"vet":  {10000, 50000, 2, 0, "failed"},
+		"test": {100000, 20000, 1, 1, "passed"},
+	}
+	if len(spans) != len(want) {
+		t.Errorf("expected %d complete events, got %d: %+v", len(want), len(spans), spans)
+	}
+	for id, w := range want {
+		if got, ok := spans[id]; !ok || got != w {
+			t.Errorf("%s: expected %+v, got %+v (found: %v)", id, w, got, ok)
+		}
+	}
+	if lanes != 2 {
+		t.Errorf("expected 2 lanes, got %d", lanes)
+	}
+}
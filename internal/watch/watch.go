@@ -0,0 +1,208 @@
+// Package watch observes a directory tree for file changes and reports them in
+// debounced batches, for re-running checks during development.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// DefaultDebounce is how long to wait after the last change before reporting
+// a batch of changed files.
+const DefaultDebounce = 300 * time.Millisecond
+
+// DefaultIgnoreDirs lists directory names that are never watched: version
+// control metadata, dependency caches and common build outputs.
+var DefaultIgnoreDirs = []string{
+	".git",
+	".hg",
+	".svn",
+	".vibeguard",
+	".idea",
+	".vscode",
+	"node_modules",
+	"vendor",
+	"bin",
+	"build",
+	"dist",
+	"target",
+	"out",
+	"__pycache__",
+	".venv",
+	".tox",
+	".pytest_cache",
+	".mypy_cache",
+}
+
+// Watcher reports changed files below a root directory.
+type Watcher struct {
+	root     string
+	ignore   map[string]bool
+	debounce time.Duration
+	fsw      *fsnotify.Watcher
+}
+
+// New creates a Watcher for root, skipping directories whose base name is in
+// ignoreDirs. A debounce of zero uses DefaultDebounce.
+func New(root string, ignoreDirs []string, debounce time.Duration) (*Watcher, error) {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve watch root %q: %w", root, err)
+	}
+	if debounce <= 0 {
+		debounce = DefaultDebounce
+	}
+
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file watcher: %w", err)
+	}
+
+	w := &Watcher{
+		root:     absRoot,
+		ignore:   make(map[string]bool, len(ignoreDirs)),
+		debounce: debounce,
+		fsw:      fsw,
+	}
+	for _, dir := range ignoreDirs {
+		w.ignore[dir] = true
+	}
+
+	if err := w.addTree(absRoot); err != nil {
+		_ = fsw.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// Close stops watching.
+func (w *Watcher) Close() error {
+	return w.fsw.Close()
+}
+
+// Run blocks until ctx is cancelled, calling onChange with the sorted,
+// slash-separated paths (relative to the root) of files changed since the
+// previous call. Calls are debounced: a batch is reported once no further
+// changes have arrived for the debounce interval. onChange runs on the
+// watcher's goroutine, so changes arriving while it runs are batched for the
+// next call.
+func (w *Watcher) Run(ctx context.Context, onChange func(files []string)) error {
+	pending := make(map[string]bool)
+	timer := time.NewTimer(w.debounce)
+	if !timer.Stop() {
+		<-timer.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+
+		case event, ok := <-w.fsw.Events:
+			if !ok {
+				return nil
+			}
+			rel, ok := w.handleEvent(event)
+			if !ok {
+				continue
+			}
+			pending[rel] = true
+			timer.Reset(w.debounce)
+
+		case err, ok := <-w.fsw.Errors:
+			if !ok {
+				return nil
+			}
+			return fmt.Errorf("file watcher error: %w", err)
+
+		case <-timer.C:
+			if len(pending) == 0 {
+				continue
+			}
+			files := make([]string, 0, len(pending))
+			for f := range pending {
+				files = append(files, f)
+			}
+			sort.Strings(files)
+			pending = make(map[string]bool)
+			onChange(files)
+		}
+	}
+}
+
+// handleEvent processes a raw event, returning the changed path relative to
+// the root and whether it should be reported.
+//
+// Editors that save atomically write a temporary file and rename it over the
+// original, producing Create/Rename/Remove events rather than Write. All of
+// these are reported as a change to the affected path. Newly created
+// directories are watched so files added inside them are seen.
+func (w *Watcher) handleEvent(event fsnotify.Event) (string, bool) {
+	if event.Op == fsnotify.Chmod {
+		return "", false
+	}
+	if w.ignoredPath(event.Name) {
+		return "", false
+	}
+
+	if event.Has(fsnotify.Create) {
+		if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+			_ = w.addTree(event.Name)
+			return "", false
+		}
+	}
+
+	rel, err := filepath.Rel(w.root, event.Name)
+	if err != nil {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+// ignoredPath reports whether any directory component of path (below the
+// root) is ignored.
+func (w *Watcher) ignoredPath(path string) bool {
+	rel, err := filepath.Rel(w.root, path)
+	if err != nil {
+		return true
+	}
+	dir := filepath.Dir(rel)
+	for dir != "." && dir != string(filepath.Separator) && dir != "" {
+		if w.ignore[filepath.Base(dir)] {
+			return true
+		}
+		dir = filepath.Dir(dir)
+	}
+	return false
+}
+
+// addTree watches dir and every non-ignored directory below it.
+func (w *Watcher) addTree(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Directories can vanish between the event and the walk
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != w.root && w.ignore[d.Name()] {
+			return filepath.SkipDir
+		}
+		if err := w.fsw.Add(path); err != nil {
+			return fmt.Errorf("failed to watch %q: %w", path, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,156 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// startWatcher runs a watcher on dir and returns a channel of change batches.
+func startWatcher(t *testing.T, dir string, debounce time.Duration) <-chan []string {
+	t.Helper()
+
+	w, err := New(dir, DefaultIgnoreDirs, debounce)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	batches := make(chan []string, 10)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := w.Run(ctx, func(files []string) { batches <- files }); err != nil {
+			t.Errorf("Run failed: %v", err)
+		}
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		_ = w.Close()
+	})
+	return batches
+}
+
+func waitBatch(t *testing.T, batches <-chan []string) []string {
+	t.Helper()
+	select {
+	case files := <-batches:
+		return files
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for change batch")
+		return nil
+	}
+}
+
+func expectNoBatch(t *testing.T, batches <-chan []string, wait time.Duration) {
+	t.Helper()
+	select {
+	case files := <-batches:
+		t.Errorf("expected no change batch, got %v", files)
+	case <-time.After(wait):
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
+
+func TestWatcher_DebouncesBurstIntoOneBatch(t *testing.T) {
+	dir := t.TempDir()
+	batches := startWatcher(t, dir, 100*time.Millisecond)
+
+	writeFile(t, filepath.Join(dir, "a.go"), "package a")
+	writeFile(t, filepath.Join(dir, "b.go"), "package b")
+	writeFile(t, filepath.Join(dir, "a.go"), "package a // edited")
+
+	files := waitBatch(t, batches)
+	if !reflect.DeepEqual(files, []string{"a.go", "b.go"}) {
+		t.Errorf("expected [a.go b.go], got %v", files)
+	}
+	expectNoBatch(t, batches, 300*time.Millisecond)
+}
+
+func TestWatcher_AtomicSaveViaRename(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "main.go")
+	writeFile(t, target, "package main")
+
+	batches := startWatcher(t, dir, 100*time.Millisecond)
+
+	// Simulate an editor writing a temp file and renaming it over the original
+	tmp := filepath.Join(dir, ".main.go.swp")
+	writeFile(t, tmp, "package main // saved")
+	if err := os.Rename(tmp, target); err != nil {
+		t.Fatalf("rename failed: %v", err)
+	}
+
+	files := waitBatch(t, batches)
+	found := false
+	for _, f := range files {
+		if f == "main.go" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected main.go in change batch, got %v", files)
+	}
+}
+
+func TestWatcher_IgnoresGitAndBuildDirectories(t *testing.T) {
+	dir := t.TempDir()
+	for _, d := range []string{".git", "node_modules", "bin"} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	batches := startWatcher(t, dir, 50*time.Millisecond)
+
+	writeFile(t, filepath.Join(dir, ".git", "index"), "x")
+	writeFile(t, filepath.Join(dir, "node_modules", "dep.js"), "x")
+	writeFile(t, filepath.Join(dir, "bin", "vibeguard"), "x")
+	expectNoBatch(t, batches, 300*time.Millisecond)
+
+	writeFile(t, filepath.Join(dir, "main.go"), "package main")
+	files := waitBatch(t, batches)
+	if !reflect.DeepEqual(files, []string{"main.go"}) {
+		t.Errorf("expected only main.go, got %v", files)
+	}
+}
+
+func TestWatcher_WatchesNewDirectories(t *testing.T) {
+	dir := t.TempDir()
+	batches := startWatcher(t, dir, 100*time.Millisecond)
+
+	sub := filepath.Join(dir, "internal", "pkg")
+	if err := os.MkdirAll(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+	// Give the watcher time to register the new directories
+	time.Sleep(200 * time.Millisecond)
+	select {
+	case <-batches:
+	default:
+	}
+
+	writeFile(t, filepath.Join(sub, "pkg.go"), "package pkg")
+
+	files := waitBatch(t, batches)
+	found := false
+	for _, f := range files {
+		if f == "internal/pkg/pkg.go" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected internal/pkg/pkg.go in change batch, got %v", files)
+	}
+}